@@ -22,6 +22,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/apimiddleware"
 	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/container/trie"
 	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
 	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
 	log "github.com/sirupsen/logrus"
@@ -35,6 +36,10 @@ const (
 	getForkSchedulePath     = "/eth/v1/config/fork_schedule"
 	getStatePath            = "/eth/v2/debug/beacon/states"
 	getNodeVersionPath      = "/eth/v1/node/version"
+	getBlockHeaderPath      = "/eth/v1/beacon/headers/{{.Id}}"
+	getStateValidatorPath   = "/eth/v1/beacon/states/{{.Id}}/validators"
+	postVoluntaryExitPath   = "/eth/v1/beacon/pool/voluntary_exits"
+	getDepositSnapshotPath  = "/eth/v1/beacon/deposit_snapshot"
 )
 
 // StateOrBlockId represents the block_id / state_id parameters that several of the Eth Beacon API methods accept.
@@ -173,6 +178,32 @@ func (c *Client) get(ctx context.Context, path string, opts ...reqOption) ([]byt
 	return b, nil
 }
 
+// post is a generic, opinionated POST function to reduce boilerplate amongst the submitters in this package.
+func (c *Client) post(ctx context.Context, path string, body []byte) ([]byte, error) {
+	u := c.baseURL.ResolveReference(&url.URL{Path: path})
+	log.Printf("posting to %s", u.String())
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, u.String(), bytes.NewBuffer(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	r, err := c.hc.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		err = r.Body.Close()
+	}()
+	if r.StatusCode != http.StatusOK {
+		return nil, non200Err(r)
+	}
+	b, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error reading http response body from %s", path)
+	}
+	return b, nil
+}
+
 func renderGetBlockPath(id StateOrBlockId) string {
 	return path.Join(getSignedBlockPath, string(id))
 }
@@ -345,6 +376,23 @@ func (c *Client) GetWeakSubjectivity(ctx context.Context) (*WeakSubjectivityData
 	}, nil
 }
 
+// GetDepositSnapshot retrieves the EIP-4881 deposit tree snapshot of the finalized deposits, ssz-encoded,
+// and verifies that its finalized subtree roots match its deposit root.
+func (c *Client) GetDepositSnapshot(ctx context.Context) (*trie.DepositTreeSnapshot, error) {
+	b, err := c.get(ctx, getDepositSnapshotPath, withSSZEncoding())
+	if err != nil {
+		return nil, errors.Wrap(err, "error requesting deposit snapshot")
+	}
+	snapshot := &trie.DepositTreeSnapshot{}
+	if err := snapshot.UnmarshalSSZ(b); err != nil {
+		return nil, errors.Wrap(err, "could not unmarshal deposit snapshot")
+	}
+	if err := snapshot.Verify(); err != nil {
+		return nil, err
+	}
+	return snapshot, nil
+}
+
 func non200Err(response *http.Response) error {
 	bodyBytes, err := io.ReadAll(response.Body)
 	var body string
@@ -1,9 +1,14 @@
 package beacon
 
 import (
+	"context"
+	"net/http"
+	"net/http/httptest"
 	"net/url"
 	"testing"
 
+	"github.com/prysmaticlabs/prysm/config/params"
+	"github.com/prysmaticlabs/prysm/container/trie"
 	"github.com/prysmaticlabs/prysm/testing/require"
 )
 
@@ -136,3 +141,34 @@ func TestValidHostname(t *testing.T) {
 		})
 	}
 }
+
+func TestClient_GetDepositSnapshot(t *testing.T) {
+	depositTrie, err := trie.NewTrie(params.BeaconConfig().DepositContractTreeDepth)
+	require.NoError(t, err)
+	for i := 0; i < 5; i++ {
+		require.NoError(t, depositTrie.Insert([]byte{byte(i)}, i))
+	}
+	snapshot, err := depositTrie.Snapshot([32]byte{'a'}, 10)
+	require.NoError(t, err)
+	enc, err := snapshot.MarshalSSZ()
+	require.NoError(t, err)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, getDepositSnapshotPath, r.URL.Path)
+		require.Equal(t, "application/octet-stream", r.Header.Get("Accept"))
+		_, err := w.Write(enc)
+		require.NoError(t, err)
+	}))
+	defer srv.Close()
+	c, err := NewClient(srv.URL)
+	require.NoError(t, err)
+	got, err := c.GetDepositSnapshot(context.Background())
+	require.NoError(t, err)
+	require.DeepEqual(t, snapshot, got)
+
+	snapshot.DepositRoot = [32]byte{'b'}
+	enc, err = snapshot.MarshalSSZ()
+	require.NoError(t, err)
+	_, err = c.GetDepositSnapshot(context.Background())
+	require.ErrorContains(t, "does not match calculated root", err)
+}
@@ -0,0 +1,152 @@
+package beacon
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+)
+
+// SignedVoluntaryExitJson is the Eth Beacon Node API JSON representation of a SignedVoluntaryExit.
+// This is the format used by the voluntary exit pool endpoint and by pre-signed exit files.
+type SignedVoluntaryExitJson struct {
+	Message   *VoluntaryExitJson `json:"message"`
+	Signature string             `json:"signature"`
+}
+
+// VoluntaryExitJson is the Eth Beacon Node API JSON representation of a VoluntaryExit.
+type VoluntaryExitJson struct {
+	Epoch          string `json:"epoch"`
+	ValidatorIndex string `json:"validator_index"`
+}
+
+// NewSignedVoluntaryExitJson converts a SignedVoluntaryExit into its API JSON representation.
+func NewSignedVoluntaryExitJson(e *ethpb.SignedVoluntaryExit) *SignedVoluntaryExitJson {
+	return &SignedVoluntaryExitJson{
+		Message: &VoluntaryExitJson{
+			Epoch:          fmt.Sprintf("%d", e.Exit.Epoch),
+			ValidatorIndex: fmt.Sprintf("%d", e.Exit.ValidatorIndex),
+		},
+		Signature: hexutil.Encode(e.Signature),
+	}
+}
+
+// SignedVoluntaryExit converts the API JSON representation back into a SignedVoluntaryExit.
+func (e *SignedVoluntaryExitJson) SignedVoluntaryExit() (*ethpb.SignedVoluntaryExit, error) {
+	if e.Message == nil {
+		return nil, errors.New("voluntary exit message is missing")
+	}
+	epoch, err := strconv.ParseUint(e.Message.Epoch, 10, 64)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not parse exit epoch %s", e.Message.Epoch)
+	}
+	index, err := strconv.ParseUint(e.Message.ValidatorIndex, 10, 64)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not parse validator index %s", e.Message.ValidatorIndex)
+	}
+	sig, err := hexutil.Decode(e.Signature)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not decode signature %s", e.Signature)
+	}
+	return &ethpb.SignedVoluntaryExit{
+		Exit: &ethpb.VoluntaryExit{
+			Epoch:          types.Epoch(epoch),
+			ValidatorIndex: types.ValidatorIndex(index),
+		},
+		Signature: sig,
+	}, nil
+}
+
+// SubmitVoluntaryExit submits a signed voluntary exit to the beacon node's operation pool,
+// which validates it against the head state and broadcasts it to the network.
+func (c *Client) SubmitVoluntaryExit(ctx context.Context, e *ethpb.SignedVoluntaryExit) error {
+	body, err := json.Marshal(NewSignedVoluntaryExitJson(e))
+	if err != nil {
+		return err
+	}
+	_, err = c.post(ctx, postVoluntaryExitPath, body)
+	return err
+}
+
+// ValidatorStatus describes the lifecycle of a validator as reported by the beacon node.
+type ValidatorStatus struct {
+	Index           types.ValidatorIndex
+	Status          string
+	ActivationEpoch types.Epoch
+	ExitEpoch       types.Epoch
+}
+
+type stateValidatorResponse struct {
+	Data struct {
+		Index     string `json:"index"`
+		Status    string `json:"status"`
+		Validator struct {
+			ActivationEpoch string `json:"activation_epoch"`
+			ExitEpoch       string `json:"exit_epoch"`
+		} `json:"validator"`
+	} `json:"data"`
+}
+
+var getStateValidatorTpl = idTemplate(getStateValidatorPath)
+
+// GetValidatorStatus retrieves the status of the validator with the given index in the given state.
+func (c *Client) GetValidatorStatus(ctx context.Context, stateId StateOrBlockId, index types.ValidatorIndex) (*ValidatorStatus, error) {
+	validatorPath := path.Join(getStateValidatorTpl(stateId), fmt.Sprintf("%d", index))
+	b, err := c.get(ctx, validatorPath)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error requesting validator %d", index)
+	}
+	v := &stateValidatorResponse{}
+	if err := json.Unmarshal(b, v); err != nil {
+		return nil, errors.Wrapf(err, "error decoding json response for validator %d", index)
+	}
+	activation, err := strconv.ParseUint(v.Data.Validator.ActivationEpoch, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	exit, err := strconv.ParseUint(v.Data.Validator.ExitEpoch, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	return &ValidatorStatus{
+		Index:           index,
+		Status:          v.Data.Status,
+		ActivationEpoch: types.Epoch(activation),
+		ExitEpoch:       types.Epoch(exit),
+	}, nil
+}
+
+type blockHeaderResponse struct {
+	Data struct {
+		Header struct {
+			Message struct {
+				Slot string `json:"slot"`
+			} `json:"message"`
+		} `json:"header"`
+	} `json:"data"`
+}
+
+var getBlockHeaderTpl = idTemplate(getBlockHeaderPath)
+
+// GetBlockHeaderSlot retrieves the slot of the block header for the given block id.
+func (c *Client) GetBlockHeaderSlot(ctx context.Context, blockId StateOrBlockId) (types.Slot, error) {
+	b, err := c.get(ctx, getBlockHeaderTpl(blockId))
+	if err != nil {
+		return 0, errors.Wrapf(err, "error requesting block header %s", blockId)
+	}
+	h := &blockHeaderResponse{}
+	if err := json.Unmarshal(b, h); err != nil {
+		return 0, errors.Wrapf(err, "error decoding json response for block header %s", blockId)
+	}
+	slot, err := strconv.ParseUint(h.Data.Header.Message.Slot, 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	return types.Slot(slot), nil
+}
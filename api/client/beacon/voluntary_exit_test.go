@@ -0,0 +1,68 @@
+package beacon
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/testing/require"
+)
+
+func TestSignedVoluntaryExitJson_RoundTrip(t *testing.T) {
+	exit := &ethpb.SignedVoluntaryExit{
+		Exit:      &ethpb.VoluntaryExit{Epoch: 12, ValidatorIndex: 34},
+		Signature: []byte{1, 2, 3},
+	}
+	j := NewSignedVoluntaryExitJson(exit)
+	require.Equal(t, "12", j.Message.Epoch)
+	require.Equal(t, "34", j.Message.ValidatorIndex)
+	require.Equal(t, "0x010203", j.Signature)
+	got, err := j.SignedVoluntaryExit()
+	require.NoError(t, err)
+	require.DeepEqual(t, exit, got)
+
+	_, err = (&SignedVoluntaryExitJson{}).SignedVoluntaryExit()
+	require.ErrorContains(t, "message is missing", err)
+}
+
+func TestClient_SubmitVoluntaryExit(t *testing.T) {
+	exit := &ethpb.SignedVoluntaryExit{
+		Exit:      &ethpb.VoluntaryExit{Epoch: 1, ValidatorIndex: 2},
+		Signature: make([]byte, 96),
+	}
+	var received *SignedVoluntaryExitJson
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, postVoluntaryExitPath, r.URL.Path)
+		require.Equal(t, http.MethodPost, r.Method)
+		b, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		received = &SignedVoluntaryExitJson{}
+		require.NoError(t, json.Unmarshal(b, received))
+	}))
+	defer srv.Close()
+	c, err := NewClient(srv.URL)
+	require.NoError(t, err)
+	require.NoError(t, c.SubmitVoluntaryExit(context.Background(), exit))
+	require.DeepEqual(t, NewSignedVoluntaryExitJson(exit), received)
+}
+
+func TestClient_GetValidatorStatus(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/eth/v1/beacon/states/head/validators/5", r.URL.Path)
+		_, err := w.Write([]byte(`{"data":{"index":"5","status":"active_exiting","validator":{"activation_epoch":"0","exit_epoch":"10"}}}`))
+		require.NoError(t, err)
+	}))
+	defer srv.Close()
+	c, err := NewClient(srv.URL)
+	require.NoError(t, err)
+	s, err := c.GetValidatorStatus(context.Background(), IdHead, 5)
+	require.NoError(t, err)
+	require.Equal(t, "active_exiting", s.Status)
+	require.Equal(t, types.Epoch(10), s.ExitEpoch)
+	require.Equal(t, types.ValidatorIndex(5), s.Index)
+}
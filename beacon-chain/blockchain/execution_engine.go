@@ -6,12 +6,15 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/time"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/transition"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db/kv"
 	"github.com/prysmaticlabs/prysm/beacon-chain/powchain"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/config/features"
 	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/config/params"
 	"github.com/prysmaticlabs/prysm/consensus-types/interfaces"
@@ -19,6 +22,7 @@ import (
 	"github.com/prysmaticlabs/prysm/consensus-types/wrapper"
 	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
 	enginev1 "github.com/prysmaticlabs/prysm/proto/engine/v1"
+	ethpbv1 "github.com/prysmaticlabs/prysm/proto/eth/v1"
 	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/time/slots"
 	"github.com/sirupsen/logrus"
@@ -51,14 +55,14 @@ func (s *Service) notifyForkchoiceUpdate(ctx context.Context, arg *notifyForkcho
 	if !isExecutionBlk {
 		return nil, nil
 	}
-	headPayload, err := headBlk.Body().ExecutionPayload()
+	headBlockHash, err := blocks.ExecutionBlockHash(headBlk.Body())
 	if err != nil {
-		return nil, errors.Wrap(err, "could not get execution payload")
+		return nil, errors.Wrap(err, "could not get execution block hash")
 	}
 	finalizedHash := s.store.FinalizedPayloadBlockHash()
 	justifiedHash := s.store.JustifiedPayloadBlockHash()
 	fcs := &enginev1.ForkchoiceState{
-		HeadBlockHash:      headPayload.BlockHash,
+		HeadBlockHash:      headBlockHash,
 		SafeBlockHash:      justifiedHash[:],
 		FinalizedBlockHash: finalizedHash[:],
 	}
@@ -68,6 +72,20 @@ func (s *Service) notifyForkchoiceUpdate(ctx context.Context, arg *notifyForkcho
 	if err != nil {
 		return nil, errors.Wrap(err, "could not get payload attribute")
 	}
+	if hasAttr {
+		s.cfg.StateNotifier.StateFeed().Send(&feed.Event{
+			Type: statefeed.PayloadAttributes,
+			Data: &ethpbv1.EventPayloadAttributes{
+				ProposalSlot:          nextSlot,
+				ProposerIndex:         proposerId,
+				ParentBlockRoot:       bytesutil.SafeCopyBytes(arg.headRoot[:]),
+				ParentBlockHash:       bytesutil.SafeCopyBytes(headBlockHash),
+				Timestamp:             attr.Timestamp,
+				PrevRandao:            bytesutil.SafeCopyBytes(attr.PrevRandao),
+				SuggestedFeeRecipient: bytesutil.SafeCopyBytes(attr.SuggestedFeeRecipient),
+			},
+		})
+	}
 
 	payloadID, lastValidHash, err := s.cfg.ExecutionEngineCaller.ForkchoiceUpdated(ctx, fcs, attr)
 	if err != nil {
@@ -76,7 +94,7 @@ func (s *Service) notifyForkchoiceUpdate(ctx context.Context, arg *notifyForkcho
 			forkchoiceUpdatedOptimisticNodeCount.Inc()
 			log.WithFields(logrus.Fields{
 				"headSlot":                  headBlk.Slot(),
-				"headPayloadBlockHash":      fmt.Sprintf("%#x", bytesutil.Trunc(headPayload.BlockHash)),
+				"headPayloadBlockHash":      fmt.Sprintf("%#x", bytesutil.Trunc(headBlockHash)),
 				"finalizedPayloadBlockHash": fmt.Sprintf("%#x", bytesutil.Trunc(finalizedHash[:])),
 			}).Info("Called fork choice updated with optimistic block")
 			return payloadID, s.optimisticCandidateBlock(ctx, headBlk)
@@ -87,6 +105,7 @@ func (s *Service) notifyForkchoiceUpdate(ctx context.Context, arg *notifyForkcho
 			if err != nil {
 				return nil, err
 			}
+			s.notifyInvalidatedPayload(invalidRoots, lastValidHash)
 			if err := s.removeInvalidBlockAndState(ctx, invalidRoots); err != nil {
 				return nil, err
 			}
@@ -124,10 +143,24 @@ func (s *Service) notifyForkchoiceUpdate(ctx context.Context, arg *notifyForkcho
 		}
 	}
 	forkchoiceUpdatedValidNodeCount.Inc()
+	wasOptimistic, err := s.cfg.ForkChoiceStore.IsOptimistic(arg.headRoot)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not check if block is optimistic")
+	}
 	if err := s.cfg.ForkChoiceStore.SetOptimisticToValid(ctx, arg.headRoot); err != nil {
 		return nil, errors.Wrap(err, "could not set block to valid")
 	}
-	if hasAttr { // If the forkchoice update call has an attribute, update the proposer payload ID cache.
+	if wasOptimistic {
+		s.cfg.StateNotifier.StateFeed().Send(&feed.Event{
+			Type: statefeed.OptimisticToValid,
+			Data: &ethpbv1.EventOptimisticToValid{
+				Block: bytesutil.SafeCopyBytes(arg.headRoot[:]),
+			},
+		})
+	}
+	// If the forkchoice update call has an attribute, update the proposer payload ID cache. Payloads which
+	// are prepared for proposers not tracked by the node are not cached, as it does not propose for them.
+	if _, _, tracked := s.cfg.ProposerSlotIndexCache.GetProposerPayloadIDs(nextSlot); hasAttr && tracked {
 		var pId [8]byte
 		copy(pId[:], payloadID[:])
 		s.cfg.ProposerSlotIndexCache.SetProposerAndPayloadIDs(nextSlot, proposerId, pId)
@@ -145,11 +178,11 @@ func (s *Service) getPayloadHash(ctx context.Context, root []byte) ([32]byte, er
 	if blocks.IsPreBellatrixVersion(blk.Block().Version()) {
 		return params.BeaconConfig().ZeroHash, nil
 	}
-	payload, err := blk.Block().Body().ExecutionPayload()
+	blockHash, err := blocks.ExecutionBlockHash(blk.Block().Body())
 	if err != nil {
-		return [32]byte{}, errors.Wrap(err, "could not get execution payload")
+		return [32]byte{}, errors.Wrap(err, "could not get execution block hash")
 	}
-	return bytesutil.ToBytes32(payload.BlockHash), nil
+	return bytesutil.ToBytes32(blockHash), nil
 }
 
 // notifyForkchoiceUpdate signals execution engine on a new payload.
@@ -201,6 +234,7 @@ func (s *Service) notifyNewPayload(ctx context.Context, postStateVersion int,
 		if err != nil {
 			return false, err
 		}
+		s.notifyInvalidatedPayload(invalidRoots, lastValidHash)
 		if err := s.removeInvalidBlockAndState(ctx, invalidRoots); err != nil {
 			return false, err
 		}
@@ -215,6 +249,22 @@ func (s *Service) notifyNewPayload(ctx context.Context, postStateVersion int,
 	}
 }
 
+// notifyInvalidatedPayload sends the roots of the blocks invalidated by the execution engine,
+// along with the latest valid hash it reported, to the state feed.
+func (s *Service) notifyInvalidatedPayload(invalidRoots [][32]byte, lastValidHash []byte) {
+	roots := make([][]byte, len(invalidRoots))
+	for i := range invalidRoots {
+		roots[i] = bytesutil.SafeCopyBytes(invalidRoots[i][:])
+	}
+	s.cfg.StateNotifier.StateFeed().Send(&feed.Event{
+		Type: statefeed.InvalidatedPayload,
+		Data: &ethpbv1.EventInvalidatedPayload{
+			InvalidBlocks:   roots,
+			LatestValidHash: bytesutil.SafeCopyBytes(lastValidHash),
+		},
+	})
+}
+
 // optimisticCandidateBlock returns an error if this block can't be optimistically synced.
 // It replaces boolean in spec code with `errNotOptimisticCandidate`.
 //
@@ -248,9 +298,10 @@ func (s *Service) optimisticCandidateBlock(ctx context.Context, blk interfaces.B
 
 // getPayloadAttributes returns the payload attributes for the given state and slot.
 // The attribute is required to initiate a payload build process in the context of an `engine_forkchoiceUpdated` call.
+// It is built for the proposers tracked by the node, or for every proposer with the prepare all payloads feature.
 func (s *Service) getPayloadAttribute(ctx context.Context, st state.BeaconState, slot types.Slot) (bool, *enginev1.PayloadAttributes, types.ValidatorIndex, error) {
-	proposerID, _, ok := s.cfg.ProposerSlotIndexCache.GetProposerPayloadIDs(slot)
-	if !ok { // There's no need to build attribute if there is no proposer for slot.
+	proposerID, _, tracked := s.cfg.ProposerSlotIndexCache.GetProposerPayloadIDs(slot)
+	if !tracked && !features.Get().PrepareAllPayloads { // There's no need to build attribute if there is no proposer for slot.
 		return false, nil, 0, nil
 	}
 
@@ -264,13 +315,23 @@ func (s *Service) getPayloadAttribute(ctx context.Context, st state.BeaconState,
 	if err != nil {
 		return false, nil, 0, nil
 	}
+	if !tracked {
+		proposerID, err = helpers.BeaconProposerIndex(ctx, st)
+		if err != nil {
+			// Payloads of untracked proposers are prepared on a best effort basis, failing to do so must not
+			// prevent the forkchoice update.
+			log.WithError(err).WithField("slot", slot).Error("Could not get proposer index, not preparing payload")
+			return false, nil, 0, nil
+		}
+	}
 
 	// Get fee recipient.
 	feeRecipient := params.BeaconConfig().DefaultFeeRecipient
 	recipient, err := s.cfg.BeaconDB.FeeRecipientByValidatorID(ctx, proposerID)
 	switch {
 	case errors.Is(err, kv.ErrNotFoundFeeRecipient):
-		if feeRecipient.String() == fieldparams.EthBurnAddressHex {
+		// Proposers which are not tracked by the node are expected to have no fee recipient.
+		if tracked && feeRecipient.String() == fieldparams.EthBurnAddressHex {
 			logrus.WithFields(logrus.Fields{
 				"validatorIndex": proposerID,
 				"burnAddress":    fieldparams.EthBurnAddressHex,
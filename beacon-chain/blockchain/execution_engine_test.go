@@ -6,8 +6,13 @@ import (
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
 	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/transition"
 	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
 	doublylinkedtree "github.com/prysmaticlabs/prysm/beacon-chain/forkchoice/doubly-linked-tree"
 	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice/protoarray"
@@ -15,6 +20,7 @@ import (
 	mockPOW "github.com/prysmaticlabs/prysm/beacon-chain/powchain/testing"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	"github.com/prysmaticlabs/prysm/config/features"
 	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/config/params"
 	"github.com/prysmaticlabs/prysm/consensus-types/interfaces"
@@ -22,6 +28,7 @@ import (
 	"github.com/prysmaticlabs/prysm/consensus-types/wrapper"
 	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
 	v1 "github.com/prysmaticlabs/prysm/proto/engine/v1"
+	ethpbv1 "github.com/prysmaticlabs/prysm/proto/eth/v1"
 	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/testing/assert"
 	"github.com/prysmaticlabs/prysm/testing/require"
@@ -49,6 +56,7 @@ func Test_NotifyForkchoiceUpdate(t *testing.T) {
 		WithStateGen(stategen.New(beaconDB)),
 		WithForkChoiceStore(fcs),
 		WithProposerIdsCache(cache.NewProposerPayloadIDsCache()),
+		WithStateNotifier(&mock.MockStateNotifier{}),
 	}
 	service, err := NewService(ctx, opts...)
 	st, _ := util.DeterministicGenesisState(t, 1)
@@ -283,11 +291,16 @@ func Test_NotifyForkchoiceUpdateRecursive(t *testing.T) {
 
 	// Insert blocks into forkchoice
 	fcs := doublylinkedtree.New(0, 0)
+	notifier := &mock.MockStateNotifier{}
+	stateChannel := make(chan *feed.Event, 10)
+	stateSub := notifier.StateFeed().Subscribe(stateChannel)
+	defer stateSub.Unsubscribe()
 	opts := []Option{
 		WithDatabase(beaconDB),
 		WithStateGen(stategen.New(beaconDB)),
 		WithForkChoiceStore(fcs),
 		WithProposerIdsCache(cache.NewProposerPayloadIDsCache()),
+		WithStateNotifier(notifier),
 	}
 	service, err := NewService(ctx, opts...)
 	service.justifiedBalances.balances = []uint64{50, 100, 200}
@@ -334,6 +347,27 @@ func Test_NotifyForkchoiceUpdateRecursive(t *testing.T) {
 	require.Equal(t, false, fcs.HasNode(brf))
 	require.Equal(t, false, fcs.HasNode(brg))
 	require.Equal(t, true, fcs.HasNode(bre))
+
+	// Ensure G and then F were reported invalid, and D validated, on the state feed
+	var invalidated [][]byte
+	var validated []byte
+	for len(stateChannel) > 0 {
+		e := <-stateChannel
+		switch e.Type {
+		case statefeed.InvalidatedPayload:
+			data, ok := e.Data.(*ethpbv1.EventInvalidatedPayload)
+			require.Equal(t, true, ok)
+			require.Equal(t, 1, len(data.InvalidBlocks))
+			assert.DeepEqual(t, pe[:], data.LatestValidHash)
+			invalidated = append(invalidated, data.InvalidBlocks[0])
+		case statefeed.OptimisticToValid:
+			data, ok := e.Data.(*ethpbv1.EventOptimisticToValid)
+			require.Equal(t, true, ok)
+			validated = data.Block
+		}
+	}
+	assert.DeepEqual(t, [][]byte{brg[:], brf[:]}, invalidated)
+	assert.DeepEqual(t, brd[:], validated)
 }
 
 func Test_NotifyNewPayload(t *testing.T) {
@@ -348,6 +382,7 @@ func Test_NotifyNewPayload(t *testing.T) {
 		WithDatabase(beaconDB),
 		WithStateGen(stategen.New(beaconDB)),
 		WithForkChoiceStore(fcs),
+		WithStateNotifier(&mock.MockStateNotifier{}),
 	}
 	phase0State, _ := util.DeterministicGenesisState(t, 1)
 	altairState, _ := util.DeterministicGenesisStateAltair(t, 1)
@@ -793,6 +828,154 @@ func Test_GetPayloadAttribute(t *testing.T) {
 	require.Equal(t, suggestedAddr, common.BytesToAddress(attr.SuggestedFeeRecipient))
 }
 
+func Test_GetPayloadAttribute_PrepareAllPayloads(t *testing.T) {
+	resetCfg := features.InitWithReset(&features.Flags{PrepareAllPayloads: true})
+	defer resetCfg()
+
+	ctx := context.Background()
+	beaconDB := testDB.SetupDB(t)
+	opts := []Option{
+		WithDatabase(beaconDB),
+		WithStateGen(stategen.New(beaconDB)),
+		WithProposerIdsCache(cache.NewProposerPayloadIDsCache()),
+	}
+	service, err := NewService(ctx, opts...)
+	require.NoError(t, err)
+
+	// Cache miss, the attribute is built for the proposer of the slot.
+	slot := types.Slot(1)
+	st, _ := util.DeterministicGenesisState(t, 64)
+	advanced, err := transition.ProcessSlots(ctx, st.Copy(), slot)
+	require.NoError(t, err)
+	wantedVid, err := helpers.BeaconProposerIndex(ctx, advanced)
+	require.NoError(t, err)
+	hook := logTest.NewGlobal()
+	hasPayload, attr, vId, err := service.getPayloadAttribute(ctx, st, slot)
+	require.NoError(t, err)
+	require.Equal(t, true, hasPayload)
+	require.Equal(t, wantedVid, vId)
+	require.Equal(t, fieldparams.EthBurnAddressHex, common.BytesToAddress(attr.SuggestedFeeRecipient).String())
+	require.LogsDoNotContain(t, hook, "Fee recipient is currently using the burn address")
+}
+
+func Test_GetPayloadAttribute_PrepareAllPayloadsNoProposer(t *testing.T) {
+	resetCfg := features.InitWithReset(&features.Flags{PrepareAllPayloads: true})
+	defer resetCfg()
+
+	ctx := context.Background()
+	beaconDB := testDB.SetupDB(t)
+	opts := []Option{
+		WithDatabase(beaconDB),
+		WithStateGen(stategen.New(beaconDB)),
+		WithProposerIdsCache(cache.NewProposerPayloadIDsCache()),
+	}
+	service, err := NewService(ctx, opts...)
+	require.NoError(t, err)
+
+	// Without active validators the proposer of the slot cannot be computed, no attribute is built
+	// and the forkchoice update goes on without it.
+	st, _ := util.DeterministicGenesisState(t, 64)
+	vals := st.Validators()
+	for _, v := range vals {
+		v.ActivationEpoch = params.BeaconConfig().FarFutureEpoch
+	}
+	require.NoError(t, st.SetValidators(vals))
+	helpers.ClearCache()
+	hook := logTest.NewGlobal()
+	hasPayload, attr, _, err := service.getPayloadAttribute(ctx, st, 1)
+	require.NoError(t, err)
+	require.Equal(t, false, hasPayload)
+	require.Equal(t, true, attr == nil)
+	require.LogsContain(t, hook, "Could not get proposer index, not preparing payload")
+}
+
+func Test_NotifyForkchoiceUpdate_SendsPayloadAttributes(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := testDB.SetupDB(t)
+	fcs := protoarray.New(0, 0)
+	notifier := &mock.MockStateNotifier{RecordEvents: true}
+	opts := []Option{
+		WithDatabase(beaconDB),
+		WithStateGen(stategen.New(beaconDB)),
+		WithForkChoiceStore(fcs),
+		WithProposerIdsCache(cache.NewProposerPayloadIDsCache()),
+		WithStateNotifier(notifier),
+	}
+	service, err := NewService(ctx, opts...)
+	require.NoError(t, err)
+	service.genesisTime = time.Now()
+	service.cfg.ExecutionEngineCaller = &mockPOW.EngineClient{PayloadIDBytes: &v1.PayloadIDBytes{1}}
+	headRoot := [32]byte{'a'}
+	require.NoError(t, fcs.InsertOptimisticBlock(ctx, 0, headRoot, [32]byte{}, params.BeaconConfig().ZeroHash, 0, 0))
+
+	suggestedVid := types.ValidatorIndex(2)
+	service.cfg.ProposerSlotIndexCache.SetProposerAndPayloadIDs(1, suggestedVid, [8]byte{})
+	st, _ := util.DeterministicGenesisState(t, 64)
+	blockHash := bytesutil.PadTo([]byte{'b'}, fieldparams.RootLength)
+	blk, err := wrapper.WrappedBeaconBlock(&ethpb.BeaconBlockBellatrix{
+		Body: &ethpb.BeaconBlockBodyBellatrix{
+			ExecutionPayload: &v1.ExecutionPayload{BlockHash: blockHash},
+		},
+	})
+	require.NoError(t, err)
+	_, err = service.notifyForkchoiceUpdate(ctx, &notifyForkchoiceUpdateArg{
+		headState: st,
+		headRoot:  headRoot,
+		headBlock: blk,
+	})
+	require.NoError(t, err)
+
+	events := notifier.ReceivedEvents()
+	require.Equal(t, 1, len(events))
+	require.Equal(t, statefeed.PayloadAttributes, int(events[0].Type))
+	attr, ok := events[0].Data.(*ethpbv1.EventPayloadAttributes)
+	require.Equal(t, true, ok)
+	assert.Equal(t, types.Slot(1), attr.ProposalSlot)
+	assert.Equal(t, suggestedVid, attr.ProposerIndex)
+	assert.DeepEqual(t, headRoot[:], attr.ParentBlockRoot)
+	assert.DeepEqual(t, blockHash, attr.ParentBlockHash)
+	assert.Equal(t, fieldparams.EthBurnAddressHex, common.BytesToAddress(attr.SuggestedFeeRecipient).String())
+}
+
+func Test_NotifyForkchoiceUpdate_PrepareAllPayloadsDoesNotCacheUntrackedProposer(t *testing.T) {
+	resetCfg := features.InitWithReset(&features.Flags{PrepareAllPayloads: true})
+	defer resetCfg()
+
+	ctx := context.Background()
+	beaconDB := testDB.SetupDB(t)
+	fcs := protoarray.New(0, 0)
+	opts := []Option{
+		WithDatabase(beaconDB),
+		WithStateGen(stategen.New(beaconDB)),
+		WithForkChoiceStore(fcs),
+		WithProposerIdsCache(cache.NewProposerPayloadIDsCache()),
+		WithStateNotifier(&mock.MockStateNotifier{}),
+	}
+	service, err := NewService(ctx, opts...)
+	require.NoError(t, err)
+	service.genesisTime = time.Now()
+	service.cfg.ExecutionEngineCaller = &mockPOW.EngineClient{PayloadIDBytes: &v1.PayloadIDBytes{1}}
+	headRoot := [32]byte{'a'}
+	require.NoError(t, fcs.InsertOptimisticBlock(ctx, 0, headRoot, [32]byte{}, params.BeaconConfig().ZeroHash, 0, 0))
+
+	st, _ := util.DeterministicGenesisState(t, 64)
+	blk, err := wrapper.WrappedBeaconBlock(&ethpb.BeaconBlockBellatrix{
+		Body: &ethpb.BeaconBlockBodyBellatrix{
+			ExecutionPayload: &v1.ExecutionPayload{BlockHash: bytesutil.PadTo([]byte{'b'}, fieldparams.RootLength)},
+		},
+	})
+	require.NoError(t, err)
+	payloadID, err := service.notifyForkchoiceUpdate(ctx, &notifyForkchoiceUpdateArg{
+		headState: st,
+		headRoot:  headRoot,
+		headBlock: blk,
+	})
+	require.NoError(t, err)
+	require.DeepEqual(t, &v1.PayloadIDBytes{1}, payloadID)
+	_, _, tracked := service.cfg.ProposerSlotIndexCache.GetProposerPayloadIDs(1)
+	assert.Equal(t, false, tracked, "Untracked proposer was cached")
+}
+
 func Test_UpdateLastValidatedCheckpoint(t *testing.T) {
 	params.SetupTestConfigCleanup(t)
 	params.OverrideBeaconConfig(params.MainnetConfig())
@@ -626,11 +626,11 @@ func getBlockPayloadHash(blk interfaces.BeaconBlock) ([32]byte, error) {
 	if blocks.IsPreBellatrixVersion(blk.Version()) {
 		return payloadHash, nil
 	}
-	payload, err := blk.Body().ExecutionPayload()
+	blockHash, err := blocks.ExecutionBlockHash(blk.Body())
 	if err != nil {
 		return payloadHash, err
 	}
-	return bytesutil.ToBytes32(payload.BlockHash), nil
+	return bytesutil.ToBytes32(blockHash), nil
 }
 
 // This saves post state info to DB or cache. This also saves post state info to fork choice store.
@@ -417,6 +417,9 @@ func (s *Service) insertFinalizedDeposits(ctx context.Context, fRoot [32]byte) e
 	// done as the state cannot represent signed integers.
 	eth1DepositIndex -= 1
 	s.cfg.DepositCache.InsertFinalizedDeposits(ctx, int64(eth1DepositIndex))
+	if err := s.saveDepositSnapshot(ctx, finalizedState); err != nil {
+		log.WithError(err).Error("Could not save deposit snapshot")
+	}
 	// Deposit proofs are only used during state transition and can be safely removed to save space.
 	if err = s.cfg.DepositCache.PruneProofs(ctx, int64(eth1DepositIndex)); err != nil {
 		return errors.Wrap(err, "could not prune deposit proofs")
@@ -424,6 +427,31 @@ func (s *Service) insertFinalizedDeposits(ctx context.Context, fRoot [32]byte) e
 	return nil
 }
 
+// saveDepositSnapshot saves a snapshot of the finalized deposits, once they cover all the deposits of the
+// finalized state's eth1 data. Nodes can then be started from the snapshot instead of replaying all deposit logs.
+func (s *Service) saveDepositSnapshot(ctx context.Context, finalizedState state.BeaconState) error {
+	ctx, span := trace.StartSpan(ctx, "blockChain.saveDepositSnapshot")
+	defer span.End()
+
+	eth1Data := finalizedState.Eth1Data()
+	if eth1Data == nil || eth1Data.DepositCount == 0 || eth1Data.DepositCount != finalizedState.Eth1DepositIndex() {
+		return nil
+	}
+	// The deposit cache may not have caught up with the finalized deposits yet.
+	if uint64(s.cfg.DepositCache.FinalizedDeposits(ctx).MerkleTrieIndex+1) != eth1Data.DepositCount {
+		return nil
+	}
+	snapshot, err := s.cfg.DepositCache.FinalizedDepositsSnapshot(ctx, bytesutil.ToBytes32(eth1Data.BlockHash))
+	if err != nil {
+		return err
+	}
+	if snapshot.DepositRoot != bytesutil.ToBytes32(eth1Data.DepositRoot) {
+		return fmt.Errorf("finalized deposits root %#x does not match eth1 data deposit root %#x",
+			snapshot.DepositRoot, eth1Data.DepositRoot)
+	}
+	return s.cfg.BeaconDB.SaveDepositSnapshot(ctx, snapshot)
+}
+
 // The deletes input attestations from the attestation pool, so proposers don't include them in a block for the future.
 func (s *Service) deletePoolAtts(atts []*ethpb.Attestation) error {
 	for _, att := range atts {
@@ -31,6 +31,7 @@ import (
 	"github.com/prysmaticlabs/prysm/consensus-types/interfaces"
 	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/consensus-types/wrapper"
+	"github.com/prysmaticlabs/prysm/container/trie"
 	"github.com/prysmaticlabs/prysm/crypto/bls"
 	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
 	enginev1 "github.com/prysmaticlabs/prysm/proto/engine/v1"
@@ -1628,6 +1629,51 @@ func TestInsertFinalizedDeposits(t *testing.T) {
 	}
 }
 
+func TestInsertFinalizedDeposits_SavesDepositSnapshot(t *testing.T) {
+	ctx := context.Background()
+	opts := testServiceOptsWithDB(t)
+	depositCache, err := depositcache.New()
+	require.NoError(t, err)
+	blockHash := bytesutil.PadTo([]byte("eth1 block"), 32)
+	opts = append(opts, WithDepositCache(depositCache))
+	service, err := NewService(ctx, opts...)
+	require.NoError(t, err)
+
+	depositTrie, err := trie.NewTrie(params.BeaconConfig().DepositContractTreeDepth)
+	require.NoError(t, err)
+	zeroSig := [96]byte{}
+	for i := 0; i < 8; i++ {
+		d := &ethpb.Deposit{Data: &ethpb.Deposit_Data{
+			PublicKey:             bytesutil.PadTo([]byte{byte(i)}, fieldparams.BLSPubkeyLength),
+			WithdrawalCredentials: params.BeaconConfig().ZeroHash[:],
+			Amount:                0,
+			Signature:             zeroSig[:],
+		}}
+		leaf, err := d.Data.HashTreeRoot()
+		require.NoError(t, err)
+		require.NoError(t, depositTrie.Insert(leaf[:], i))
+		root, err := depositTrie.HashTreeRoot()
+		require.NoError(t, err)
+		require.NoError(t, depositCache.InsertDeposit(ctx, d, 100, int64(i), root))
+	}
+	depositRoot, err := depositTrie.HashTreeRoot()
+	require.NoError(t, err)
+
+	gs, _ := util.DeterministicGenesisState(t, 32)
+	require.NoError(t, gs.SetEth1Data(&ethpb.Eth1Data{DepositCount: 8, DepositRoot: depositRoot[:], BlockHash: blockHash}))
+	require.NoError(t, gs.SetEth1DepositIndex(8))
+	require.NoError(t, service.cfg.StateGen.SaveState(ctx, [32]byte{'m', 'o', 'c', 'k'}, gs))
+	require.NoError(t, service.insertFinalizedDeposits(ctx, [32]byte{'m', 'o', 'c', 'k'}))
+
+	snapshot, err := service.cfg.BeaconDB.DepositSnapshot(ctx)
+	require.NoError(t, err)
+	require.NotNil(t, snapshot)
+	assert.Equal(t, uint64(8), snapshot.DepositCount)
+	assert.Equal(t, depositRoot, snapshot.DepositRoot)
+	assert.Equal(t, bytesutil.ToBytes32(blockHash), snapshot.ExecutionBlockHash)
+	assert.Equal(t, uint64(100), snapshot.ExecutionBlockHeight)
+}
+
 func TestInsertFinalizedDeposits_MultipleFinalizedRoutines(t *testing.T) {
 	ctx := context.Background()
 	opts := testServiceOptsWithDB(t)
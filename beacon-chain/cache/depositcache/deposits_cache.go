@@ -54,7 +54,10 @@ type DepositCache struct {
 	deposits          []*ethpb.DepositContainer
 	finalizedDeposits *FinalizedDeposits
 	depositsByKey     map[[fieldparams.BLSPubkeyLength]byte][]*ethpb.DepositContainer
-	depositsLock      sync.RWMutex
+	// Deposit tree snapshot the finalized deposits were initialized from, if any. Deposits covered
+	// by the snapshot are not kept as deposit containers.
+	depositSnapshot *trie.DepositTreeSnapshot
+	depositsLock    sync.RWMutex
 }
 
 // New instantiates a new deposit cache
@@ -91,8 +94,8 @@ func (dc *DepositCache) InsertDeposit(ctx context.Context, d *ethpb.Deposit, blo
 	dc.depositsLock.Lock()
 	defer dc.depositsLock.Unlock()
 
-	if int(index) != len(dc.deposits) {
-		return errors.Errorf("wanted deposit with index %d to be inserted but received %d", len(dc.deposits), index)
+	if next := dc.nextDepositIndex(); index != next {
+		return errors.Errorf("wanted deposit with index %d to be inserted but received %d", next, index)
 	}
 	// Keep the slice sorted on insertion in order to avoid costly sorting on retrieval.
 	heightIdx := sort.Search(len(dc.deposits), func(i int) bool { return dc.deposits[i].Index >= index })
@@ -145,8 +148,8 @@ func (dc *DepositCache) InsertFinalizedDeposits(ctx context.Context, eth1Deposit
 	}
 	// In the event we have less deposits than we need to
 	// finalize we finalize till the index on which we do have it.
-	if len(dc.deposits) <= int(eth1DepositIndex) {
-		eth1DepositIndex = int64(len(dc.deposits)) - 1
+	if lastIndex := dc.deposits[len(dc.deposits)-1].Index; lastIndex < eth1DepositIndex {
+		eth1DepositIndex = lastIndex
 	}
 	// If we finalize to some lower deposit index, we
 	// ignore it.
@@ -178,6 +181,71 @@ func (dc *DepositCache) InsertFinalizedDeposits(ctx context.Context, eth1Deposit
 	}
 }
 
+// InsertDepositSnapshot initializes the finalized deposits trie from a deposit tree snapshot, unless
+// more deposits have already been finalized. Deposits covered by the snapshot are only known through
+// the snapshot afterwards, so the next deposit to be inserted is the first one after it.
+func (dc *DepositCache) InsertDepositSnapshot(ctx context.Context, snapshot *trie.DepositTreeSnapshot) error {
+	_, span := trace.StartSpan(ctx, "DepositsCache.InsertDepositSnapshot")
+	defer span.End()
+	dc.depositsLock.Lock()
+	defer dc.depositsLock.Unlock()
+
+	if snapshot == nil {
+		return errors.New("nil deposit snapshot")
+	}
+	lastIndex := int64(snapshot.DepositCount) - 1 // lint:ignore uintcast -- deposit count will not exceed int64 in your lifetime.
+	if lastIndex <= dc.finalizedDeposits.MerkleTrieIndex {
+		return nil
+	}
+	if len(dc.deposits) > 0 && dc.deposits[0].Index > lastIndex+1 {
+		return errors.Errorf("deposit snapshot with %d deposits leaves a gap before cached deposit %d",
+			snapshot.DepositCount, dc.deposits[0].Index)
+	}
+	depositTrie, err := trie.CreateTrieFromSnapshot(snapshot, params.BeaconConfig().DepositContractTreeDepth)
+	if err != nil {
+		return err
+	}
+	dc.finalizedDeposits = &FinalizedDeposits{
+		Deposits:        depositTrie,
+		MerkleTrieIndex: lastIndex,
+	}
+	dc.depositSnapshot = snapshot
+	return nil
+}
+
+// FinalizedDepositsSnapshot returns a deposit tree snapshot of the finalized deposits, for the execution block
+// with the given hash at which the deposit contract held exactly these deposits. The execution block height
+// of the snapshot is the one of the last finalized deposit, after which the deposit tree did not change.
+func (dc *DepositCache) FinalizedDepositsSnapshot(
+	ctx context.Context, executionBlockHash [32]byte,
+) (*trie.DepositTreeSnapshot, error) {
+	_, span := trace.StartSpan(ctx, "DepositsCache.FinalizedDepositsSnapshot")
+	defer span.End()
+	dc.depositsLock.RLock()
+	defer dc.depositsLock.RUnlock()
+
+	lastIndex := dc.finalizedDeposits.MerkleTrieIndex
+	var height uint64
+	i := sort.Search(len(dc.deposits), func(i int) bool { return dc.deposits[i].Index >= lastIndex })
+	switch {
+	case i < len(dc.deposits) && dc.deposits[i].Index == lastIndex:
+		height = dc.deposits[i].Eth1BlockHeight
+	case dc.depositSnapshot != nil && int64(dc.depositSnapshot.DepositCount)-1 == lastIndex: // lint:ignore uintcast -- deposit count will not exceed int64 in your lifetime.
+		height = dc.depositSnapshot.ExecutionBlockHeight
+	default:
+		return nil, errors.Errorf("no execution block height known for finalized deposit %d", lastIndex)
+	}
+	return dc.finalizedDeposits.Deposits.Snapshot(executionBlockHash, height)
+}
+
+// nextDepositIndex returns the index of the next deposit to be inserted into the cache.
+func (dc *DepositCache) nextDepositIndex() int64 {
+	if len(dc.deposits) > 0 {
+		return dc.deposits[len(dc.deposits)-1].Index + 1
+	}
+	return dc.finalizedDeposits.MerkleTrieIndex + 1
+}
+
 // AllDepositContainers returns all historical deposit containers.
 func (dc *DepositCache) AllDepositContainers(ctx context.Context) []*ethpb.DepositContainer {
 	_, span := trace.StartSpan(ctx, "DepositsCache.AllDepositContainers")
@@ -217,12 +285,18 @@ func (dc *DepositCache) DepositsNumberAndRootAtHeight(ctx context.Context, block
 	dc.depositsLock.RLock()
 	defer dc.depositsLock.RUnlock()
 	heightIdx := sort.Search(len(dc.deposits), func(i int) bool { return dc.deposits[i].Eth1BlockHeight > blockHeight.Uint64() })
-	// send the deposit root of the empty trie, if eth1follow distance is greater than the time of the earliest
-	// deposit.
 	if heightIdx == 0 {
+		// Without any later deposit, the deposits at the height are the ones of the snapshot the
+		// cache was initialized from.
+		if dc.depositSnapshot != nil && blockHeight.Uint64() >= dc.depositSnapshot.ExecutionBlockHeight {
+			return dc.depositSnapshot.DepositCount, dc.depositSnapshot.DepositRoot
+		}
+		// send the deposit root of the empty trie, if eth1follow distance is greater than the time of the earliest
+		// deposit.
 		return 0, [32]byte{}
 	}
-	return uint64(heightIdx), bytesutil.ToBytes32(dc.deposits[heightIdx-1].DepositRoot)
+	last := dc.deposits[heightIdx-1]
+	return uint64(last.Index + 1), bytesutil.ToBytes32(last.DepositRoot)
 }
 
 // DepositByPubkey looks through historical deposits and finds one which contains
@@ -289,11 +363,16 @@ func (dc *DepositCache) PruneProofs(ctx context.Context, untilDepositIndex int64
 	dc.depositsLock.Lock()
 	defer dc.depositsLock.Unlock()
 
-	if untilDepositIndex >= int64(len(dc.deposits)) {
-		untilDepositIndex = int64(len(dc.deposits) - 1)
+	if len(dc.deposits) == 0 {
+		return nil
+	}
+	// Deposits covered by a deposit snapshot are not in the cache.
+	untilPos := untilDepositIndex - dc.deposits[0].Index
+	if untilPos >= int64(len(dc.deposits)) {
+		untilPos = int64(len(dc.deposits) - 1)
 	}
 
-	for i := untilDepositIndex; i >= 0; i-- {
+	for i := untilPos; i >= 0; i-- {
 		if ctx.Err() != nil {
 			return ctx.Err()
 		}
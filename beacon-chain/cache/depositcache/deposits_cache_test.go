@@ -836,6 +836,77 @@ func TestFinalizedDeposits_ReturnsTrieCorrectly(t *testing.T) {
 	assert.Equal(t, fd.Deposits.NumOfItems(), depositTrie.NumOfItems())
 }
 
+func TestInsertDepositSnapshot(t *testing.T) {
+	ctx := context.Background()
+	generateDeposit := func(index int64) *ethpb.Deposit {
+		return &ethpb.Deposit{
+			Data: &ethpb.Deposit_Data{
+				PublicKey:             bytesutil.PadTo([]byte{uint8(index)}, 48),
+				WithdrawalCredentials: make([]byte, 32),
+				Signature:             make([]byte, 96),
+			},
+		}
+	}
+
+	// A node which followed all deposits and finalized the first five of them.
+	full, err := New()
+	require.NoError(t, err)
+	fullTrie, err := trie.NewTrie(params.BeaconConfig().DepositContractTreeDepth)
+	require.NoError(t, err)
+	for i := int64(0); i < 8; i++ {
+		depHash, err := generateDeposit(i).Data.HashTreeRoot()
+		require.NoError(t, err)
+		require.NoError(t, fullTrie.Insert(depHash[:], int(i)))
+		root, err := fullTrie.HashTreeRoot()
+		require.NoError(t, err)
+		require.NoError(t, full.InsertDeposit(ctx, generateDeposit(i), uint64(10+i), i, root))
+	}
+	full.InsertFinalizedDeposits(ctx, 4)
+	snapshot, err := full.FinalizedDepositsSnapshot(ctx, [32]byte{'a'})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(5), snapshot.DepositCount)
+	assert.Equal(t, uint64(14), snapshot.ExecutionBlockHeight)
+
+	// A node initialized from the snapshot only needs the deposits after it.
+	dc, err := New()
+	require.NoError(t, err)
+	require.NoError(t, dc.InsertDepositSnapshot(ctx, snapshot))
+	count, root := dc.DepositsNumberAndRootAtHeight(ctx, big.NewInt(14))
+	assert.Equal(t, uint64(5), count)
+	assert.Equal(t, snapshot.DepositRoot, root)
+	assert.ErrorContains(t, "wanted deposit with index 5 to be inserted but received 0",
+		dc.InsertDeposit(ctx, generateDeposit(0), 10, 0, [32]byte{}))
+	for _, ctr := range full.AllDepositContainers(ctx)[5:] {
+		require.NoError(t, dc.InsertDeposit(ctx, ctr.Deposit, ctr.Eth1BlockHeight, ctr.Index, bytesutil.ToBytes32(ctr.DepositRoot)))
+	}
+	wantCount, wantRoot := full.DepositsNumberAndRootAtHeight(ctx, big.NewInt(16))
+	count, root = dc.DepositsNumberAndRootAtHeight(ctx, big.NewInt(16))
+	assert.Equal(t, wantCount, count)
+	assert.Equal(t, wantRoot, root)
+
+	full.InsertFinalizedDeposits(ctx, 6)
+	dc.InsertFinalizedDeposits(ctx, 6)
+	require.NoError(t, dc.PruneProofs(ctx, 6))
+	want, err := full.FinalizedDepositsSnapshot(ctx, [32]byte{'b'})
+	require.NoError(t, err)
+	got, err := dc.FinalizedDepositsSnapshot(ctx, [32]byte{'b'})
+	require.NoError(t, err)
+	assert.DeepEqual(t, want, got)
+	assert.Equal(t, uint64(16), got.ExecutionBlockHeight)
+
+	// Without deposits after the snapshot, the execution block height is the one of the snapshot.
+	fromSnapshot, err := New()
+	require.NoError(t, err)
+	require.NoError(t, fromSnapshot.InsertDepositSnapshot(ctx, snapshot))
+	got, err = fromSnapshot.FinalizedDepositsSnapshot(ctx, [32]byte{'a'})
+	require.NoError(t, err)
+	assert.DeepEqual(t, snapshot, got)
+
+	// An older snapshot does not replace the finalized deposits.
+	require.NoError(t, dc.InsertDepositSnapshot(ctx, snapshot))
+	assert.Equal(t, int64(6), dc.FinalizedDeposits(ctx).MerkleTrieIndex)
+}
+
 func TestPruneProofs_Ok(t *testing.T) {
 	dc, err := New()
 	require.NoError(t, err)
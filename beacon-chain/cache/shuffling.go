@@ -0,0 +1,71 @@
+//go:build !fuzz
+// +build !fuzz
+
+package cache
+
+import (
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	lruwrpr "github.com/prysmaticlabs/prysm/cache/lru"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+)
+
+const (
+	// maxShufflingCacheSize defines the max number of attester shufflings the cache can hold. Forks sharing
+	// a decision root share the same shuffling, so a handful of entries covers long lived forks.
+	maxShufflingCacheSize = int(16)
+)
+
+var (
+	// ShufflingCacheMiss tracks the number of shuffling requests that aren't present in the cache.
+	ShufflingCacheMiss = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "shuffling_cache_miss",
+		Help: "The number of shuffling requests that aren't present in the cache.",
+	})
+	// ShufflingCacheHit tracks the number of shuffling requests that are in the cache.
+	ShufflingCacheHit = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "shuffling_cache_hit",
+		Help: "The number of shuffling requests that are present in the cache.",
+	})
+)
+
+// shufflingKey identifies a shuffling by its epoch and decision root.
+type shufflingKey struct {
+	epoch types.Epoch
+	root  [32]byte
+}
+
+// ShufflingCache is a struct with 1 queue for looking up attester shufflings by epoch and decision root.
+type ShufflingCache struct {
+	cache *lru.Cache
+}
+
+// NewShufflingCache creates a new shuffling cache for storing/accessing the attester shuffling of an epoch.
+func NewShufflingCache() *ShufflingCache {
+	return &ShufflingCache{
+		cache: lruwrpr.New(maxShufflingCacheSize),
+	}
+}
+
+// Shuffling returns the shuffling of the epoch for the given decision root, or nil if it is not cached.
+func (c *ShufflingCache) Shuffling(epoch types.Epoch, decisionRoot [32]byte) *Shuffling {
+	item, exists := c.cache.Get(shufflingKey{epoch: epoch, root: decisionRoot})
+	if !exists || item == nil {
+		ShufflingCacheMiss.Inc()
+		return nil
+	}
+	ShufflingCacheHit.Inc()
+	return item.(*Shuffling)
+}
+
+// HasShuffling returns true if the shuffling of the epoch for the given decision root is cached.
+func (c *ShufflingCache) HasShuffling(epoch types.Epoch, decisionRoot [32]byte) bool {
+	return c.cache.Contains(shufflingKey{epoch: epoch, root: decisionRoot})
+}
+
+// AddShuffling adds the shuffling to the cache, evicting the least recently used shuffling
+// if the cache is full.
+func (c *ShufflingCache) AddShuffling(s *Shuffling) {
+	c.cache.Add(shufflingKey{epoch: s.Epoch, root: s.DecisionRoot}, s)
+}
@@ -0,0 +1,31 @@
+//go:build fuzz
+// +build fuzz
+
+// This file is used in fuzzer builds to bypass shuffling caches.
+package cache
+
+import types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+
+// FakeShufflingCache is a struct with 1 queue for looking up attester shufflings by epoch and decision root.
+type FakeShufflingCache struct {
+}
+
+// NewShufflingCache creates a new shuffling cache for storing/accessing the attester shuffling of an epoch.
+func NewShufflingCache() *FakeShufflingCache {
+	return &FakeShufflingCache{}
+}
+
+// Shuffling returns the shuffling of the epoch for the given decision root, or nil if it is not cached.
+func (c *FakeShufflingCache) Shuffling(epoch types.Epoch, decisionRoot [32]byte) *Shuffling {
+	return nil
+}
+
+// HasShuffling returns true if the shuffling of the epoch for the given decision root is cached.
+func (c *FakeShufflingCache) HasShuffling(epoch types.Epoch, decisionRoot [32]byte) bool {
+	return false
+}
+
+// AddShuffling adds the shuffling to the cache, evicting the least recently used shuffling
+// if the cache is full.
+func (c *FakeShufflingCache) AddShuffling(s *Shuffling) {
+}
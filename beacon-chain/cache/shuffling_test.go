@@ -0,0 +1,60 @@
+//go:build !fuzz
+// +build !fuzz
+
+package cache
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/config/params"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+)
+
+func TestShufflingCache_AddShuffling(t *testing.T) {
+	c := NewShufflingCache()
+	s := &Shuffling{
+		Epoch:           1,
+		DecisionRoot:    [32]byte{'A'},
+		CommitteeCount:  3,
+		ShuffledIndices: []types.ValidatorIndex{1, 2, 3, 4, 5, 6},
+	}
+	assert.Equal(t, false, c.HasShuffling(s.Epoch, s.DecisionRoot))
+	if c.Shuffling(s.Epoch, s.DecisionRoot) != nil {
+		t.Error("Expected shuffling not to exist in empty cache")
+	}
+
+	c.AddShuffling(s)
+	assert.Equal(t, true, c.HasShuffling(s.Epoch, s.DecisionRoot))
+	assert.Equal(t, s, c.Shuffling(s.Epoch, s.DecisionRoot))
+	assert.Equal(t, false, c.HasShuffling(s.Epoch+1, s.DecisionRoot))
+	assert.Equal(t, false, c.HasShuffling(s.Epoch, [32]byte{'B'}))
+}
+
+func TestShufflingCache_MaxSize(t *testing.T) {
+	c := NewShufflingCache()
+	for i := 0; i <= maxShufflingCacheSize; i++ {
+		c.AddShuffling(&Shuffling{Epoch: types.Epoch(i)})
+	}
+	assert.Equal(t, false, c.HasShuffling(0, [32]byte{}))
+	assert.Equal(t, true, c.HasShuffling(types.Epoch(maxShufflingCacheSize), [32]byte{}))
+}
+
+func TestShuffling_Committee(t *testing.T) {
+	indices := make([]types.ValidatorIndex, params.BeaconConfig().SlotsPerEpoch.Mul(4))
+	for i := range indices {
+		indices[i] = types.ValidatorIndex(i)
+	}
+	s := &Shuffling{
+		CommitteeCount:  uint64(params.BeaconConfig().SlotsPerEpoch.Mul(2)),
+		ShuffledIndices: indices,
+	}
+
+	committee, err := s.Committee(params.BeaconConfig().SlotsPerEpoch+1, 1)
+	require.NoError(t, err)
+	assert.DeepEqual(t, []types.ValidatorIndex{6, 7}, committee)
+	committee, err = s.Committee(0, 0)
+	require.NoError(t, err)
+	assert.DeepEqual(t, []types.ValidatorIndex{0, 1}, committee)
+}
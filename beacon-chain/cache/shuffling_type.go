@@ -0,0 +1,38 @@
+package cache
+
+import (
+	"errors"
+
+	"github.com/prysmaticlabs/prysm/config/params"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/container/slice"
+	mathutil "github.com/prysmaticlabs/prysm/math"
+)
+
+// Shuffling defines the cached attester shuffling of an epoch. The shuffling is fully determined
+// by the epoch and its decision block root, the spec's shuffling dependent root.
+type Shuffling struct {
+	Epoch           types.Epoch
+	DecisionRoot    [32]byte
+	CommitteeCount  uint64
+	ShuffledIndices []types.ValidatorIndex
+}
+
+// Committee returns the beacon committee of the shuffling at the given slot and committee index.
+func (s *Shuffling) Committee(slot types.Slot, index types.CommitteeIndex) ([]types.ValidatorIndex, error) {
+	committeesPerSlot := s.CommitteeCount / uint64(params.BeaconConfig().SlotsPerEpoch)
+	if committeesPerSlot < 1 {
+		committeesPerSlot = 1
+	}
+	indexOffset, err := mathutil.Add64(uint64(index), uint64(slot.ModSlot(params.BeaconConfig().SlotsPerEpoch).Mul(committeesPerSlot)))
+	if err != nil {
+		return nil, err
+	}
+	validatorCount := uint64(len(s.ShuffledIndices))
+	start := slice.SplitOffset(validatorCount, s.CommitteeCount, indexOffset)
+	end := slice.SplitOffset(validatorCount, s.CommitteeCount, indexOffset+1)
+	if end > validatorCount || end < start {
+		return nil, errors.New("requested index out of bound")
+	}
+	return s.ShuffledIndices[start:end], nil
+}
@@ -7,9 +7,11 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/time"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/consensus-types/forks/bellatrix"
 	"github.com/prysmaticlabs/prysm/consensus-types/interfaces"
 	"github.com/prysmaticlabs/prysm/consensus-types/wrapper"
+	"github.com/prysmaticlabs/prysm/encoding/ssz"
 	enginev1 "github.com/prysmaticlabs/prysm/proto/engine/v1"
 	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/runtime/version"
@@ -67,7 +69,20 @@ func IsExecutionBlock(body interfaces.BeaconBlockBody) (bool, error) {
 	payload, err := body.ExecutionPayload()
 	switch {
 	case errors.Is(err, wrapper.ErrUnsupportedField):
-		return false, nil
+		// Blinded blocks only carry the header of their execution payload.
+		header, err := body.ExecutionPayloadHeader()
+		switch {
+		case errors.Is(err, wrapper.ErrUnsupportedField):
+			return false, nil
+		case err != nil:
+			return false, err
+		default:
+		}
+		empty, err := isEmptyPayloadHeader(header)
+		if err != nil {
+			return false, err
+		}
+		return !empty, nil
 	case err != nil:
 		return false, err
 	default:
@@ -75,6 +90,41 @@ func IsExecutionBlock(body interfaces.BeaconBlockBody) (bool, error) {
 	return !bellatrix.IsEmptyPayload(payload), nil
 }
 
+// isEmptyPayloadHeader returns whether the header is the one of an empty execution payload. The
+// transactions root of such header is either zero or the root of an empty transactions list.
+func isEmptyPayloadHeader(h *ethpb.ExecutionPayloadHeader) (bool, error) {
+	emptyTxRoot, err := ssz.TransactionsRoot([][]byte{})
+	if err != nil {
+		return false, err
+	}
+	if bytes.Equal(h.TransactionsRoot, emptyTxRoot[:]) {
+		h = ethpb.CopyExecutionPayloadHeader(h)
+		h.TransactionsRoot = make([]byte, fieldparams.RootLength)
+	}
+	return bellatrix.IsEmptyHeader(h), nil
+}
+
+// ExecutionBlockHash returns the hash of the execution block committed to by the block body. It is
+// read from the execution payload, or from the execution payload header of blinded blocks.
+func ExecutionBlockHash(body interfaces.BeaconBlockBody) ([]byte, error) {
+	if body == nil {
+		return nil, errors.New("nil block body")
+	}
+	payload, err := body.ExecutionPayload()
+	switch {
+	case errors.Is(err, wrapper.ErrUnsupportedField):
+		header, err := body.ExecutionPayloadHeader()
+		if err != nil {
+			return nil, err
+		}
+		return header.BlockHash, nil
+	case err != nil:
+		return nil, err
+	default:
+	}
+	return payload.BlockHash, nil
+}
+
 // IsExecutionEnabled returns true if the beacon chain can begin executing.
 // Meaning the payload header is beacon state is non-empty or the payload in block body is non-empty.
 //
@@ -380,10 +380,44 @@ func Test_IsExecutionBlock(t *testing.T) {
 			got, err := blocks.IsExecutionBlock(wrappedBlock.Body())
 			require.NoError(t, err)
 			require.Equal(t, tt.want, got)
+
+			header, err := bellatrix.PayloadToHeader(tt.payload)
+			require.NoError(t, err)
+			blinded := util.NewBlindedBeaconBlockBellatrix()
+			blinded.Block.Body.ExecutionPayloadHeader = header
+			wrappedBlock, err = wrapper.WrappedBeaconBlock(blinded.Block)
+			require.NoError(t, err)
+			got, err = blocks.IsExecutionBlock(wrappedBlock.Body())
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
 		})
 	}
 }
 
+func Test_ExecutionBlockHash(t *testing.T) {
+	hash := bytesutil.PadTo([]byte{'a'}, fieldparams.RootLength)
+	blk := util.NewBeaconBlockBellatrix()
+	blk.Block.Body.ExecutionPayload.BlockHash = hash
+	wrappedBlock, err := wrapper.WrappedBeaconBlock(blk.Block)
+	require.NoError(t, err)
+	got, err := blocks.ExecutionBlockHash(wrappedBlock.Body())
+	require.NoError(t, err)
+	require.DeepEqual(t, hash, got)
+
+	blinded := util.NewBlindedBeaconBlockBellatrix()
+	blinded.Block.Body.ExecutionPayloadHeader.BlockHash = hash
+	wrappedBlock, err = wrapper.WrappedBeaconBlock(blinded.Block)
+	require.NoError(t, err)
+	got, err = blocks.ExecutionBlockHash(wrappedBlock.Body())
+	require.NoError(t, err)
+	require.DeepEqual(t, hash, got)
+
+	wrappedBlock, err = wrapper.WrappedBeaconBlock(util.NewBeaconBlockAltair().Block)
+	require.NoError(t, err)
+	_, err = blocks.ExecutionBlockHash(wrappedBlock.Body())
+	require.ErrorIs(t, err, wrapper.ErrUnsupportedField)
+}
+
 func Test_IsExecutionEnabled(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -2,11 +2,17 @@
 // during the runtime of a beacon node.
 package block
 
-import "github.com/prysmaticlabs/prysm/consensus-types/interfaces"
+import (
+	"time"
+
+	"github.com/prysmaticlabs/prysm/consensus-types/interfaces"
+)
 
 const (
 	// ReceivedBlock is sent after a block has been received by the beacon node via p2p or RPC.
 	ReceivedBlock = iota + 1
+	// GossipBlockReceived is sent after a block received over gossip has passed validation, before it is imported.
+	GossipBlockReceived
 )
 
 // ReceivedBlockData is the data sent with ReceivedBlock events.
@@ -14,3 +20,11 @@ type ReceivedBlockData struct {
 	SignedBlock  interfaces.SignedBeaconBlock
 	IsOptimistic bool
 }
+
+// GossipBlockReceivedData is the data sent with GossipBlockReceived events.
+type GossipBlockReceivedData struct {
+	SignedBlock interfaces.SignedBeaconBlock
+	BlockRoot   [32]byte
+	// ArrivalTime is the time at which the block was received over gossip.
+	ArrivalTime time.Time
+}
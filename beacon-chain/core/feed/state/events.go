@@ -26,6 +26,14 @@ const (
 	FinalizedCheckpoint
 	// NewHead of the chain event.
 	NewHead
+	// PayloadAttributes is sent when the payload attributes of an upcoming block proposal are computed.
+	PayloadAttributes
+	// SyncStatusChanged is sent when the node transitions between syncing, optimistic and synced.
+	SyncStatusChanged
+	// OptimisticToValid is sent when an optimistically imported block has its execution payload validated.
+	OptimisticToValid
+	// InvalidatedPayload is sent when the execution engine reports optimistically imported blocks as invalid.
+	InvalidatedPayload
 )
 
 // BlockProcessedData is the data sent with BlockProcessed events.
@@ -26,6 +26,7 @@ import (
 var (
 	committeeCache       = cache.NewCommitteesCache()
 	proposerIndicesCache = cache.NewProposerIndicesCache()
+	shufflingCache       = cache.NewShufflingCache()
 )
 
 // SlotCommitteeCount returns the number of beacon committees of a slot. The
@@ -75,6 +76,11 @@ func SlotCommitteeCount(activeValidatorCount uint64) uint64 {
 //    )
 func BeaconCommitteeFromState(ctx context.Context, state state.ReadOnlyBeaconState, slot types.Slot, committeeIndex types.CommitteeIndex) ([]types.ValidatorIndex, error) {
 	epoch := slots.ToEpoch(slot)
+	if decisionRoot, ok := ShufflingDecisionRoot(state, epoch); ok {
+		if shuffling := shufflingCache.Shuffling(epoch, decisionRoot); shuffling != nil {
+			return shuffling.Committee(slot, committeeIndex)
+		}
+	}
 	seed, err := Seed(state, epoch, params.BeaconConfig().DomainBeaconAttester)
 	if err != nil {
 		return nil, errors.Wrap(err, "could not get seed")
@@ -283,15 +289,38 @@ func ShuffledIndices(s state.ReadOnlyBeaconState, epoch types.Epoch) ([]types.Va
 	return UnshuffleList(indices, seed)
 }
 
+// ShufflingDecisionRoot returns the root of the block which determines the attester shuffling of the
+// given epoch, the spec's shuffling dependent root. It is the block root at the last slot of epoch - 2,
+// or the genesis block root for the first two epochs. It returns false if the root is not in the
+// block roots of the state, or is the zero hash.
+func ShufflingDecisionRoot(state state.ReadOnlyBeaconState, epoch types.Epoch) ([32]byte, bool) {
+	var decisionSlot types.Slot
+	if epoch > 1 {
+		prevEpochStart, err := slots.EpochStart(epoch - 1)
+		if err != nil {
+			return [32]byte{}, false
+		}
+		decisionSlot = prevEpochStart - 1
+	}
+	root, err := BlockRootAtSlot(state, decisionSlot)
+	if err != nil || bytes.Equal(root, params.BeaconConfig().ZeroHash[:]) {
+		return [32]byte{}, false
+	}
+	return bytesutil.ToBytes32(root), true
+}
+
 // UpdateCommitteeCache gets called at the beginning of every epoch to cache the committee shuffled indices
 // list with committee index and epoch number. It caches the shuffled indices for current epoch and next epoch.
+// The shuffled indices are also cached by epoch and decision root, so they can be shared across forks.
 func UpdateCommitteeCache(state state.ReadOnlyBeaconState, epoch types.Epoch) error {
 	for _, e := range []types.Epoch{epoch, epoch + 1} {
 		seed, err := Seed(state, e, params.BeaconConfig().DomainBeaconAttester)
 		if err != nil {
 			return err
 		}
-		if committeeCache.HasEntry(string(seed[:])) {
+		decisionRoot, hasDecisionRoot := ShufflingDecisionRoot(state, e)
+		hasShuffling := !hasDecisionRoot || shufflingCache.HasShuffling(e, decisionRoot)
+		if committeeCache.HasEntry(string(seed[:])) && hasShuffling {
 			return nil
 		}
 
@@ -301,6 +330,14 @@ func UpdateCommitteeCache(state state.ReadOnlyBeaconState, epoch types.Epoch) er
 		}
 
 		count := SlotCommitteeCount(uint64(len(shuffledIndices)))
+		if !hasShuffling {
+			shufflingCache.AddShuffling(&cache.Shuffling{
+				Epoch:           e,
+				DecisionRoot:    decisionRoot,
+				CommitteeCount:  uint64(params.BeaconConfig().SlotsPerEpoch.Mul(count)),
+				ShuffledIndices: shuffledIndices,
+			})
+		}
 
 		// Store the sorted indices as well as shuffled indices. In current spec,
 		// sorted indices is required to retrieve proposer index. This is also
@@ -373,6 +410,7 @@ func UpdateProposerIndicesInCache(ctx context.Context, state state.ReadOnlyBeaco
 func ClearCache() {
 	committeeCache = cache.NewCommitteesCache()
 	proposerIndicesCache = cache.NewProposerIndicesCache()
+	shufflingCache = cache.NewShufflingCache()
 	syncCommitteeCache = cache.NewSyncCommittee()
 	balanceCache = cache.NewEffectiveBalanceCache()
 }
@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/time"
 	v1 "github.com/prysmaticlabs/prysm/beacon-chain/state/v1"
 	"github.com/prysmaticlabs/prysm/config/params"
@@ -629,3 +630,83 @@ func TestPrecomputeProposerIndices_Ok(t *testing.T) {
 	}
 	assert.DeepEqual(t, wantedProposerIndices, proposerIndices, "Did not precompute proposer indices correctly")
 }
+
+func TestShufflingDecisionRoot(t *testing.T) {
+	blockRoots := make([][]byte, params.BeaconConfig().SlotsPerHistoricalRoot)
+	for i := range blockRoots {
+		blockRoots[i] = bytesutil.PadTo(bytesutil.Bytes8(uint64(i+1)), 32)
+	}
+	blockRoots[params.BeaconConfig().SlotsPerEpoch.Mul(3)-1] = params.BeaconConfig().ZeroHash[:]
+	st, err := v1.InitializeFromProto(&ethpb.BeaconState{
+		Slot:       params.BeaconConfig().SlotsPerEpoch.Mul(3),
+		BlockRoots: blockRoots,
+	})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name  string
+		epoch types.Epoch
+		slot  types.Slot
+		ok    bool
+	}{
+		{name: "genesis epoch", epoch: 0, slot: 0, ok: true},
+		{name: "epoch 1", epoch: 1, slot: 0, ok: true},
+		{name: "current epoch", epoch: 3, slot: params.BeaconConfig().SlotsPerEpoch.Mul(2) - 1, ok: true},
+		{name: "next epoch with zero root", epoch: 4},
+		{name: "future epoch", epoch: 5},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			root, ok := ShufflingDecisionRoot(st, tt.epoch)
+			require.Equal(t, tt.ok, ok)
+			if tt.ok {
+				assert.DeepEqual(t, blockRoots[tt.slot], root[:])
+			}
+		})
+	}
+}
+
+func TestBeaconCommitteeFromState_ShufflingCache(t *testing.T) {
+	ClearCache()
+	validators := make([]*ethpb.Validator, params.BeaconConfig().MinGenesisActiveValidatorCount)
+	for i := 0; i < len(validators); i++ {
+		validators[i] = &ethpb.Validator{
+			ExitEpoch: params.BeaconConfig().FarFutureEpoch,
+		}
+	}
+	blockRoots := make([][]byte, params.BeaconConfig().SlotsPerHistoricalRoot)
+	for i := range blockRoots {
+		blockRoots[i] = bytesutil.PadTo([]byte{'a'}, 32)
+	}
+	st, err := v1.InitializeFromProto(&ethpb.BeaconState{
+		Slot:        params.BeaconConfig().SlotsPerEpoch,
+		Validators:  validators,
+		RandaoMixes: make([][]byte, params.BeaconConfig().EpochsPerHistoricalVector),
+		BlockRoots:  blockRoots,
+	})
+	require.NoError(t, err)
+	require.NoError(t, UpdateCommitteeCache(st, time.CurrentEpoch(st)))
+
+	// Both the current and the next epoch shufflings are cached by decision root.
+	root, ok := ShufflingDecisionRoot(st, 1)
+	require.Equal(t, true, ok)
+	require.Equal(t, true, shufflingCache.HasShuffling(1, root))
+	root, ok = ShufflingDecisionRoot(st, 2)
+	require.Equal(t, true, ok)
+	require.Equal(t, true, shufflingCache.HasShuffling(2, root))
+
+	activeIndices, err := ActiveValidatorIndices(context.Background(), st, 1)
+	require.NoError(t, err)
+	seed, err := Seed(st, 1, params.BeaconConfig().DomainBeaconAttester)
+	require.NoError(t, err)
+	slot := params.BeaconConfig().SlotsPerEpoch + 1
+	want, err := BeaconCommittee(context.Background(), activeIndices, seed, slot, 1)
+	require.NoError(t, err)
+
+	// The committee is served from the shuffling cache without the seed keyed committee cache.
+	committeeCache = cache.NewCommitteesCache()
+	got, err := BeaconCommitteeFromState(context.Background(), st, slot, 1)
+	require.NoError(t, err)
+	assert.DeepEqual(t, want, got)
+	assert.Equal(t, false, committeeCache.HasEntry(string(seed[:])))
+}
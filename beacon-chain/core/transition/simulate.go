@@ -0,0 +1,264 @@
+package transition
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/altair"
+	b "github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	v "github.com/prysmaticlabs/prysm/beacon-chain/core/validators"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/consensus-types/wrapper"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/runtime/version"
+	"go.opencensus.io/trace"
+)
+
+// Steps of a simulated block transition, as reported in OperationResult.
+const (
+	opProcessSlots     = "process_slots"
+	opBlockHeader      = "block_header"
+	opBlockSignature   = "block_signature"
+	opExecutionPayload = "execution_payload"
+	opRandao           = "randao"
+	opEth1Data         = "eth1_data"
+	opOperationLengths = "operation_lengths"
+	opProposerSlashing = "proposer_slashing"
+	opAttesterSlashing = "attester_slashing"
+	opAttestation      = "attestation"
+	opDeposit          = "deposit"
+	opVoluntaryExit    = "voluntary_exit"
+	opSyncAggregate    = "sync_aggregate"
+	opStateRoot        = "state_root"
+)
+
+// noOperationIndex is the index of the steps which are not block body operations.
+const noOperationIndex = -1
+
+// OperationResult is the outcome of one step of a simulated block transition.
+type OperationResult struct {
+	Operation string
+	// Index is the position of the operation in its list of the block body, or -1 for steps
+	// which are not block body operations.
+	Index int
+	Err   error
+}
+
+// Simulation is the outcome of a simulated block transition.
+type Simulation struct {
+	// Operations lists the steps of the transition in the order they were run, up to the first
+	// step which failed.
+	Operations []*OperationResult
+	// PostState is the state after processing the block. It is nil if processing failed, but
+	// not if the only failure is a state root mismatch.
+	PostState     state.BeaconState
+	PostStateRoot [32]byte
+	// Err is the failure which makes the block invalid, nil for a valid block.
+	Err error
+}
+
+// SimulateBlock runs the state transition of a block against a state, processing the operations
+// of the block one at a time so that an invalid block is attributed to the operation which failed.
+// It follows ExecuteStateTransition, or ExecuteStateTransitionNoVerifyAnySig when verifySignatures
+// is false, in which case the block, randao, attestation and sync aggregate signatures are not
+// verified. Signatures of slashings, exits and deposits are verified either way, as processing
+// them does. The state is modified.
+func SimulateBlock(
+	ctx context.Context,
+	st state.BeaconState,
+	signed interfaces.SignedBeaconBlock,
+	verifySignatures bool,
+) (*Simulation, error) {
+	ctx, span := trace.StartSpan(ctx, "core.state.SimulateBlock")
+	defer span.End()
+	if err := wrapper.BeaconBlockIsNil(signed); err != nil {
+		return nil, err
+	}
+	if st == nil || st.IsNil() {
+		return nil, errors.New("nil state")
+	}
+
+	sim := &Simulation{}
+	// run runs a step of the transition unless a previous step failed, and records its outcome.
+	run := func(op string, idx int, fn func(state.BeaconState) (state.BeaconState, error)) {
+		if sim.Err != nil || ctx.Err() != nil {
+			return
+		}
+		post, err := fn(st)
+		sim.Operations = append(sim.Operations, &OperationResult{Operation: op, Index: idx, Err: err})
+		if err != nil {
+			if idx == noOperationIndex {
+				sim.Err = errors.Wrapf(err, "%s failed", op)
+			} else {
+				sim.Err = errors.Wrapf(err, "%s %d failed", op, idx)
+			}
+			return
+		}
+		st = post
+	}
+
+	blk := signed.Block()
+	body := blk.Body()
+	run(opProcessSlots, noOperationIndex, func(s state.BeaconState) (state.BeaconState, error) {
+		return ProcessSlots(ctx, s, blk.Slot())
+	})
+	run(opBlockHeader, noOperationIndex, func(s state.BeaconState) (state.BeaconState, error) {
+		if s.Version() != blk.Version() {
+			return nil, fmt.Errorf("state and block are different version. %d != %d", s.Version(), blk.Version())
+		}
+		bodyRoot, err := body.HashTreeRoot()
+		if err != nil {
+			return nil, errors.Wrap(err, "could not hash tree root beacon block body")
+		}
+		return b.ProcessBlockHeaderNoVerify(ctx, s, blk.Slot(), blk.ProposerIndex(), blk.ParentRoot(), bodyRoot[:])
+	})
+	if verifySignatures {
+		run(opBlockSignature, noOperationIndex, func(s state.BeaconState) (state.BeaconState, error) {
+			return s, b.VerifyBlockSignature(s, blk.ProposerIndex(), signed.Signature(), blk.HashTreeRoot)
+		})
+	}
+	if blk.Version() >= version.Bellatrix {
+		run(opExecutionPayload, noOperationIndex, func(s state.BeaconState) (state.BeaconState, error) {
+			return simulatePayload(s, blk)
+		})
+	}
+	run(opRandao, noOperationIndex, func(s state.BeaconState) (state.BeaconState, error) {
+		if verifySignatures {
+			return b.ProcessRandao(ctx, s, signed)
+		}
+		return b.ProcessRandaoNoVerify(s, body.RandaoReveal())
+	})
+	run(opEth1Data, noOperationIndex, func(s state.BeaconState) (state.BeaconState, error) {
+		return b.ProcessEth1DataInBlock(ctx, s, body.Eth1Data())
+	})
+	run(opOperationLengths, noOperationIndex, func(s state.BeaconState) (state.BeaconState, error) {
+		return VerifyOperationLengths(ctx, s, signed)
+	})
+	for i, slashing := range body.ProposerSlashings() {
+		run(opProposerSlashing, i, func(s state.BeaconState) (state.BeaconState, error) {
+			return b.ProcessProposerSlashing(ctx, s, slashing, v.SlashValidator)
+		})
+	}
+	for i, slashing := range body.AttesterSlashings() {
+		run(opAttesterSlashing, i, func(s state.BeaconState) (state.BeaconState, error) {
+			return b.ProcessAttesterSlashing(ctx, s, slashing, v.SlashValidator)
+		})
+	}
+	var totalBalance uint64
+	for i, att := range body.Attestations() {
+		run(opAttestation, i, func(s state.BeaconState) (state.BeaconState, error) {
+			if verifySignatures {
+				if err := verifyAttestationSignature(ctx, s, att); err != nil {
+					return nil, err
+				}
+			}
+			if s.Version() == version.Phase0 {
+				return b.ProcessAttestationNoVerifySignature(ctx, s, att)
+			}
+			// The total active balance does not change while processing attestations.
+			if totalBalance == 0 {
+				var err error
+				if totalBalance, err = helpers.TotalActiveBalance(s); err != nil {
+					return nil, err
+				}
+			}
+			return altair.ProcessAttestationNoVerifySignature(ctx, s, att, totalBalance)
+		})
+	}
+	for i, deposit := range body.Deposits() {
+		run(opDeposit, i, func(s state.BeaconState) (state.BeaconState, error) {
+			if deposit == nil || deposit.Data == nil {
+				return nil, errors.New("nil deposit")
+			}
+			if s.Version() == version.Phase0 {
+				s, _, err := b.ProcessDeposit(s, deposit, true)
+				return s, err
+			}
+			return altair.ProcessDeposit(ctx, s, deposit, true)
+		})
+	}
+	for i, exit := range body.VoluntaryExits() {
+		run(opVoluntaryExit, i, func(s state.BeaconState) (state.BeaconState, error) {
+			return b.ProcessVoluntaryExits(ctx, s, []*ethpb.SignedVoluntaryExit{exit})
+		})
+	}
+	if blk.Version() >= version.Altair {
+		run(opSyncAggregate, noOperationIndex, func(s state.BeaconState) (state.BeaconState, error) {
+			sa, err := body.SyncAggregate()
+			if err != nil {
+				return nil, err
+			}
+			if verifySignatures {
+				return altair.ProcessSyncAggregate(ctx, s, sa)
+			}
+			_, votedIndices, didntVoteIndices, err := altair.FilterSyncCommitteeVotes(s, sa)
+			if err != nil {
+				return nil, err
+			}
+			return altair.ApplySyncRewardsPenalties(ctx, s, votedIndices, didntVoteIndices)
+		})
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if sim.Err != nil {
+		return sim, nil
+	}
+
+	root, err := st.HashTreeRoot(ctx)
+	if err != nil {
+		return nil, err
+	}
+	sim.PostState, sim.PostStateRoot = st, root
+	run(opStateRoot, noOperationIndex, func(s state.BeaconState) (state.BeaconState, error) {
+		if !bytes.Equal(root[:], blk.StateRoot()) {
+			return nil, fmt.Errorf("could not validate state root, wanted: %#x, received: %#x", root[:], blk.StateRoot())
+		}
+		return s, nil
+	})
+	return sim, nil
+}
+
+// simulatePayload processes the execution payload, or payload header of a blinded block, if
+// execution is enabled.
+func simulatePayload(st state.BeaconState, blk interfaces.BeaconBlock) (state.BeaconState, error) {
+	body := blk.Body()
+	enabled, err := b.IsExecutionEnabled(st, body)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not check if execution is enabled")
+	}
+	if !enabled {
+		return st, nil
+	}
+	if blk.IsBlinded() {
+		header, err := body.ExecutionPayloadHeader()
+		if err != nil {
+			return nil, err
+		}
+		return b.ProcessPayloadHeader(st, header)
+	}
+	payload, err := body.ExecutionPayload()
+	if err != nil {
+		return nil, err
+	}
+	return b.ProcessPayload(st, payload)
+}
+
+func verifyAttestationSignature(ctx context.Context, st state.BeaconState, att *ethpb.Attestation) error {
+	set, err := b.AttestationSignatureBatch(ctx, st, []*ethpb.Attestation{att})
+	if err != nil {
+		return errors.Wrap(err, "could not retrieve attestation signature set")
+	}
+	verified, err := set.Verify()
+	if err != nil {
+		return errors.Wrap(err, "could not verify attestation signature")
+	}
+	if !verified {
+		return errors.New("attestation signature did not verify")
+	}
+	return nil
+}
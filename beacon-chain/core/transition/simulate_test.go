@@ -0,0 +1,86 @@
+package transition_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/transition"
+	"github.com/prysmaticlabs/prysm/config/params"
+	"github.com/prysmaticlabs/prysm/consensus-types/wrapper"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	"github.com/prysmaticlabs/prysm/testing/util"
+)
+
+func TestSimulateBlock_Valid(t *testing.T) {
+	st, privs := util.DeterministicGenesisState(t, params.MinimalSpecConfig().MinGenesisActiveValidatorCount)
+	cfg := util.DefaultBlockGenConfig()
+	cfg.NumAttestations = 2
+	blk, err := util.GenerateFullBlock(st.Copy(), privs, cfg, 1)
+	require.NoError(t, err)
+	wsb, err := wrapper.WrappedSignedBeaconBlock(blk)
+	require.NoError(t, err)
+
+	want, err := transition.ExecuteStateTransition(context.Background(), st.Copy(), wsb)
+	require.NoError(t, err)
+	wantRoot, err := want.HashTreeRoot(context.Background())
+	require.NoError(t, err)
+
+	sim, err := transition.SimulateBlock(context.Background(), st.Copy(), wsb, true)
+	require.NoError(t, err)
+	require.NoError(t, sim.Err)
+	assert.Equal(t, wantRoot, sim.PostStateRoot)
+	assert.NotNil(t, sim.PostState)
+	var atts int
+	for _, op := range sim.Operations {
+		assert.NoError(t, op.Err, op.Operation)
+		if op.Operation == "attestation" {
+			assert.Equal(t, atts, op.Index)
+			atts++
+		}
+	}
+	assert.Equal(t, 2, atts)
+	assert.Equal(t, "state_root", sim.Operations[len(sim.Operations)-1].Operation)
+}
+
+func TestSimulateBlock_InvalidAttestationSignature(t *testing.T) {
+	st, privs := util.DeterministicGenesisState(t, params.MinimalSpecConfig().MinGenesisActiveValidatorCount)
+	cfg := util.DefaultBlockGenConfig()
+	cfg.NumAttestations = 2
+	blk, err := util.GenerateFullBlock(st.Copy(), privs, cfg, 1)
+	require.NoError(t, err)
+	blk.Block.Body.Attestations[1].Signature = blk.Signature
+	sig, err := util.BlockSignature(st.Copy(), blk.Block, privs)
+	require.NoError(t, err)
+	blk.Signature = sig.Marshal()
+	wsb, err := wrapper.WrappedSignedBeaconBlock(blk)
+	require.NoError(t, err)
+
+	sim, err := transition.SimulateBlock(context.Background(), st.Copy(), wsb, true)
+	require.NoError(t, err)
+	require.ErrorContains(t, "attestation 1 failed", sim.Err)
+	assert.Equal(t, nil, sim.PostState)
+	last := sim.Operations[len(sim.Operations)-1]
+	assert.Equal(t, "attestation", last.Operation)
+	assert.Equal(t, 1, last.Index)
+	assert.NotNil(t, last.Err)
+
+	sim, err = transition.SimulateBlock(context.Background(), st.Copy(), wsb, false)
+	require.NoError(t, err)
+	require.NoError(t, sim.Err)
+}
+
+func TestSimulateBlock_StateRootMismatch(t *testing.T) {
+	st, privs := util.DeterministicGenesisState(t, params.MinimalSpecConfig().MinGenesisActiveValidatorCount)
+	blk, err := util.GenerateFullBlock(st.Copy(), privs, util.DefaultBlockGenConfig(), 1)
+	require.NoError(t, err)
+	blk.Block.StateRoot = make([]byte, 32)
+	wsb, err := wrapper.WrappedSignedBeaconBlock(blk)
+	require.NoError(t, err)
+
+	sim, err := transition.SimulateBlock(context.Background(), st.Copy(), wsb, false)
+	require.NoError(t, err)
+	require.ErrorContains(t, "could not validate state root", sim.Err)
+	assert.NotNil(t, sim.PostState)
+	assert.NotEqual(t, [32]byte{}, sim.PostStateRoot)
+}
@@ -0,0 +1,109 @@
+// Package backend defines the key-value storage interface targeted by the beacon node
+// database, and its implementations. Data is organized in buckets of sorted keys, read and
+// written within transactions, following the model of BoltDB.
+package backend
+
+import (
+	"github.com/pkg/errors"
+)
+
+// Kind identifies a storage backend.
+type Kind string
+
+const (
+	// KindBolt stores the database in a single BoltDB B+tree file.
+	KindBolt Kind = "bolt"
+	// KindLevelDB stores the database in a LevelDB log-structured merge-tree directory.
+	KindLevelDB Kind = "leveldb"
+	// KindPebble stores the database in a Pebble log-structured merge-tree directory.
+	KindPebble Kind = "pebble"
+)
+
+var (
+	// ErrBucketNotFound is returned when deleting a bucket which does not exist.
+	ErrBucketNotFound = errors.New("bucket not found")
+	// ErrBucketExists is returned when creating a bucket which already exists.
+	ErrBucketExists = errors.New("bucket already exists")
+	// ErrTxNotWritable is returned when writing within a read-only transaction.
+	ErrTxNotWritable = errors.New("tx not writable")
+	// ErrUnsupported is returned when an operation is not supported by a backend.
+	ErrUnsupported = errors.New("operation not supported by the storage backend")
+)
+
+// ParseKind returns the backend kind of the given name.
+func ParseKind(name string) (Kind, error) {
+	switch k := Kind(name); k {
+	case KindBolt, KindLevelDB, KindPebble:
+		return k, nil
+	default:
+		return "", errors.Errorf("unknown storage backend %q, expected %q, %q or %q", name, KindBolt, KindLevelDB, KindPebble)
+	}
+}
+
+// DB is a transactional key-value store. Read-only transactions see a consistent view of the
+// store, and read-write transactions are applied atomically, one at a time.
+type DB interface {
+	// View runs fn within a read-only transaction.
+	View(fn func(Tx) error) error
+	// Update runs fn within a read-write transaction, which is committed if fn returns nil
+	// and rolled back otherwise.
+	Update(fn func(Tx) error) error
+	// Kind returns the backend kind of the store.
+	Kind() Kind
+	// Path returns the path of the file or directory of the store.
+	Path() string
+	Close() error
+}
+
+// Compactor is implemented by backends which discard overwritten and deleted data lazily.
+type Compactor interface {
+	// Compact discards overwritten and deleted data of the whole store.
+	Compact() error
+}
+
+// Tx is a transaction. Byte slices returned by a transaction are only valid until it ends.
+type Tx interface {
+	// Bucket returns the bucket of the given name, or nil if it does not exist.
+	Bucket(name []byte) Bucket
+	// CreateBucket creates a bucket, returning ErrBucketExists if it exists.
+	CreateBucket(name []byte) (Bucket, error)
+	// CreateBucketIfNotExists creates a bucket if it does not exist, and returns it.
+	CreateBucketIfNotExists(name []byte) (Bucket, error)
+	// DeleteBucket deletes a bucket and all its keys, returning ErrBucketNotFound if it does not exist.
+	DeleteBucket(name []byte) error
+	// ForEach calls fn for every bucket, in name order.
+	ForEach(fn func(name []byte, b Bucket) error) error
+}
+
+// Bucket is a collection of sorted keys.
+type Bucket interface {
+	// Get returns the value of a key, or nil if the key does not exist.
+	Get(key []byte) []byte
+	Put(key, value []byte) error
+	Delete(key []byte) error
+	Cursor() Cursor
+	// ForEach calls fn for every key of the bucket, in key order.
+	ForEach(fn func(k, v []byte) error) error
+	Stats() BucketStats
+}
+
+// Cursor iterates over the sorted keys of a bucket. Its methods return a nil key once
+// iteration goes past the first or last key of the bucket.
+type Cursor interface {
+	First() (key, value []byte)
+	Last() (key, value []byte)
+	Next() (key, value []byte)
+	Prev() (key, value []byte)
+	// Seek moves the cursor to the given key, or to the next key if it does not exist.
+	Seek(seek []byte) (key, value []byte)
+}
+
+// BucketStats describes the content of a bucket.
+type BucketStats struct {
+	// KeyN is the number of keys of the bucket.
+	KeyN int
+	// InUse is the number of bytes used by the bucket's data.
+	InUse int
+	// Alloc is the number of bytes allocated to the bucket on disk.
+	Alloc int
+}
@@ -0,0 +1,236 @@
+package backend
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	bolt "go.etcd.io/bbolt"
+)
+
+var testBucket = []byte("test")
+
+func openBackends(t *testing.T) []DB {
+	dir := t.TempDir()
+	boltDB, err := OpenBolt(filepath.Join(dir, "test.db"), 0600, &bolt.Options{})
+	require.NoError(t, err)
+	levelDB, err := OpenLevelDB(filepath.Join(dir, "test.leveldb"), nil)
+	require.NoError(t, err)
+	pebbleDB, err := OpenPebble(filepath.Join(dir, "test.pebble"), nil)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, boltDB.Close())
+		require.NoError(t, levelDB.Close())
+		require.NoError(t, pebbleDB.Close())
+	})
+	return []DB{boltDB, levelDB, pebbleDB}
+}
+
+// keys returns the keys and values of the bucket, iterated forwards with a cursor.
+func keys(t *testing.T, bkt Bucket) []string {
+	var kvs []string
+	c := bkt.Cursor()
+	for k, v := c.First(); k != nil; k, v = c.Next() {
+		kvs = append(kvs, fmt.Sprintf("%s=%s", k, v))
+	}
+	return kvs
+}
+
+func TestDB_Buckets(t *testing.T) {
+	for _, db := range openBackends(t) {
+		t.Run(string(db.Kind()), func(t *testing.T) {
+			require.NoError(t, db.Update(func(tx Tx) error {
+				assert.Equal(t, nil, tx.Bucket([]byte("b")))
+				_, err := tx.CreateBucket([]byte("b"))
+				require.NoError(t, err)
+				_, err = tx.CreateBucket([]byte("b"))
+				assert.Equal(t, ErrBucketExists, err)
+				_, err = tx.CreateBucketIfNotExists([]byte("a"))
+				require.NoError(t, err)
+				bkt, err := tx.CreateBucketIfNotExists([]byte("c"))
+				require.NoError(t, err)
+				return bkt.Put([]byte("k"), []byte("v"))
+			}))
+			require.NoError(t, db.Update(func(tx Tx) error {
+				var names []string
+				require.NoError(t, tx.ForEach(func(name []byte, _ Bucket) error {
+					names = append(names, string(name))
+					return nil
+				}))
+				assert.DeepEqual(t, []string{"a", "b", "c"}, names)
+
+				require.NoError(t, tx.DeleteBucket([]byte("c")))
+				assert.Equal(t, ErrBucketNotFound, tx.DeleteBucket([]byte("c")))
+				assert.Equal(t, nil, tx.Bucket([]byte("c")))
+				bkt, err := tx.CreateBucket([]byte("c"))
+				require.NoError(t, err)
+				assert.Equal(t, 0, len(keys(t, bkt)), "Recreated bucket is not empty")
+				return nil
+			}))
+		})
+	}
+}
+
+func TestDB_UpdateRollback(t *testing.T) {
+	for _, db := range openBackends(t) {
+		t.Run(string(db.Kind()), func(t *testing.T) {
+			errRollback := errors.New("rollback")
+			err := db.Update(func(tx Tx) error {
+				bkt, err := tx.CreateBucket(testBucket)
+				require.NoError(t, err)
+				require.NoError(t, bkt.Put([]byte("k"), []byte("v")))
+				return errRollback
+			})
+			assert.Equal(t, errRollback, err)
+			require.NoError(t, db.View(func(tx Tx) error {
+				assert.Equal(t, nil, tx.Bucket(testBucket))
+				return nil
+			}))
+		})
+	}
+}
+
+func TestDB_ViewNotWritable(t *testing.T) {
+	for _, db := range openBackends(t) {
+		t.Run(string(db.Kind()), func(t *testing.T) {
+			require.NoError(t, db.Update(func(tx Tx) error {
+				_, err := tx.CreateBucket(testBucket)
+				return err
+			}))
+			require.NoError(t, db.View(func(tx Tx) error {
+				assert.NotNil(t, tx.Bucket(testBucket).Put([]byte("k"), []byte("v")))
+				_, err := tx.CreateBucket([]byte("other"))
+				assert.NotNil(t, err)
+				return nil
+			}))
+		})
+	}
+}
+
+func TestCursor_PendingWrites(t *testing.T) {
+	for _, db := range openBackends(t) {
+		t.Run(string(db.Kind()), func(t *testing.T) {
+			require.NoError(t, db.Update(func(tx Tx) error {
+				bkt, err := tx.CreateBucket(testBucket)
+				require.NoError(t, err)
+				for _, k := range []string{"b", "d", "f"} {
+					require.NoError(t, bkt.Put([]byte(k), []byte(k+"0")))
+				}
+				// Keys of other buckets are not visible.
+				other, err := tx.CreateBucket([]byte("tesu"))
+				require.NoError(t, err)
+				return other.Put([]byte("c"), []byte("other"))
+			}))
+			require.NoError(t, db.Update(func(tx Tx) error {
+				bkt := tx.Bucket(testBucket)
+				require.NoError(t, bkt.Put([]byte("a"), []byte("a1")))
+				require.NoError(t, bkt.Put([]byte("d"), []byte("d1")))
+				require.NoError(t, bkt.Delete([]byte("f")))
+				require.NoError(t, bkt.Put([]byte("e"), []byte("e1")))
+				require.NoError(t, bkt.Delete([]byte("e")))
+				assert.DeepEqual(t, []byte("d1"), bkt.Get([]byte("d")))
+				assert.DeepEqual(t, []byte(nil), bkt.Get([]byte("f")))
+				assert.DeepEqual(t, []string{"a=a1", "b=b0", "d=d1"}, keys(t, bkt))
+
+				c := bkt.Cursor()
+				k, v := c.Last()
+				assert.Equal(t, "d=d1", fmt.Sprintf("%s=%s", k, v))
+				k, _ = c.Prev()
+				assert.DeepEqual(t, []byte("b"), k)
+				k, _ = c.Prev()
+				assert.DeepEqual(t, []byte("a"), k)
+				k, _ = c.Prev()
+				assert.DeepEqual(t, []byte(nil), k)
+				k, _ = c.Seek([]byte("c"))
+				assert.DeepEqual(t, []byte("d"), k)
+				k, _ = c.Next()
+				assert.DeepEqual(t, []byte(nil), k)
+				return nil
+			}))
+			require.NoError(t, db.View(func(tx Tx) error {
+				bkt := tx.Bucket(testBucket)
+				assert.DeepEqual(t, []string{"a=a1", "b=b0", "d=d1"}, keys(t, bkt))
+				assert.Equal(t, 3, bkt.Stats().KeyN)
+				return nil
+			}))
+		})
+	}
+}
+
+func TestDB_ViewSnapshot(t *testing.T) {
+	// BoltDB is left out, as it can not grow its file while a read-only transaction is open.
+	for _, db := range openBackends(t)[1:] {
+		t.Run(string(db.Kind()), func(t *testing.T) {
+			require.NoError(t, db.Update(func(tx Tx) error {
+				bkt, err := tx.CreateBucket(testBucket)
+				require.NoError(t, err)
+				return bkt.Put([]byte("k"), []byte("v0"))
+			}))
+			require.NoError(t, db.View(func(tx Tx) error {
+				require.NoError(t, db.Update(func(tx Tx) error {
+					return tx.Bucket(testBucket).Put([]byte("k"), []byte("v1"))
+				}))
+				assert.DeepEqual(t, []byte("v0"), tx.Bucket(testBucket).Get([]byte("k")), "View sees a later write")
+				return nil
+			}))
+		})
+	}
+}
+
+func TestCopy(t *testing.T) {
+	backends := openBackends(t)
+	boltDB, levelDB, pebbleDB := backends[0], backends[1], backends[2]
+	want := make(map[string][]string)
+	require.NoError(t, boltDB.Update(func(tx Tx) error {
+		for i := 0; i < 3; i++ {
+			name := fmt.Sprintf("bucket%d", i)
+			bkt, err := tx.CreateBucket([]byte(name))
+			require.NoError(t, err)
+			for j := 0; j < 100*i; j++ {
+				require.NoError(t, bkt.Put([]byte(fmt.Sprintf("%04d", j)), []byte(fmt.Sprintf("value%d", j))))
+				want[name] = append(want[name], fmt.Sprintf("%04d=value%d", j, j))
+			}
+		}
+		return nil
+	}))
+	require.NoError(t, Copy(context.Background(), levelDB, boltDB))
+	require.NoError(t, Copy(context.Background(), pebbleDB, levelDB))
+	for _, db := range []DB{levelDB, pebbleDB} {
+		require.NoError(t, db.(Compactor).Compact())
+	}
+
+	dir := t.TempDir()
+	copied, err := OpenBolt(filepath.Join(dir, "copy.db"), 0600, &bolt.Options{})
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, copied.Close())
+	}()
+	require.NoError(t, Copy(context.Background(), copied, pebbleDB))
+	for _, db := range []DB{levelDB, pebbleDB, copied} {
+		require.NoError(t, db.View(func(tx Tx) error {
+			n := 0
+			require.NoError(t, tx.ForEach(func(name []byte, bkt Bucket) error {
+				n++
+				assert.DeepEqual(t, want[string(name)], keys(t, bkt), "bucket %s", name)
+				return nil
+			}))
+			assert.Equal(t, len(want)+1, n, "Expected every bucket, including the empty one")
+			return nil
+		}))
+	}
+}
+
+func TestParseKind(t *testing.T) {
+	kind, err := ParseKind("leveldb")
+	require.NoError(t, err)
+	assert.Equal(t, KindLevelDB, kind)
+	kind, err = ParseKind("pebble")
+	require.NoError(t, err)
+	assert.Equal(t, KindPebble, kind)
+	_, err = ParseKind("badger")
+	assert.ErrorContains(t, "unknown storage backend", err)
+}
@@ -0,0 +1,125 @@
+package backend
+
+import (
+	"io"
+	"os"
+
+	bolt "go.etcd.io/bbolt"
+)
+
+// BoltDB is a storage backend using a BoltDB file.
+type BoltDB struct {
+	db *bolt.DB
+}
+
+var _ DB = (*BoltDB)(nil)
+
+// OpenBolt opens or creates the BoltDB file at the given path.
+func OpenBolt(path string, mode os.FileMode, opts *bolt.Options) (*BoltDB, error) {
+	db, err := bolt.Open(path, mode, opts)
+	if err != nil {
+		return nil, err
+	}
+	return &BoltDB{db: db}, nil
+}
+
+// Bolt returns the underlying BoltDB database.
+func (b *BoltDB) Bolt() *bolt.DB {
+	return b.db
+}
+
+// View runs fn within a BoltDB read-only transaction.
+func (b *BoltDB) View(fn func(Tx) error) error {
+	return b.db.View(func(tx *bolt.Tx) error {
+		return fn(&boltTx{tx: tx})
+	})
+}
+
+// Update runs fn within a BoltDB read-write transaction.
+func (b *BoltDB) Update(fn func(Tx) error) error {
+	return b.db.Update(func(tx *bolt.Tx) error {
+		return fn(&boltTx{tx: tx})
+	})
+}
+
+// Kind returns KindBolt.
+func (b *BoltDB) Kind() Kind {
+	return KindBolt
+}
+
+// Path returns the path of the BoltDB file.
+func (b *BoltDB) Path() string {
+	return b.db.Path()
+}
+
+// Close the BoltDB file.
+func (b *BoltDB) Close() error {
+	return b.db.Close()
+}
+
+type boltTx struct {
+	tx *bolt.Tx
+}
+
+func (t *boltTx) Bucket(name []byte) Bucket {
+	bkt := t.tx.Bucket(name)
+	if bkt == nil {
+		return nil
+	}
+	return &boltBucket{bkt}
+}
+
+func (t *boltTx) CreateBucket(name []byte) (Bucket, error) {
+	bkt, err := t.tx.CreateBucket(name)
+	if err == bolt.ErrBucketExists {
+		return nil, ErrBucketExists
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &boltBucket{bkt}, nil
+}
+
+func (t *boltTx) CreateBucketIfNotExists(name []byte) (Bucket, error) {
+	bkt, err := t.tx.CreateBucketIfNotExists(name)
+	if err != nil {
+		return nil, err
+	}
+	return &boltBucket{bkt}, nil
+}
+
+func (t *boltTx) DeleteBucket(name []byte) error {
+	err := t.tx.DeleteBucket(name)
+	if err == bolt.ErrBucketNotFound {
+		return ErrBucketNotFound
+	}
+	return err
+}
+
+func (t *boltTx) ForEach(fn func(name []byte, b Bucket) error) error {
+	return t.tx.ForEach(func(name []byte, bkt *bolt.Bucket) error {
+		return fn(name, &boltBucket{bkt})
+	})
+}
+
+// WriteTo writes a copy of the BoltDB file, as seen by the transaction, to w.
+func (t *boltTx) WriteTo(w io.Writer) (int64, error) {
+	return t.tx.WriteTo(w)
+}
+
+type boltBucket struct {
+	*bolt.Bucket
+}
+
+func (b *boltBucket) Cursor() Cursor {
+	return b.Bucket.Cursor()
+}
+
+func (b *boltBucket) Stats() BucketStats {
+	s := b.Bucket.Stats()
+	return BucketStats{
+		KeyN:  s.KeyN,
+		InUse: s.BranchInuse + s.LeafInuse,
+		Alloc: s.BranchAlloc + s.LeafAlloc,
+	}
+}
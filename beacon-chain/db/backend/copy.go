@@ -0,0 +1,44 @@
+package backend
+
+import (
+	"context"
+)
+
+// copyTxSize is the approximate number of bytes written per transaction when copying a store.
+const copyTxSize = 64 * 1024 * 1024
+
+// Copy copies every bucket of src into dst, within a single read-only transaction of src.
+// Writes to dst are committed every copyTxSize bytes, so that large stores are not copied
+// in memory.
+func Copy(ctx context.Context, dst, src DB) error {
+	return src.View(func(srcTx Tx) error {
+		return srcTx.ForEach(func(name []byte, srcBkt Bucket) error {
+			if err := dst.Update(func(tx Tx) error {
+				_, err := tx.CreateBucketIfNotExists(name)
+				return err
+			}); err != nil {
+				return err
+			}
+			c := srcBkt.Cursor()
+			k, v := c.First()
+			for k != nil {
+				if err := dst.Update(func(tx Tx) error {
+					dstBkt := tx.Bucket(name)
+					for size := 0; k != nil && size < copyTxSize; k, v = c.Next() {
+						if err := ctx.Err(); err != nil {
+							return err
+						}
+						if err := dstBkt.Put(k, v); err != nil {
+							return err
+						}
+						size += len(k) + len(v)
+					}
+					return nil
+				}); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+	})
+}
@@ -0,0 +1,373 @@
+package backend
+
+import (
+	"bytes"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/comparer"
+	"github.com/syndtr/goleveldb/leveldb/iterator"
+	"github.com/syndtr/goleveldb/leveldb/memdb"
+	"github.com/syndtr/goleveldb/leveldb/opt"
+	"github.com/syndtr/goleveldb/leveldb/util"
+)
+
+// Keys of a bucket are stored in LevelDB prefixed by the length and the name of the bucket,
+// and the existence of a bucket is recorded under the bucketRegistryPrefix followed by its
+// name. As bucket names are not empty, registry keys never collide with bucket keys.
+const (
+	bucketRegistryPrefix = 0x00
+	maxBucketNameLength  = 255
+)
+
+// Values written by a read-write transaction are buffered, prefixed by a tag telling whether
+// the key is put or deleted, until the transaction is committed.
+const (
+	tagDelete byte = iota
+	tagPut
+)
+
+// LevelDBOptions configures a LevelDB backend.
+type LevelDBOptions struct {
+	ReadOnly bool
+	// Sync fsyncs the journal on every commit. Without it, a crash of the operating system can
+	// lose the latest transactions, but never leaves a transaction partially applied.
+	Sync bool
+	// BlockCacheCapacity and WriteBuffer are in bytes, LevelDB defaults are used if zero.
+	BlockCacheCapacity int
+	WriteBuffer        int
+}
+
+// LevelDB is a storage backend using a LevelDB directory. Read-only transactions read from a
+// LevelDB snapshot. Read-write transactions read from a snapshot taken once the previous
+// read-write transaction committed, buffer their writes in memory, and commit them as a
+// single atomic batch.
+type LevelDB struct {
+	db    *leveldb.DB
+	path  string
+	sync  bool
+	write sync.Mutex
+}
+
+var _ DB = (*LevelDB)(nil)
+
+// OpenLevelDB opens or creates the LevelDB directory at the given path.
+func OpenLevelDB(path string, opts *LevelDBOptions) (*LevelDB, error) {
+	if opts == nil {
+		opts = &LevelDBOptions{}
+	}
+	db, err := leveldb.OpenFile(path, &opt.Options{
+		ReadOnly:           opts.ReadOnly,
+		ErrorIfMissing:     opts.ReadOnly,
+		BlockCacheCapacity: opts.BlockCacheCapacity,
+		WriteBuffer:        opts.WriteBuffer,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &LevelDB{db: db, path: path, sync: opts.Sync}, nil
+}
+
+// View runs fn within a transaction reading from a LevelDB snapshot.
+func (l *LevelDB) View(fn func(Tx) error) error {
+	snapshot, err := l.db.GetSnapshot()
+	if err != nil {
+		return err
+	}
+	defer snapshot.Release()
+	tx := &levelTx{db: l, snapshot: snapshot}
+	defer tx.release()
+	return fn(tx)
+}
+
+// Update runs fn within a read-write transaction, whose writes are committed as a single batch.
+func (l *LevelDB) Update(fn func(Tx) error) error {
+	l.write.Lock()
+	defer l.write.Unlock()
+	snapshot, err := l.db.GetSnapshot()
+	if err != nil {
+		return err
+	}
+	defer snapshot.Release()
+	tx := &levelTx{db: l, snapshot: snapshot, pending: memdb.New(comparer.DefaultComparer, 0)}
+	defer tx.release()
+	if err := fn(tx); err != nil {
+		return err
+	}
+	return tx.commit()
+}
+
+// Kind returns KindLevelDB.
+func (l *LevelDB) Kind() Kind {
+	return KindLevelDB
+}
+
+// Path returns the path of the LevelDB directory.
+func (l *LevelDB) Path() string {
+	return l.path
+}
+
+// Close the LevelDB directory.
+func (l *LevelDB) Close() error {
+	return l.db.Close()
+}
+
+// Compact compacts the whole LevelDB key range, discarding overwritten and deleted data.
+func (l *LevelDB) Compact() error {
+	return l.db.CompactRange(util.Range{})
+}
+
+type levelTx struct {
+	db       *LevelDB
+	snapshot *leveldb.Snapshot
+	// pending holds the writes of a read-write transaction, it is nil in read-only transactions.
+	pending   *memdb.DB
+	iterators []iterator.Iterator
+}
+
+func (t *levelTx) get(key []byte) []byte {
+	if t.pending != nil {
+		if v, err := t.pending.Get(key); err == nil {
+			if v[0] == tagDelete {
+				return nil
+			}
+			return v[1:]
+		}
+	}
+	v, err := t.snapshot.Get(key, nil)
+	if err != nil {
+		return nil
+	}
+	return v
+}
+
+func (t *levelTx) put(key, value []byte) error {
+	if t.pending == nil {
+		return ErrTxNotWritable
+	}
+	return t.pending.Put(key, append([]byte{tagPut}, value...))
+}
+
+func (t *levelTx) delete(key []byte) error {
+	if t.pending == nil {
+		return ErrTxNotWritable
+	}
+	return t.pending.Put(key, []byte{tagDelete})
+}
+
+// newCursor returns a cursor over the keys starting with prefix, with the prefix removed.
+func (t *levelTx) newCursor(prefix []byte) *levelCursor {
+	rng := util.BytesPrefix(prefix)
+	c := &levelCursor{prefix: prefix}
+	snapshotIt := t.snapshot.NewIterator(rng, nil)
+	if t.pending == nil {
+		c.it = snapshotIt
+	} else {
+		c.pending = t.pending.NewIterator(rng)
+		c.it = iterator.NewMergedIterator([]iterator.Iterator{c.pending, snapshotIt}, comparer.DefaultComparer, false)
+	}
+	t.iterators = append(t.iterators, c.it)
+	return c
+}
+
+func (t *levelTx) Bucket(name []byte) Bucket {
+	if t.get(registryKey(name)) == nil {
+		return nil
+	}
+	return &levelBucket{tx: t, name: name, prefix: bucketPrefix(name)}
+}
+
+func (t *levelTx) CreateBucket(name []byte) (Bucket, error) {
+	if t.Bucket(name) != nil {
+		return nil, ErrBucketExists
+	}
+	return t.CreateBucketIfNotExists(name)
+}
+
+func (t *levelTx) CreateBucketIfNotExists(name []byte) (Bucket, error) {
+	if len(name) == 0 || len(name) > maxBucketNameLength {
+		return nil, errors.Errorf("invalid bucket name length %d", len(name))
+	}
+	if bkt := t.Bucket(name); bkt != nil {
+		return bkt, nil
+	}
+	if err := t.put(registryKey(name), []byte{}); err != nil {
+		return nil, err
+	}
+	return &levelBucket{tx: t, name: name, prefix: bucketPrefix(name)}, nil
+}
+
+func (t *levelTx) DeleteBucket(name []byte) error {
+	if t.Bucket(name) == nil {
+		return ErrBucketNotFound
+	}
+	prefix := bucketPrefix(name)
+	c := t.newCursor(prefix)
+	for k, _ := c.First(); k != nil; k, _ = c.Next() {
+		if err := t.delete(append(append([]byte{}, prefix...), k...)); err != nil {
+			return err
+		}
+	}
+	return t.delete(registryKey(name))
+}
+
+func (t *levelTx) ForEach(fn func(name []byte, b Bucket) error) error {
+	c := t.newCursor([]byte{bucketRegistryPrefix})
+	for name, _ := c.First(); name != nil; name, _ = c.Next() {
+		name = append([]byte{}, name...)
+		if err := fn(name, &levelBucket{tx: t, name: name, prefix: bucketPrefix(name)}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (t *levelTx) commit() error {
+	batch := new(leveldb.Batch)
+	it := t.pending.NewIterator(nil)
+	defer it.Release()
+	for it.Next() {
+		if v := it.Value(); v[0] == tagDelete {
+			batch.Delete(it.Key())
+		} else {
+			batch.Put(it.Key(), v[1:])
+		}
+	}
+	if batch.Len() == 0 {
+		return nil
+	}
+	return t.db.db.Write(batch, &opt.WriteOptions{Sync: t.db.sync})
+}
+
+func (t *levelTx) release() {
+	for _, it := range t.iterators {
+		it.Release()
+	}
+	t.iterators = nil
+}
+
+type levelBucket struct {
+	tx     *levelTx
+	name   []byte
+	prefix []byte
+}
+
+func (b *levelBucket) key(k []byte) []byte {
+	return append(append(make([]byte, 0, len(b.prefix)+len(k)), b.prefix...), k...)
+}
+
+func (b *levelBucket) Get(key []byte) []byte {
+	return b.tx.get(b.key(key))
+}
+
+func (b *levelBucket) Put(key, value []byte) error {
+	if len(key) == 0 {
+		return errors.New("key required")
+	}
+	return b.tx.put(b.key(key), value)
+}
+
+func (b *levelBucket) Delete(key []byte) error {
+	return b.tx.delete(b.key(key))
+}
+
+func (b *levelBucket) Cursor() Cursor {
+	return b.tx.newCursor(b.prefix)
+}
+
+func (b *levelBucket) ForEach(fn func(k, v []byte) error) error {
+	c := b.tx.newCursor(b.prefix)
+	for k, v := c.First(); k != nil; k, v = c.Next() {
+		if err := fn(k, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (b *levelBucket) Stats() BucketStats {
+	var s BucketStats
+	if err := b.ForEach(func(k, v []byte) error {
+		s.KeyN++
+		s.InUse += len(b.prefix) + len(k) + len(v)
+		return nil
+	}); err != nil {
+		return s
+	}
+	if sizes, err := b.tx.db.db.SizeOf([]util.Range{*util.BytesPrefix(b.prefix)}); err == nil {
+		s.Alloc = int(sizes.Sum())
+	}
+	return s
+}
+
+// levelCursor iterates over the keys of a bucket. In read-write transactions, it merges the
+// pending writes with the snapshot, pending writes taking precedence over the snapshot for
+// the same key, and skips deleted keys.
+type levelCursor struct {
+	prefix  []byte
+	it      iterator.Iterator
+	pending iterator.Iterator
+	// last is the key the cursor is positioned at, used to skip the snapshot entry of a key
+	// which also has a pending write.
+	last []byte
+}
+
+func (c *levelCursor) First() ([]byte, []byte) {
+	c.last = nil
+	return c.settle(c.it.First(), c.it.Next)
+}
+
+func (c *levelCursor) Last() ([]byte, []byte) {
+	c.last = nil
+	return c.settle(c.it.Last(), c.it.Prev)
+}
+
+func (c *levelCursor) Next() ([]byte, []byte) {
+	return c.settle(c.it.Next(), c.it.Next)
+}
+
+func (c *levelCursor) Prev() ([]byte, []byte) {
+	return c.settle(c.it.Prev(), c.it.Prev)
+}
+
+func (c *levelCursor) Seek(seek []byte) ([]byte, []byte) {
+	c.last = nil
+	return c.settle(c.it.Seek(append(append([]byte{}, c.prefix...), seek...)), c.it.Next)
+}
+
+// settle moves the cursor with move until it is positioned at a key which is not deleted and
+// differs from the previous key, and returns a copy of that key without the bucket prefix. When both the
+// pending writes and the snapshot contain the current key, the merged iterator may be positioned
+// at either of them, but the pending iterator is then always positioned at the key, so the value
+// is read from it.
+func (c *levelCursor) settle(ok bool, move func() bool) ([]byte, []byte) {
+	for ; ok; ok = move() {
+		key := c.it.Key()
+		if c.last != nil && bytes.Equal(key, c.last) {
+			continue
+		}
+		c.last = append(c.last[:0], key...)
+		value := c.it.Value()
+		if c.pending != nil && c.pending.Valid() && bytes.Equal(c.pending.Key(), key) {
+			value = c.pending.Value()
+			if value[0] == tagDelete {
+				continue
+			}
+			value = value[1:]
+		}
+		// LevelDB iterators reuse their buffers, while byte slices returned by a transaction
+		// must remain valid until it ends.
+		return append([]byte{}, key[len(c.prefix):]...), append([]byte{}, value...)
+	}
+	c.last = nil
+	return nil, nil
+}
+
+func bucketPrefix(name []byte) []byte {
+	return append([]byte{byte(len(name))}, name...)
+}
+
+func registryKey(name []byte) []byte {
+	return append([]byte{bucketRegistryPrefix}, name...)
+}
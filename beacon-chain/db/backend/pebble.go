@@ -0,0 +1,350 @@
+package backend
+
+import (
+	"io"
+	"sync"
+
+	"github.com/cockroachdb/pebble"
+	"github.com/pkg/errors"
+)
+
+// PebbleOptions configures a Pebble backend.
+type PebbleOptions struct {
+	ReadOnly bool
+	// Sync fsyncs the write-ahead log on every commit. Without it, a crash of the operating
+	// system can lose the latest transactions, but never leaves a transaction partially applied.
+	Sync bool
+	// CacheSize and MemTableSize are in bytes, Pebble defaults are used if zero.
+	CacheSize    int64
+	MemTableSize int
+}
+
+// Pebble is a storage backend using a Pebble directory, with the same key layout as the
+// LevelDB backend. Read-only transactions read from a Pebble snapshot. Read-write transactions
+// are indexed batches, which read their own writes on top of the latest committed state and
+// are committed atomically. As they run one at a time, the state they read from does not
+// change during the transaction.
+type Pebble struct {
+	db    *pebble.DB
+	path  string
+	sync  bool
+	write sync.Mutex
+}
+
+var _ DB = (*Pebble)(nil)
+
+// OpenPebble opens or creates the Pebble directory at the given path.
+func OpenPebble(path string, opts *PebbleOptions) (*Pebble, error) {
+	if opts == nil {
+		opts = &PebbleOptions{}
+	}
+	pebbleOpts := &pebble.Options{
+		ReadOnly:         opts.ReadOnly,
+		ErrorIfNotExists: opts.ReadOnly,
+		MemTableSize:     opts.MemTableSize,
+	}
+	if opts.CacheSize > 0 {
+		cache := pebble.NewCache(opts.CacheSize)
+		// The database holds its own reference to the cache.
+		defer cache.Unref()
+		pebbleOpts.Cache = cache
+	}
+	db, err := pebble.Open(path, pebbleOpts)
+	if err != nil {
+		return nil, err
+	}
+	return &Pebble{db: db, path: path, sync: opts.Sync}, nil
+}
+
+// View runs fn within a transaction reading from a Pebble snapshot.
+func (p *Pebble) View(fn func(Tx) error) (err error) {
+	snapshot := p.db.NewSnapshot()
+	tx := &pebbleTx{db: p, reader: snapshot}
+	defer func() {
+		releaseErr := tx.release()
+		if closeErr := snapshot.Close(); releaseErr == nil {
+			releaseErr = closeErr
+		}
+		if err == nil {
+			err = releaseErr
+		}
+	}()
+	return fn(tx)
+}
+
+// Update runs fn within a read-write transaction, whose writes are committed as a single batch.
+func (p *Pebble) Update(fn func(Tx) error) (err error) {
+	p.write.Lock()
+	defer p.write.Unlock()
+	batch := p.db.NewIndexedBatch()
+	tx := &pebbleTx{db: p, reader: batch, batch: batch}
+	defer func() {
+		releaseErr := tx.release()
+		if closeErr := batch.Close(); releaseErr == nil {
+			releaseErr = closeErr
+		}
+		if err == nil {
+			err = releaseErr
+		}
+	}()
+	if err := fn(tx); err != nil {
+		return err
+	}
+	if batch.Empty() {
+		return nil
+	}
+	return batch.Commit(&pebble.WriteOptions{Sync: p.sync})
+}
+
+// Kind returns KindPebble.
+func (p *Pebble) Kind() Kind {
+	return KindPebble
+}
+
+// Path returns the path of the Pebble directory.
+func (p *Pebble) Path() string {
+	return p.path
+}
+
+// Close the Pebble directory.
+func (p *Pebble) Close() error {
+	return p.db.Close()
+}
+
+// Compact compacts the whole Pebble key range, discarding overwritten and deleted data.
+func (p *Pebble) Compact() error {
+	it := p.db.NewIter(nil)
+	var first, last []byte
+	if it.First() {
+		first = append([]byte{}, it.Key()...)
+	}
+	if it.Last() {
+		last = append([]byte{}, it.Key()...)
+	}
+	if err := it.Close(); err != nil {
+		return err
+	}
+	if first == nil {
+		return nil
+	}
+	// The start of the range to compact must be before its end.
+	return p.db.Compact(first, append(last, 0), true)
+}
+
+// pebbleReader is implemented by Pebble snapshots and indexed batches.
+type pebbleReader interface {
+	Get(key []byte) ([]byte, io.Closer, error)
+	NewIter(o *pebble.IterOptions) *pebble.Iterator
+}
+
+type pebbleTx struct {
+	db     *Pebble
+	reader pebbleReader
+	// batch holds the writes of a read-write transaction, it is nil in read-only transactions.
+	batch     *pebble.Batch
+	iterators []*pebble.Iterator
+}
+
+func (t *pebbleTx) get(key []byte) []byte {
+	v, closer, err := t.reader.Get(key)
+	if err != nil {
+		return nil
+	}
+	// Values returned by Pebble are only valid until the closer is closed.
+	value := append([]byte{}, v...)
+	if err := closer.Close(); err != nil {
+		return nil
+	}
+	return value
+}
+
+func (t *pebbleTx) put(key, value []byte) error {
+	if t.batch == nil {
+		return ErrTxNotWritable
+	}
+	return t.batch.Set(key, value, nil)
+}
+
+func (t *pebbleTx) delete(key []byte) error {
+	if t.batch == nil {
+		return ErrTxNotWritable
+	}
+	return t.batch.Delete(key, nil)
+}
+
+// newCursor returns a cursor over the keys starting with prefix, with the prefix removed. In
+// read-write transactions, the cursor sees the writes made before it was created.
+func (t *pebbleTx) newCursor(prefix []byte) *pebbleCursor {
+	it := t.reader.NewIter(&pebble.IterOptions{
+		LowerBound: prefix,
+		UpperBound: prefixUpperBound(prefix),
+	})
+	t.iterators = append(t.iterators, it)
+	return &pebbleCursor{prefix: prefix, it: it}
+}
+
+func (t *pebbleTx) Bucket(name []byte) Bucket {
+	if t.get(registryKey(name)) == nil {
+		return nil
+	}
+	return &pebbleBucket{tx: t, name: name, prefix: bucketPrefix(name)}
+}
+
+func (t *pebbleTx) CreateBucket(name []byte) (Bucket, error) {
+	if t.Bucket(name) != nil {
+		return nil, ErrBucketExists
+	}
+	return t.CreateBucketIfNotExists(name)
+}
+
+func (t *pebbleTx) CreateBucketIfNotExists(name []byte) (Bucket, error) {
+	if len(name) == 0 || len(name) > maxBucketNameLength {
+		return nil, errors.Errorf("invalid bucket name length %d", len(name))
+	}
+	if bkt := t.Bucket(name); bkt != nil {
+		return bkt, nil
+	}
+	if err := t.put(registryKey(name), []byte{}); err != nil {
+		return nil, err
+	}
+	return &pebbleBucket{tx: t, name: name, prefix: bucketPrefix(name)}, nil
+}
+
+func (t *pebbleTx) DeleteBucket(name []byte) error {
+	if t.Bucket(name) == nil {
+		return ErrBucketNotFound
+	}
+	if t.batch == nil {
+		return ErrTxNotWritable
+	}
+	prefix := bucketPrefix(name)
+	if err := t.batch.DeleteRange(prefix, prefixUpperBound(prefix), nil); err != nil {
+		return err
+	}
+	return t.delete(registryKey(name))
+}
+
+func (t *pebbleTx) ForEach(fn func(name []byte, b Bucket) error) error {
+	c := t.newCursor([]byte{bucketRegistryPrefix})
+	for name, _ := c.First(); name != nil; name, _ = c.Next() {
+		if err := fn(name, &pebbleBucket{tx: t, name: name, prefix: bucketPrefix(name)}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// release closes the iterators of the transaction, returning the first iteration error.
+func (t *pebbleTx) release() error {
+	var err error
+	for _, it := range t.iterators {
+		if closeErr := it.Close(); err == nil {
+			err = closeErr
+		}
+	}
+	t.iterators = nil
+	return err
+}
+
+type pebbleBucket struct {
+	tx     *pebbleTx
+	name   []byte
+	prefix []byte
+}
+
+func (b *pebbleBucket) key(k []byte) []byte {
+	return append(append(make([]byte, 0, len(b.prefix)+len(k)), b.prefix...), k...)
+}
+
+func (b *pebbleBucket) Get(key []byte) []byte {
+	return b.tx.get(b.key(key))
+}
+
+func (b *pebbleBucket) Put(key, value []byte) error {
+	if len(key) == 0 {
+		return errors.New("key required")
+	}
+	return b.tx.put(b.key(key), value)
+}
+
+func (b *pebbleBucket) Delete(key []byte) error {
+	return b.tx.delete(b.key(key))
+}
+
+func (b *pebbleBucket) Cursor() Cursor {
+	return b.tx.newCursor(b.prefix)
+}
+
+func (b *pebbleBucket) ForEach(fn func(k, v []byte) error) error {
+	c := b.tx.newCursor(b.prefix)
+	for k, v := c.First(); k != nil; k, v = c.Next() {
+		if err := fn(k, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (b *pebbleBucket) Stats() BucketStats {
+	var s BucketStats
+	if err := b.ForEach(func(k, v []byte) error {
+		s.KeyN++
+		s.InUse += len(b.prefix) + len(k) + len(v)
+		return nil
+	}); err != nil {
+		return s
+	}
+	if size, err := b.tx.db.db.EstimateDiskUsage(b.prefix, prefixUpperBound(b.prefix)); err == nil {
+		s.Alloc = int(size)
+	}
+	return s
+}
+
+// pebbleCursor iterates over the keys of a bucket, within the bounds of its Pebble iterator.
+type pebbleCursor struct {
+	prefix []byte
+	it     *pebble.Iterator
+}
+
+func (c *pebbleCursor) First() ([]byte, []byte) {
+	return c.current(c.it.First())
+}
+
+func (c *pebbleCursor) Last() ([]byte, []byte) {
+	return c.current(c.it.Last())
+}
+
+func (c *pebbleCursor) Next() ([]byte, []byte) {
+	return c.current(c.it.Next())
+}
+
+func (c *pebbleCursor) Prev() ([]byte, []byte) {
+	return c.current(c.it.Prev())
+}
+
+func (c *pebbleCursor) Seek(seek []byte) ([]byte, []byte) {
+	return c.current(c.it.SeekGE(append(append([]byte{}, c.prefix...), seek...)))
+}
+
+// current returns a copy of the key the iterator is positioned at, without the bucket prefix,
+// and of its value. Pebble iterators reuse their buffers, while byte slices returned by a
+// transaction must remain valid until it ends.
+func (c *pebbleCursor) current(ok bool) ([]byte, []byte) {
+	if !ok {
+		return nil, nil
+	}
+	return append([]byte{}, c.it.Key()[len(c.prefix):]...), append([]byte{}, c.it.Value()...)
+}
+
+// prefixUpperBound returns the smallest key greater than every key starting with prefix, or nil
+// if there is none.
+func prefixUpperBound(prefix []byte) []byte {
+	end := append([]byte{}, prefix...)
+	for i := len(end) - 1; i >= 0; i-- {
+		end[i]++
+		if end[i] != 0 {
+			return end[:i+1]
+		}
+	}
+	return nil
+}
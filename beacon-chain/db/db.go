@@ -15,7 +15,11 @@ func NewDB(ctx context.Context, dirPath string, config *kv.Config) (Database, er
 
 // NewDBFilename uses the KVStoreDatafilePath so that if this layer of
 // indirection between db.NewDB->kv.NewKVStore ever changes, it will be easy to remember
-// to also change this filename indirection at the same time.
+// to also change this filename indirection at the same time. It returns the directory of
+// the database when it uses the leveldb or pebble backend.
 func NewDBFilename(dirPath string) string {
+	if kind, ok := kv.ExistingBackend(dirPath); ok {
+		return kv.BackendPath(dirPath, kind)
+	}
 	return kv.KVStoreDatafilePath(dirPath)
 }
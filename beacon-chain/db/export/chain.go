@@ -0,0 +1,63 @@
+package export
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/consensus-types/wrapper"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+)
+
+// DBChain answers canonical block and current slot queries from the database alone, so that
+// data can be exported while the beacon node is not running. Finalized blocks are canonical,
+// as are the unfinalized ancestors of the head block saved in the database.
+type DBChain struct {
+	db       db.HeadAccessDatabase
+	headSlot types.Slot
+	roots    map[[32]byte]bool
+}
+
+// NewDBChain walks back from the saved head block to the finalized chain to determine the
+// canonical unfinalized blocks.
+func NewDBChain(ctx context.Context, beaconDB db.HeadAccessDatabase) (*DBChain, error) {
+	head, err := beaconDB.HeadBlock(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get head block")
+	}
+	if err := wrapper.BeaconBlockIsNil(head); err != nil {
+		return nil, err
+	}
+	c := &DBChain{
+		db:       beaconDB,
+		headSlot: head.Block().Slot(),
+		roots:    make(map[[32]byte]bool),
+	}
+	root, err := head.Block().HashTreeRoot()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute head block root")
+	}
+	for !beaconDB.IsFinalizedBlock(ctx, root) {
+		c.roots[root] = true
+		blk, err := beaconDB.Block(ctx, root)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not get block %#x", root)
+		}
+		if blk == nil || blk.IsNil() || blk.Block().Slot() == 0 {
+			break
+		}
+		root = bytesutil.ToBytes32(blk.Block().ParentRoot())
+	}
+	return c, nil
+}
+
+// IsCanonical returns true if the block is finalized or an ancestor of the saved head block.
+func (c *DBChain) IsCanonical(ctx context.Context, blockRoot [32]byte) (bool, error) {
+	return c.roots[blockRoot] || c.db.IsFinalizedBlock(ctx, blockRoot), nil
+}
+
+// CurrentSlot returns the slot of the saved head block.
+func (c *DBChain) CurrentSlot() types.Slot {
+	return c.headSlot
+}
@@ -0,0 +1,221 @@
+package export
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/filters"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/consensus-types/interfaces"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/runtime/version"
+	"github.com/prysmaticlabs/prysm/time/slots"
+)
+
+// blockBatchSize is the number of slots of blocks read from the database at once.
+const blockBatchSize = 64
+
+var (
+	blockColumns = []string{
+		"slot", "block_root", "parent_root", "state_root", "proposer_index", "version", "graffiti",
+		"attestations", "deposits", "voluntary_exits", "proposer_slashings", "attester_slashings",
+	}
+	attestationColumns = []string{
+		"slot", "block_root", "attestation_slot", "committee_index", "aggregation_bits",
+		"beacon_block_root", "source_epoch", "source_root", "target_epoch", "target_root",
+	}
+	depositColumns = []string{
+		"slot", "block_root", "pubkey", "withdrawal_credentials", "amount",
+	}
+	validatorColumns = []string{
+		"epoch", "index", "pubkey", "withdrawal_credentials", "effective_balance", "slashed",
+		"activation_eligibility_epoch", "activation_epoch", "exit_epoch", "withdrawable_epoch",
+	}
+	balanceColumns = []string{
+		"epoch", "index", "balance",
+	}
+)
+
+func (e *Exporter) exportBlocks(ctx context.Context, req *Request, rw rowWriter) error {
+	return e.forEachBlock(ctx, req, func(root [32]byte, blk interfaces.BeaconBlock) error {
+		body := blk.Body()
+		return rw.Write([]string{
+			uintString(uint64(blk.Slot())),
+			hexutil.Encode(root[:]),
+			hexutil.Encode(blk.ParentRoot()),
+			hexutil.Encode(blk.StateRoot()),
+			uintString(uint64(blk.ProposerIndex())),
+			version.String(blk.Version()),
+			hexutil.Encode(body.Graffiti()),
+			strconv.Itoa(len(body.Attestations())),
+			strconv.Itoa(len(body.Deposits())),
+			strconv.Itoa(len(body.VoluntaryExits())),
+			strconv.Itoa(len(body.ProposerSlashings())),
+			strconv.Itoa(len(body.AttesterSlashings())),
+		})
+	})
+}
+
+func (e *Exporter) exportAttestations(ctx context.Context, req *Request, rw rowWriter) error {
+	return e.forEachBlock(ctx, req, func(root [32]byte, blk interfaces.BeaconBlock) error {
+		for _, att := range blk.Body().Attestations() {
+			if att == nil || att.Data == nil || att.Data.Source == nil || att.Data.Target == nil {
+				continue
+			}
+			if err := rw.Write([]string{
+				uintString(uint64(blk.Slot())),
+				hexutil.Encode(root[:]),
+				uintString(uint64(att.Data.Slot)),
+				uintString(uint64(att.Data.CommitteeIndex)),
+				hexutil.Encode(att.AggregationBits),
+				hexutil.Encode(att.Data.BeaconBlockRoot),
+				uintString(uint64(att.Data.Source.Epoch)),
+				hexutil.Encode(att.Data.Source.Root),
+				uintString(uint64(att.Data.Target.Epoch)),
+				hexutil.Encode(att.Data.Target.Root),
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (e *Exporter) exportDeposits(ctx context.Context, req *Request, rw rowWriter) error {
+	return e.forEachBlock(ctx, req, func(root [32]byte, blk interfaces.BeaconBlock) error {
+		for _, deposit := range blk.Body().Deposits() {
+			if deposit == nil || deposit.Data == nil {
+				continue
+			}
+			if err := rw.Write([]string{
+				uintString(uint64(blk.Slot())),
+				hexutil.Encode(root[:]),
+				hexutil.Encode(deposit.Data.PublicKey),
+				hexutil.Encode(deposit.Data.WithdrawalCredentials),
+				uintString(deposit.Data.Amount),
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (e *Exporter) exportValidators(ctx context.Context, req *Request, rw rowWriter) error {
+	return e.forEachState(ctx, req, func(epoch types.Epoch, st state.ReadOnlyBeaconState) error {
+		return st.ReadFromEveryValidator(func(idx int, val state.ReadOnlyValidator) error {
+			pubkey := val.PublicKey()
+			return rw.Write([]string{
+				uintString(uint64(epoch)),
+				strconv.Itoa(idx),
+				hexutil.Encode(pubkey[:]),
+				hexutil.Encode(val.WithdrawalCredentials()),
+				uintString(val.EffectiveBalance()),
+				strconv.FormatBool(val.Slashed()),
+				uintString(uint64(val.ActivationEligibilityEpoch())),
+				uintString(uint64(val.ActivationEpoch())),
+				uintString(uint64(val.ExitEpoch())),
+				uintString(uint64(val.WithdrawableEpoch())),
+			})
+		})
+	})
+}
+
+func (e *Exporter) exportBalances(ctx context.Context, req *Request, rw rowWriter) error {
+	return e.forEachState(ctx, req, func(epoch types.Epoch, st state.ReadOnlyBeaconState) error {
+		for idx, balance := range st.Balances() {
+			if err := rw.Write([]string{
+				uintString(uint64(epoch)),
+				strconv.Itoa(idx),
+				uintString(balance),
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// forEachBlock calls f with every canonical block in the slot range of the request, in slot order.
+func (e *Exporter) forEachBlock(ctx context.Context, req *Request, f func([32]byte, interfaces.BeaconBlock) error) error {
+	return e.Blocks(ctx, types.Slot(req.Start), types.Slot(req.End), func(root [32]byte, blk interfaces.SignedBeaconBlock) error {
+		return f(root, blk.Block())
+	})
+}
+
+// Blocks calls f with every canonical signed block from the start slot to the end slot inclusive,
+// in slot order. Blocks are read from the database in batches so that large ranges are streamed.
+func (e *Exporter) Blocks(ctx context.Context, start, end types.Slot, f func([32]byte, interfaces.SignedBeaconBlock) error) error {
+	if end < start {
+		return fmt.Errorf("end %d is lower than start %d", end, start)
+	}
+	for batchStart := start; batchStart <= end; batchStart += blockBatchSize {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		batchEnd := batchStart + blockBatchSize - 1
+		if batchEnd > end || batchEnd < batchStart {
+			batchEnd = end
+		}
+		filter := filters.NewFilter().SetStartSlot(batchStart).SetEndSlot(batchEnd)
+		blks, roots, err := e.db.Blocks(ctx, filter)
+		if err != nil {
+			return errors.Wrapf(err, "could not read blocks from slot %d to %d", batchStart, batchEnd)
+		}
+		order := make([]int, len(blks))
+		for i := range order {
+			order[i] = i
+		}
+		sort.Slice(order, func(i, j int) bool {
+			return blks[order[i]].Block().Slot() < blks[order[j]].Block().Slot()
+		})
+		for _, i := range order {
+			canonical, err := e.cc.IsCanonical(ctx, roots[i])
+			if err != nil {
+				return errors.Wrapf(err, "could not check if block %#x is canonical", roots[i])
+			}
+			if !canonical {
+				continue
+			}
+			if err := f(roots[i], blks[i]); err != nil {
+				return err
+			}
+		}
+		if batchEnd == end {
+			return nil
+		}
+	}
+	return nil
+}
+
+// forEachState calls f with the state at the start slot of every epoch in the range of the request.
+func (e *Exporter) forEachState(ctx context.Context, req *Request, f func(types.Epoch, state.ReadOnlyBeaconState) error) error {
+	for epoch := types.Epoch(req.Start); epoch <= types.Epoch(req.End); epoch++ {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		slot, err := slots.EpochStart(epoch)
+		if err != nil {
+			return err
+		}
+		st, err := e.replayer.ReplayerForSlot(slot).ReplayBlocks(ctx)
+		if err != nil {
+			return errors.Wrapf(err, "could not replay state for epoch %d", epoch)
+		}
+		if err := f(epoch, st); err != nil {
+			return err
+		}
+		if epoch == types.Epoch(req.End) {
+			return nil
+		}
+	}
+	return nil
+}
+
+func uintString(v uint64) string {
+	return strconv.FormatUint(v, 10)
+}
@@ -0,0 +1,146 @@
+// Package export streams historical chain data from the beacon node database
+// as newline-delimited JSON, CSV or columnar row groups for offline analysis.
+package export
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+)
+
+// Dataset is a kind of chain data which can be exported.
+type Dataset string
+
+const (
+	// Blocks exports one row per canonical block.
+	Blocks Dataset = "blocks"
+	// Attestations exports one row per attestation included in a canonical block.
+	Attestations Dataset = "attestations"
+	// Deposits exports one row per deposit included in a canonical block.
+	Deposits Dataset = "deposits"
+	// Validators exports one row per validator of the state at the start of each epoch.
+	Validators Dataset = "validators"
+	// Balances exports one row per validator balance of the state at the start of each epoch.
+	Balances Dataset = "balances"
+)
+
+// Datasets lists all exportable datasets.
+var Datasets = []Dataset{Blocks, Attestations, Deposits, Validators, Balances}
+
+// PerEpoch is true for datasets read from states, whose range is expressed in epochs
+// rather than in slots.
+func (d Dataset) PerEpoch() bool {
+	return d == Validators || d == Balances
+}
+
+// Format is the encoding of exported rows.
+type Format string
+
+const (
+	// JSON writes one JSON object per row, separated by newlines.
+	JSON Format = "json"
+	// CSV writes a header line followed by one comma-separated line per row.
+	CSV Format = "csv"
+	// Columnar writes one JSON object per group of rows, mapping each column to its values,
+	// similar to the row groups of Parquet files.
+	Columnar Format = "columnar"
+)
+
+// Formats lists all supported output formats.
+var Formats = []Format{JSON, CSV, Columnar}
+
+// ContentType is the media type of the format.
+func (f Format) ContentType() string {
+	if f == CSV {
+		return "text/csv"
+	}
+	return "application/x-ndjson"
+}
+
+// Request describes the data to export. Start and End are inclusive slots for datasets
+// read from blocks, and inclusive epochs for datasets read from states.
+type Request struct {
+	Dataset Dataset
+	Format  Format
+	Start   uint64
+	End     uint64
+}
+
+// Validate checks that the request refers to a known dataset and format, and to a valid range.
+func (r *Request) Validate() error {
+	if !validDataset(r.Dataset) {
+		return fmt.Errorf("unknown dataset %q, expected one of %v", r.Dataset, Datasets)
+	}
+	if !validFormat(r.Format) {
+		return fmt.Errorf("unknown format %q, expected one of %v", r.Format, Formats)
+	}
+	if r.End < r.Start {
+		return fmt.Errorf("end %d is lower than start %d", r.End, r.Start)
+	}
+	return nil
+}
+
+// Exporter reads blocks from the database and states through replay to export chain data.
+// Only blocks considered canonical by the canonical checker are exported.
+type Exporter struct {
+	db       db.ReadOnlyDatabase
+	cc       stategen.CanonicalChecker
+	replayer stategen.ReplayerBuilder
+}
+
+// New creates an exporter.
+func New(beaconDB db.ReadOnlyDatabase, cc stategen.CanonicalChecker, replayer stategen.ReplayerBuilder) *Exporter {
+	return &Exporter{
+		db:       beaconDB,
+		cc:       cc,
+		replayer: replayer,
+	}
+}
+
+// Export streams the rows of the request to w.
+func (e *Exporter) Export(ctx context.Context, req *Request, w io.Writer) error {
+	if err := req.Validate(); err != nil {
+		return err
+	}
+	var columns []string
+	var export func(context.Context, *Request, rowWriter) error
+	switch req.Dataset {
+	case Blocks:
+		columns, export = blockColumns, e.exportBlocks
+	case Attestations:
+		columns, export = attestationColumns, e.exportAttestations
+	case Deposits:
+		columns, export = depositColumns, e.exportDeposits
+	case Validators:
+		columns, export = validatorColumns, e.exportValidators
+	case Balances:
+		columns, export = balanceColumns, e.exportBalances
+	}
+	rw := newRowWriter(w, req.Format, columns)
+	if err := export(ctx, req, rw); err != nil {
+		return errors.Wrapf(err, "could not export %s", req.Dataset)
+	}
+	return rw.Flush()
+}
+
+func validDataset(d Dataset) bool {
+	for _, dataset := range Datasets {
+		if d == dataset {
+			return true
+		}
+	}
+	return false
+}
+
+func validFormat(f Format) bool {
+	for _, format := range Formats {
+		if f == format {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,167 @@
+package export
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	"github.com/prysmaticlabs/prysm/config/params"
+	"github.com/prysmaticlabs/prysm/consensus-types/interfaces"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/consensus-types/wrapper"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	"github.com/prysmaticlabs/prysm/testing/util"
+)
+
+// setupExporter saves a genesis block and state, a canonical chain of blocks at slots 1 and 3
+// and a fork block at slot 2.
+func setupExporter(t *testing.T) *Exporter {
+	ctx := context.Background()
+	beaconDB := testDB.SetupDB(t)
+	st, _ := util.DeterministicGenesisState(t, 4)
+
+	genesis := util.NewBeaconBlock()
+	stateRoot, err := st.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	genesis.Block.StateRoot = stateRoot[:]
+	genesisRoot := saveBlock(t, beaconDB, genesis)
+	require.NoError(t, beaconDB.SaveGenesisBlockRoot(ctx, genesisRoot))
+	require.NoError(t, beaconDB.SaveState(ctx, st, genesisRoot))
+
+	b1 := util.NewBeaconBlock()
+	b1.Block.Slot = 1
+	b1.Block.ProposerIndex = 2
+	b1.Block.ParentRoot = genesisRoot[:]
+	b1.Block.Body.Attestations = []*ethpb.Attestation{util.HydrateAttestation(&ethpb.Attestation{
+		AggregationBits: []byte{0x03},
+		Data:            &ethpb.AttestationData{Slot: 0, CommitteeIndex: 1},
+	})}
+	proof := make([][]byte, params.BeaconConfig().DepositContractTreeDepth+1)
+	for i := range proof {
+		proof[i] = make([]byte, 32)
+	}
+	b1.Block.Body.Deposits = []*ethpb.Deposit{{Proof: proof, Data: &ethpb.Deposit_Data{
+		PublicKey:             bytesutil.PadTo([]byte{0xaa}, 48),
+		WithdrawalCredentials: bytesutil.PadTo([]byte{0xbb}, 32),
+		Amount:                32000000000,
+		Signature:             make([]byte, 96),
+	}}}
+	root1 := saveBlock(t, beaconDB, b1)
+
+	fork := util.NewBeaconBlock()
+	fork.Block.Slot = 2
+	fork.Block.ParentRoot = root1[:]
+	fork.Block.Body.Graffiti = bytesutil.PadTo([]byte("fork"), 32)
+	saveBlock(t, beaconDB, fork)
+
+	b3 := util.NewBeaconBlock()
+	b3.Block.Slot = 3
+	b3.Block.ParentRoot = root1[:]
+	root3 := saveBlock(t, beaconDB, b3)
+	require.NoError(t, beaconDB.SaveStateSummary(ctx, &ethpb.StateSummary{Slot: 3, Root: root3[:]}))
+	require.NoError(t, beaconDB.SaveHeadBlockRoot(ctx, root3))
+
+	chain, err := NewDBChain(ctx, beaconDB)
+	require.NoError(t, err)
+	assert.Equal(t, types.Slot(3), chain.CurrentSlot())
+	return New(beaconDB, chain, stategen.NewCanonicalHistory(beaconDB, chain, chain))
+}
+
+func saveBlock(t *testing.T, beaconDB db.Database, b *ethpb.SignedBeaconBlock) [32]byte {
+	wsb, err := wrapper.WrappedSignedBeaconBlock(b)
+	require.NoError(t, err)
+	require.NoError(t, beaconDB.SaveBlock(context.Background(), wsb))
+	root, err := b.Block.HashTreeRoot()
+	require.NoError(t, err)
+	return root
+}
+
+func export(t *testing.T, e *Exporter, req *Request) []string {
+	var b bytes.Buffer
+	require.NoError(t, e.Export(context.Background(), req, &b))
+	return strings.Split(strings.TrimSuffix(b.String(), "\n"), "\n")
+}
+
+func TestExport_Blocks(t *testing.T) {
+	e := setupExporter(t)
+
+	lines := export(t, e, &Request{Dataset: Blocks, Format: CSV, Start: 1, End: 10})
+	require.Equal(t, 3, len(lines))
+	assert.Equal(t, strings.Join(blockColumns, ","), lines[0])
+	assert.Equal(t, true, strings.HasPrefix(lines[1], "1,0x"))
+	assert.Equal(t, true, strings.HasSuffix(lines[1], ",2,phase0,0x0000000000000000000000000000000000000000000000000000000000000000,1,1,0,0,0"))
+	assert.Equal(t, true, strings.HasPrefix(lines[2], "3,0x"))
+
+	lines = export(t, e, &Request{Dataset: Blocks, Format: JSON, Start: 0, End: 0})
+	require.Equal(t, 1, len(lines))
+	assert.Equal(t, true, strings.HasPrefix(lines[0], `{"slot":"0","block_root":"0x`))
+}
+
+func TestExporter_Blocks(t *testing.T) {
+	e := setupExporter(t)
+
+	var blkSlots []types.Slot
+	require.NoError(t, e.Blocks(context.Background(), 1, 10, func(root [32]byte, blk interfaces.SignedBeaconBlock) error {
+		blkRoot, err := blk.Block().HashTreeRoot()
+		require.NoError(t, err)
+		assert.Equal(t, blkRoot, root)
+		blkSlots = append(blkSlots, blk.Block().Slot())
+		return nil
+	}))
+	assert.DeepEqual(t, []types.Slot{1, 3}, blkSlots)
+
+	err := e.Blocks(context.Background(), 2, 1, func([32]byte, interfaces.SignedBeaconBlock) error { return nil })
+	assert.ErrorContains(t, "end 1 is lower than start 2", err)
+}
+
+func TestExport_Operations(t *testing.T) {
+	e := setupExporter(t)
+
+	lines := export(t, e, &Request{Dataset: Attestations, Format: JSON, Start: 0, End: 3})
+	require.Equal(t, 1, len(lines))
+	assert.Equal(t, true, strings.Contains(lines[0], `"attestation_slot":"0","committee_index":"1","aggregation_bits":"0x03"`))
+
+	lines = export(t, e, &Request{Dataset: Deposits, Format: CSV, Start: 0, End: 3})
+	require.Equal(t, 2, len(lines))
+	assert.Equal(t, true, strings.HasSuffix(lines[1], ",32000000000"))
+}
+
+func TestExport_Balances(t *testing.T) {
+	e := setupExporter(t)
+
+	lines := export(t, e, &Request{Dataset: Balances, Format: Columnar, Start: 0, End: 0})
+	require.Equal(t, 1, len(lines))
+	assert.Equal(t,
+		`{"epoch":["0","0","0","0"],"index":["0","1","2","3"],"balance":["32000000000","32000000000","32000000000","32000000000"]}`,
+		lines[0],
+	)
+
+	lines = export(t, e, &Request{Dataset: Validators, Format: CSV, Start: 0, End: 0})
+	require.Equal(t, 5, len(lines))
+	assert.Equal(t, true, strings.HasPrefix(lines[1], "0,0,0x"))
+}
+
+func TestRequest_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     *Request
+		wantErr string
+	}{
+		{name: "unknown dataset", req: &Request{Dataset: "foo", Format: JSON}, wantErr: `unknown dataset "foo"`},
+		{name: "unknown format", req: &Request{Dataset: Blocks, Format: "xml"}, wantErr: `unknown format "xml"`},
+		{name: "invalid range", req: &Request{Dataset: Blocks, Format: JSON, Start: 2, End: 1}, wantErr: "end 1 is lower than start 2"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.ErrorContains(t, tt.wantErr, tt.req.Validate())
+		})
+	}
+	assert.NoError(t, (&Request{Dataset: Balances, Format: Columnar, Start: 1, End: 1}).Validate())
+}
@@ -0,0 +1,159 @@
+package export
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+)
+
+// rowGroupSize is the number of rows written together by the columnar format.
+const rowGroupSize = 1024
+
+// rowWriter writes rows of string values, in the order of the columns it was created with.
+type rowWriter interface {
+	Write(row []string) error
+	Flush() error
+}
+
+func newRowWriter(w io.Writer, f Format, columns []string) rowWriter {
+	switch f {
+	case CSV:
+		return &csvWriter{w: csv.NewWriter(w), columns: columns}
+	case Columnar:
+		return &columnarWriter{w: bufio.NewWriter(w), columns: columns}
+	default:
+		return &jsonWriter{w: bufio.NewWriter(w), columns: columns}
+	}
+}
+
+// jsonWriter writes each row as a JSON object on its own line.
+type jsonWriter struct {
+	w       *bufio.Writer
+	columns []string
+}
+
+func (j *jsonWriter) Write(row []string) error {
+	if err := j.w.WriteByte('{'); err != nil {
+		return err
+	}
+	for i, v := range row {
+		if i > 0 {
+			if err := j.w.WriteByte(','); err != nil {
+				return err
+			}
+		}
+		if err := writeJSONString(j.w, j.columns[i]); err != nil {
+			return err
+		}
+		if err := j.w.WriteByte(':'); err != nil {
+			return err
+		}
+		if err := writeJSONString(j.w, v); err != nil {
+			return err
+		}
+	}
+	_, err := j.w.WriteString("}\n")
+	return err
+}
+
+func (j *jsonWriter) Flush() error {
+	return j.w.Flush()
+}
+
+// csvWriter writes a header line followed by one line per row.
+type csvWriter struct {
+	w             *csv.Writer
+	columns       []string
+	headerWritten bool
+}
+
+func (c *csvWriter) Write(row []string) error {
+	if !c.headerWritten {
+		if err := c.w.Write(c.columns); err != nil {
+			return err
+		}
+		c.headerWritten = true
+	}
+	return c.w.Write(row)
+}
+
+func (c *csvWriter) Flush() error {
+	if !c.headerWritten {
+		if err := c.w.Write(c.columns); err != nil {
+			return err
+		}
+		c.headerWritten = true
+	}
+	c.w.Flush()
+	return c.w.Error()
+}
+
+// columnarWriter buffers rows and writes them as row groups, each a JSON object on its
+// own line mapping every column to the list of its values.
+type columnarWriter struct {
+	w       *bufio.Writer
+	columns []string
+	rows    [][]string
+}
+
+func (c *columnarWriter) Write(row []string) error {
+	c.rows = append(c.rows, row)
+	if len(c.rows) == rowGroupSize {
+		return c.writeGroup()
+	}
+	return nil
+}
+
+func (c *columnarWriter) Flush() error {
+	if len(c.rows) > 0 {
+		if err := c.writeGroup(); err != nil {
+			return err
+		}
+	}
+	return c.w.Flush()
+}
+
+func (c *columnarWriter) writeGroup() error {
+	if err := c.w.WriteByte('{'); err != nil {
+		return err
+	}
+	for i, column := range c.columns {
+		if i > 0 {
+			if err := c.w.WriteByte(','); err != nil {
+				return err
+			}
+		}
+		if err := writeJSONString(c.w, column); err != nil {
+			return err
+		}
+		if _, err := c.w.WriteString(":["); err != nil {
+			return err
+		}
+		for j, row := range c.rows {
+			if j > 0 {
+				if err := c.w.WriteByte(','); err != nil {
+					return err
+				}
+			}
+			if err := writeJSONString(c.w, row[i]); err != nil {
+				return err
+			}
+		}
+		if err := c.w.WriteByte(']'); err != nil {
+			return err
+		}
+	}
+	c.rows = c.rows[:0]
+	_, err := c.w.WriteString("}\n")
+	return err
+}
+
+func writeJSONString(w *bufio.Writer, s string) error {
+	enc, err := json.Marshal(s)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(enc)
+	return err
+}
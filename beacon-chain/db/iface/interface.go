@@ -13,6 +13,7 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/state"
 	"github.com/prysmaticlabs/prysm/consensus-types/interfaces"
 	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/container/trie"
 	"github.com/prysmaticlabs/prysm/monitoring/backup"
 	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
 )
@@ -51,11 +52,17 @@ type ReadOnlyDatabase interface {
 	DepositContractAddress(ctx context.Context) ([]byte, error)
 	// Powchain operations.
 	PowchainData(ctx context.Context) (*ethpb.ETH1ChainData, error)
+	DepositSnapshot(ctx context.Context) (*trie.DepositTreeSnapshot, error)
 	// Fee reicipients operations.
 	FeeRecipientByValidatorID(ctx context.Context, id types.ValidatorIndex) (common.Address, error)
 	// origin checkpoint sync support
 	OriginCheckpointBlockRoot(ctx context.Context) ([32]byte, error)
 	BackfillBlockRoot(ctx context.Context) ([32]byte, error)
+	// Operation pool persistence.
+	VoluntaryExitPool(ctx context.Context) ([]*ethpb.SignedVoluntaryExit, error)
+	ProposerSlashingPool(ctx context.Context) ([]*ethpb.ProposerSlashing, error)
+	AttesterSlashingPool(ctx context.Context) ([]*ethpb.AttesterSlashing, error)
+	AttestationPool(ctx context.Context) ([]*ethpb.Attestation, error)
 }
 
 // NoHeadAccessDatabase defines a struct without access to chain head data.
@@ -82,10 +89,16 @@ type NoHeadAccessDatabase interface {
 	SaveDepositContractAddress(ctx context.Context, addr common.Address) error
 	// Powchain operations.
 	SavePowchainData(ctx context.Context, data *ethpb.ETH1ChainData) error
+	SaveDepositSnapshot(ctx context.Context, snapshot *trie.DepositTreeSnapshot) error
 	// Run any required database migrations.
 	RunMigrations(ctx context.Context) error
 	// Fee reicipients operations.
 	SaveFeeRecipientsByValidatorIDs(ctx context.Context, ids []types.ValidatorIndex, addrs []common.Address) error
+	// Operation pool persistence.
+	SaveVoluntaryExitPool(ctx context.Context, exits []*ethpb.SignedVoluntaryExit) error
+	SaveProposerSlashingPool(ctx context.Context, slashings []*ethpb.ProposerSlashing) error
+	SaveAttesterSlashingPool(ctx context.Context, slashings []*ethpb.AttesterSlashing) error
+	SaveAttestationPool(ctx context.Context, atts []*ethpb.Attestation) error
 
 	CleanUpDirtyStates(ctx context.Context, slotsPerArchivedPoint types.Slot) error
 }
@@ -160,6 +173,7 @@ type SlasherDatabase interface {
 type Database interface {
 	io.Closer
 	backup.BackupExporter
+	backup.Snapshotter
 	HeadAccessDatabase
 
 	DatabasePath() string
@@ -0,0 +1,42 @@
+package kv
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/backend"
+	"github.com/prysmaticlabs/prysm/io/file"
+	"go.opencensus.io/trace"
+)
+
+// Compact copies every bucket of the database into a new database of the same storage backend
+// in the destination directory. As BoltDB never shrinks its file, and LevelDB and Pebble only
+// discard overwritten data lazily, the copy only takes the space used by live data.
+// The destination directory must not contain a database already.
+func (s *Store) Compact(ctx context.Context, dstDir string) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.Compact")
+	defer span.End()
+
+	if kind, ok := ExistingBackend(dstDir); ok {
+		return errors.Errorf("%s database already exists in %s", kind, dstDir)
+	}
+	if err := file.MkdirAll(dstDir); err != nil {
+		return err
+	}
+	dst, err := openBackend(dstDir, &Config{Backend: s.db.Kind()})
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := dst.Close(); err != nil {
+			log.WithError(err).Error("Could not close compacted database")
+		}
+	}()
+	if err := backend.Copy(ctx, dst, s.db); err != nil {
+		return err
+	}
+	if c, ok := dst.(backend.Compactor); ok {
+		return c.Compact()
+	}
+	return nil
+}
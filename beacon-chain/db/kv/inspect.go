@@ -0,0 +1,177 @@
+package kv
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/backend"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	"go.opencensus.io/trace"
+)
+
+// BucketStats describes the space used by a database bucket.
+type BucketStats struct {
+	Name string
+	// Keys is the number of keys in the bucket, including the keys of nested buckets.
+	Keys int
+	// InUseBytes is the number of bytes used by the bucket's keys, values and pages headers.
+	InUseBytes int
+	// AllocatedBytes is the number of bytes allocated to the bucket's pages.
+	AllocatedBytes int
+}
+
+// ConsistencyReport lists the inconsistencies found between blocks, state summaries and the
+// indices of the database.
+type ConsistencyReport struct {
+	Blocks         int
+	StateSummaries int
+	// MissingParents are the roots of blocks whose parent block is missing. The genesis block,
+	// the origin checkpoint block and the lowest backfilled block are not expected to have a parent.
+	MissingParents [][32]byte
+	// UnindexedBlocks are the roots of blocks missing from the block slot index.
+	UnindexedBlocks [][32]byte
+	// OrphanedSummaries are the roots of state summaries without a block.
+	OrphanedSummaries [][32]byte
+	// OrphanedBlockSlotIndices is the number of block slot index entries referring to missing blocks.
+	OrphanedBlockSlotIndices int
+	// OrphanedParentRootIndices is the number of parent root index entries referring to missing blocks.
+	OrphanedParentRootIndices int
+	// OrphanedStateSlotIndices is the number of state slot index entries referring to missing states.
+	OrphanedStateSlotIndices int
+}
+
+// Consistent returns true if no inconsistency was found.
+func (r *ConsistencyReport) Consistent() bool {
+	return len(r.MissingParents) == 0 &&
+		len(r.UnindexedBlocks) == 0 &&
+		len(r.OrphanedSummaries) == 0 &&
+		r.OrphanedBlockSlotIndices == 0 &&
+		r.OrphanedParentRootIndices == 0 &&
+		r.OrphanedStateSlotIndices == 0
+}
+
+// BucketStats reports the number of keys and the space used by every top level bucket.
+func (s *Store) BucketStats(ctx context.Context) ([]*BucketStats, error) {
+	_, span := trace.StartSpan(ctx, "BeaconDB.BucketStats")
+	defer span.End()
+
+	var stats []*BucketStats
+	err := s.db.View(func(tx backend.Tx) error {
+		return tx.ForEach(func(name []byte, bkt backend.Bucket) error {
+			bs := bkt.Stats()
+			stats = append(stats, &BucketStats{
+				Name:           string(name),
+				Keys:           bs.KeyN,
+				InUseBytes:     bs.InUse,
+				AllocatedBytes: bs.Alloc,
+			})
+			return nil
+		})
+	})
+	return stats, err
+}
+
+// VerifyConsistency checks that every block has a parent block and is indexed by slot, that every
+// state summary has a block, and that the slot and parent root indices only refer to existing
+// blocks and states. It is meant to be run against the database of a stopped beacon node.
+func (s *Store) VerifyConsistency(ctx context.Context) (*ConsistencyReport, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.VerifyConsistency")
+	defer span.End()
+
+	report := &ConsistencyReport{}
+	err := s.db.View(func(tx backend.Tx) error {
+		blocks := tx.Bucket(blocksBucket)
+		roots := make(map[[32]byte]bool)
+		if err := blocks.ForEach(func(k, _ []byte) error {
+			if len(k) == 32 {
+				roots[bytesutil.ToBytes32(k)] = true
+			}
+			return nil
+		}); err != nil {
+			return err
+		}
+		report.Blocks = len(roots)
+
+		// Blocks which are not expected to have their parent in the database.
+		noParent := make(map[[32]byte]bool)
+		for _, key := range [][]byte{genesisBlockRootKey, originCheckpointBlockRootKey, backfillBlockRootKey} {
+			if root := blocks.Get(key); root != nil {
+				noParent[bytesutil.ToBytes32(root)] = true
+			}
+		}
+
+		slotIndices := tx.Bucket(blockSlotIndicesBucket)
+		if err := blocks.ForEach(func(k, v []byte) error {
+			if len(k) != 32 {
+				return nil
+			}
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			blk, err := unmarshalBlock(ctx, v)
+			if err != nil {
+				return err
+			}
+			root := bytesutil.ToBytes32(k)
+			parent := bytesutil.ToBytes32(blk.Block().ParentRoot())
+			if blk.Block().Slot() != 0 && !noParent[root] && !roots[parent] {
+				report.MissingParents = append(report.MissingParents, root)
+			}
+			if !containsRoot(slotIndices.Get(bytesutil.SlotToBytesBigEndian(blk.Block().Slot())), k) {
+				report.UnindexedBlocks = append(report.UnindexedBlocks, root)
+			}
+			return nil
+		}); err != nil {
+			return err
+		}
+
+		if err := tx.Bucket(stateSummaryBucket).ForEach(func(k, _ []byte) error {
+			report.StateSummaries++
+			if !roots[bytesutil.ToBytes32(k)] {
+				report.OrphanedSummaries = append(report.OrphanedSummaries, bytesutil.ToBytes32(k))
+			}
+			return nil
+		}); err != nil {
+			return err
+		}
+
+		blockExists := func(root []byte) bool { return roots[bytesutil.ToBytes32(root)] }
+		stateExists := func(root []byte) bool { return tx.Bucket(stateBucket).Get(root) != nil }
+		var err error
+		if report.OrphanedBlockSlotIndices, err = countOrphanedIndices(slotIndices, blockExists); err != nil {
+			return err
+		}
+		if report.OrphanedParentRootIndices, err = countOrphanedIndices(tx.Bucket(blockParentRootIndicesBucket), blockExists); err != nil {
+			return err
+		}
+		report.OrphanedStateSlotIndices, err = countOrphanedIndices(tx.Bucket(stateSlotIndicesBucket), stateExists)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return report, nil
+}
+
+// countOrphanedIndices counts the roots stored in an index bucket for which exists returns false.
+func countOrphanedIndices(bkt backend.Bucket, exists func(root []byte) bool) (int, error) {
+	orphaned := 0
+	err := bkt.ForEach(func(_, v []byte) error {
+		for i := 0; i+32 <= len(v); i += 32 {
+			if !exists(v[i : i+32]) {
+				orphaned++
+			}
+		}
+		return nil
+	})
+	return orphaned, err
+}
+
+func containsRoot(values, root []byte) bool {
+	for i := 0; i+32 <= len(values); i += 32 {
+		if bytes.Equal(values[i:i+32], root) {
+			return true
+		}
+	}
+	return false
+}
@@ -1,5 +1,6 @@
-// Package kv defines a bolt-db, key-value store implementation
-// of the Database interface defined by a Prysm beacon node.
+// Package kv defines a key-value store implementation of the Database
+// interface defined by a Prysm beacon node, on top of a BoltDB or LevelDB
+// storage backend.
 package kv
 
 import (
@@ -13,9 +14,12 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
 	prombolt "github.com/prysmaticlabs/prombbolt"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/backend"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db/iface"
+	"github.com/prysmaticlabs/prysm/config/features"
 	"github.com/prysmaticlabs/prysm/config/params"
 	"github.com/prysmaticlabs/prysm/io/file"
+	leveldberrors "github.com/syndtr/goleveldb/leveldb/errors"
 	bolt "go.etcd.io/bbolt"
 )
 
@@ -29,8 +33,12 @@ const (
 	ValidatorEntryMaxCost = 1 << 26
 	// BeaconNodeDbDirName is the name of the directory containing the beacon node database.
 	BeaconNodeDbDirName = "beaconchaindata"
-	// DatabaseFileName is the name of the beacon node database.
+	// DatabaseFileName is the name of the beacon node database file using the bolt backend.
 	DatabaseFileName = "beaconchain.db"
+	// LevelDBDirName is the name of the beacon node database directory using the leveldb backend.
+	LevelDBDirName = "beaconchain.leveldb"
+	// PebbleDirName is the name of the beacon node database directory using the pebble backend.
+	PebbleDirName = "beaconchain.pebble"
 
 	boltAllocSize = 8 * 1024 * 1024
 	// The size of hash length in bytes
@@ -68,20 +76,26 @@ var blockedBuckets = [][]byte{
 	finalizedBlockRootsIndexBucket,
 }
 
-// Config for the bolt db kv store.
+// Config for the kv store.
 type Config struct {
 	InitialMMapSize int
+	// Backend is the storage backend used when creating a new database, bolt if empty.
+	// An existing database is always opened with the backend it was created with.
+	Backend backend.Kind
 }
 
 // Store defines an implementation of the Prysm Database interface
-// using BoltDB as the underlying persistent kv-store for Ethereum Beacon Nodes.
+// using BoltDB or LevelDB as the underlying persistent kv-store for Ethereum Beacon Nodes.
 type Store struct {
-	db                  *bolt.DB
+	db                  backend.DB
 	databasePath        string
 	blockCache          *ristretto.Cache
 	validatorEntryCache *ristretto.Cache
 	stateSummaryCache   *stateSummaryCache
 	ctx                 context.Context
+	// saveBlindedBeaconBlocks is set when execution payloads are not stored with their
+	// blocks. Once set for a database, it can not be unset.
+	saveBlindedBeaconBlocks bool
 }
 
 // KVStoreDatafilePath is the canonical construction of a full
@@ -91,7 +105,93 @@ func KVStoreDatafilePath(dirPath string) string {
 	return path.Join(dirPath, DatabaseFileName)
 }
 
-// NewKVStore initializes a new boltDB key-value store at the directory
+// LevelDBDatadirPath is the path of the leveldb database directory in the directory path.
+func LevelDBDatadirPath(dirPath string) string {
+	return path.Join(dirPath, LevelDBDirName)
+}
+
+// PebbleDatadirPath is the path of the pebble database directory in the directory path.
+func PebbleDatadirPath(dirPath string) string {
+	return path.Join(dirPath, PebbleDirName)
+}
+
+// ExistingBackend returns the storage backend of the database in the directory path, if any.
+func ExistingBackend(dirPath string) (backend.Kind, bool) {
+	if file.FileExists(KVStoreDatafilePath(dirPath)) {
+		return backend.KindBolt, true
+	}
+	if hasDir, err := file.HasDir(LevelDBDatadirPath(dirPath)); err == nil && hasDir {
+		return backend.KindLevelDB, true
+	}
+	if hasDir, err := file.HasDir(PebbleDatadirPath(dirPath)); err == nil && hasDir {
+		return backend.KindPebble, true
+	}
+	return "", false
+}
+
+// openBackend opens the database in the directory path with its existing backend, or creates
+// it with the configured backend.
+func openBackend(dirPath string, config *Config) (backend.DB, error) {
+	kind, exists := ExistingBackend(dirPath)
+	if !exists {
+		kind = backend.KindBolt
+		if config.Backend != "" {
+			kind = config.Backend
+		}
+	} else if config.Backend != "" && config.Backend != kind {
+		log.Warnf("Database uses the %s backend, ignoring the configured %s backend. "+
+			"Use `prysmctl db migrate-backend` to convert it", kind, config.Backend)
+	}
+	return openBackendKind(dirPath, kind, config.InitialMMapSize)
+}
+
+// openBackendKind opens or creates the database of the given backend in the directory path.
+func openBackendKind(dirPath string, kind backend.Kind, initialMMapSize int) (backend.DB, error) {
+	switch kind {
+	case backend.KindBolt:
+		datafile := KVStoreDatafilePath(dirPath)
+		log.Infof("Opening Bolt DB at %s", datafile)
+		db, err := backend.OpenBolt(
+			datafile,
+			params.BeaconIoConfig().ReadWritePermissions,
+			&bolt.Options{
+				Timeout:         1 * time.Second,
+				InitialMmapSize: initialMMapSize,
+			},
+		)
+		if err != nil {
+			if errors.Is(err, bolt.ErrTimeout) {
+				return nil, errors.New("cannot obtain database lock, database may be in use by another process")
+			}
+			return nil, err
+		}
+		db.Bolt().AllocSize = boltAllocSize
+		return db, nil
+	case backend.KindLevelDB:
+		datadir := LevelDBDatadirPath(dirPath)
+		log.Infof("Opening LevelDB at %s", datadir)
+		db, err := backend.OpenLevelDB(datadir, &backend.LevelDBOptions{Sync: true})
+		if err != nil {
+			if leveldberrors.IsCorrupted(err) {
+				return nil, errors.Wrap(err, "database is corrupted")
+			}
+			return nil, errors.Wrap(err, "could not open database, it may be in use by another process")
+		}
+		return db, nil
+	case backend.KindPebble:
+		datadir := PebbleDatadirPath(dirPath)
+		log.Infof("Opening Pebble DB at %s", datadir)
+		db, err := backend.OpenPebble(datadir, &backend.PebbleOptions{Sync: true})
+		if err != nil {
+			return nil, errors.Wrap(err, "could not open database, it may be in use by another process")
+		}
+		return db, nil
+	default:
+		return nil, errors.Errorf("unsupported storage backend %q", kind)
+	}
+}
+
+// NewKVStore initializes a new key-value store at the directory
 // path specified, creates the kv-buckets based on the schema, and stores
 // an open connection db object as a property of the Store struct.
 func NewKVStore(ctx context.Context, dirPath string, config *Config) (*Store, error) {
@@ -104,27 +204,14 @@ func NewKVStore(ctx context.Context, dirPath string, config *Config) (*Store, er
 			return nil, err
 		}
 	}
-	datafile := KVStoreDatafilePath(dirPath)
 	start := time.Now()
-	log.Infof("Opening Bolt DB at %s", datafile)
-	boltDB, err := bolt.Open(
-		datafile,
-		params.BeaconIoConfig().ReadWritePermissions,
-		&bolt.Options{
-			Timeout:         1 * time.Second,
-			InitialMmapSize: config.InitialMMapSize,
-		},
-	)
+	db, err := openBackend(dirPath, config)
 	if err != nil {
-		log.WithField("elapsed", time.Since(start)).Error("Failed to open Bolt DB")
-		if errors.Is(err, bolt.ErrTimeout) {
-			return nil, errors.New("cannot obtain database lock, database may be in use by another process")
-		}
+		log.WithField("elapsed", time.Since(start)).Error("Failed to open database")
 		return nil, err
 	}
-	log.WithField("elapsed", time.Since(start)).Info("Opened Bolt DB")
+	log.WithField("elapsed", time.Since(start)).WithField("backend", db.Kind()).Info("Opened database")
 
-	boltDB.AllocSize = boltAllocSize
 	start = time.Now()
 	log.Infof("Creating block cache...")
 	blockCache, err := ristretto.NewCache(&ristretto.Config{
@@ -152,7 +239,7 @@ func NewKVStore(ctx context.Context, dirPath string, config *Config) (*Store, er
 	log.WithField("elapsed", time.Since(start)).Info("Created validator cache")
 
 	kv := &Store{
-		db:                  boltDB,
+		db:                  db,
 		databasePath:        dirPath,
 		blockCache:          blockCache,
 		validatorEntryCache: validatorCache,
@@ -161,7 +248,7 @@ func NewKVStore(ctx context.Context, dirPath string, config *Config) (*Store, er
 	}
 	start = time.Now()
 	log.Infof("Updating DB and creating buckets...")
-	if err := kv.db.Update(func(tx *bolt.Tx) error {
+	if err := kv.db.Update(func(tx backend.Tx) error {
 		return createBuckets(
 			tx,
 			attestationsBucket,
@@ -192,6 +279,11 @@ func NewKVStore(ctx context.Context, dirPath string, config *Config) (*Store, er
 			migrationsBucket,
 
 			feeRecipientBucket,
+			// Operation pool buckets.
+			poolVoluntaryExitsBucket,
+			poolProposerSlashingsBucket,
+			poolAttesterSlashingsBucket,
+			poolAttestationsBucket,
 		)
 	}); err != nil {
 		log.WithField("elapsed", time.Since(start)).Error("Failed to update db and create buckets")
@@ -199,26 +291,59 @@ func NewKVStore(ctx context.Context, dirPath string, config *Config) (*Store, er
 	}
 	log.WithField("elapsed", time.Since(start)).Info("Updated db and created buckets")
 
-	err = prometheus.Register(createBoltCollector(kv.db))
+	if err := kv.setupBlindedBeaconBlocks(); err != nil {
+		return nil, errors.Wrap(err, "could not configure blinded block storage")
+	}
+
+	if boltDB, ok := kv.db.(*backend.BoltDB); ok {
+		err = prometheus.Register(createBoltCollector(boltDB.Bolt()))
+	}
 
 	return kv, err
 }
 
+// setupBlindedBeaconBlocks records in the database that blocks are stored blinded when the feature
+// is enabled. The setting is sticky: a database which has ever stored blinded blocks keeps doing
+// so, as its full blocks have been migrated away.
+func (s *Store) setupBlindedBeaconBlocks() error {
+	return s.db.Update(func(tx backend.Tx) error {
+		bkt := tx.Bucket(chainMetadataBucket)
+		if features.Get().EnableOnlyBlindedBeaconBlocks {
+			if err := bkt.Put(saveBlindedBeaconBlocksKey, []byte{1}); err != nil {
+				return err
+			}
+		} else if bkt.Get(saveBlindedBeaconBlocksKey) != nil {
+			log.Warning("Database was created with blinded block storage. The node will work as if --enable-only-blinded-beacon-blocks=true")
+		}
+		s.saveBlindedBeaconBlocks = bkt.Get(saveBlindedBeaconBlocksKey) != nil
+		return nil
+	})
+}
+
 // ClearDB removes the previously stored database in the data directory.
 func (s *Store) ClearDB() error {
 	if _, err := os.Stat(s.databasePath); os.IsNotExist(err) {
 		return nil
 	}
-	prometheus.Unregister(createBoltCollector(s.db))
+	boltDB, ok := s.db.(*backend.BoltDB)
+	if !ok {
+		if err := os.RemoveAll(BackendPath(s.databasePath, s.db.Kind())); err != nil {
+			return errors.Wrap(err, "could not remove database directory")
+		}
+		return nil
+	}
+	prometheus.Unregister(createBoltCollector(boltDB.Bolt()))
 	if err := os.Remove(path.Join(s.databasePath, DatabaseFileName)); err != nil {
 		return errors.Wrap(err, "could not remove database file")
 	}
 	return nil
 }
 
-// Close closes the underlying BoltDB database.
+// Close closes the underlying database.
 func (s *Store) Close() error {
-	prometheus.Unregister(createBoltCollector(s.db))
+	if boltDB, ok := s.db.(*backend.BoltDB); ok {
+		prometheus.Unregister(createBoltCollector(boltDB.Bolt()))
+	}
 
 	// Before DB closes, we should dump the cached state summary objects to DB.
 	if err := s.saveCachedStateSummariesDB(s.ctx); err != nil {
@@ -233,7 +358,7 @@ func (s *Store) DatabasePath() string {
 	return s.databasePath
 }
 
-func createBuckets(tx *bolt.Tx, buckets ...[]byte) error {
+func createBuckets(tx backend.Tx, buckets ...[]byte) error {
 	for _, bucket := range buckets {
 		if _, err := tx.CreateBucketIfNotExists(bucket); err != nil {
 			return err
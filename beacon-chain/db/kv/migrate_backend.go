@@ -0,0 +1,96 @@
+package kv
+
+import (
+	"context"
+	"os"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/backend"
+	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
+)
+
+// backendBackupSuffix is appended to the name of the database file or directory of the previous
+// backend once a database has been migrated to another backend.
+const backendBackupSuffix = ".bak"
+
+// MigrateBackend copies the database in the directory path into a new database using the given
+// storage backend, then renames the previous database file or directory with a .bak suffix so that
+// the new database is opened from then on. It must be run while no beacon node uses the database.
+func MigrateBackend(ctx context.Context, dirPath string, to backend.Kind) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.MigrateBackend")
+	defer span.End()
+
+	from, ok := ExistingBackend(dirPath)
+	if !ok {
+		return errors.Errorf("no database found in %s", dirPath)
+	}
+	if from == to {
+		return errors.Errorf("database already uses the %s backend", to)
+	}
+	srcPath, dstPath := BackendPath(dirPath, from), BackendPath(dirPath, to)
+	if _, err := os.Stat(dstPath); err == nil {
+		return errors.Errorf("%s already exists, remove it to restart the migration", dstPath)
+	}
+
+	src, err := openBackendKind(dirPath, from, 0)
+	if err != nil {
+		return errors.Wrapf(err, "could not open %s database", from)
+	}
+	defer func() {
+		if src == nil {
+			return
+		}
+		if err := src.Close(); err != nil {
+			log.WithError(err).Error("Could not close database")
+		}
+	}()
+	dst, err := openBackendKind(dirPath, to, 0)
+	if err != nil {
+		return errors.Wrapf(err, "could not create %s database", to)
+	}
+	log.WithFields(logrus.Fields{
+		"from": srcPath,
+		"to":   dstPath,
+	}).Info("Copying database")
+	if err := backend.Copy(ctx, dst, src); err != nil {
+		if closeErr := dst.Close(); closeErr != nil {
+			log.WithError(closeErr).Error("Could not close database")
+		}
+		if removeErr := os.RemoveAll(dstPath); removeErr != nil {
+			log.WithError(removeErr).Errorf("Could not remove partially copied database at %s", dstPath)
+		}
+		return errors.Wrap(err, "could not copy database")
+	}
+	if c, ok := dst.(backend.Compactor); ok {
+		if err := c.Compact(); err != nil {
+			log.WithError(err).Warn("Could not compact migrated database")
+		}
+	}
+	if err := dst.Close(); err != nil {
+		return err
+	}
+	if err := src.Close(); err != nil {
+		return err
+	}
+	src = nil
+
+	backupPath := srcPath + backendBackupSuffix
+	if err := os.Rename(srcPath, backupPath); err != nil {
+		return errors.Wrapf(err, "could not move %s database out of the way", from)
+	}
+	log.WithField("previousDatabase", backupPath).Infof("Migrated database to the %s backend", to)
+	return nil
+}
+
+// BackendPath returns the path of the database file or directory of the backend in the directory path.
+func BackendPath(dirPath string, kind backend.Kind) string {
+	switch kind {
+	case backend.KindLevelDB:
+		return LevelDBDatadirPath(dirPath)
+	case backend.KindPebble:
+		return PebbleDatadirPath(dirPath)
+	default:
+		return KVStoreDatafilePath(dirPath)
+	}
+}
@@ -0,0 +1,168 @@
+package kv
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/backend"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/filters"
+	"github.com/prysmaticlabs/prysm/consensus-types/interfaces"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/consensus-types/wrapper"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	"github.com/prysmaticlabs/prysm/testing/util"
+)
+
+// chainBlocks returns count blocks from the given slot, each block being the parent of the next one.
+func chainBlocks(t testing.TB, start types.Slot, count int, parent [32]byte) ([]interfaces.SignedBeaconBlock, [][32]byte) {
+	blks := make([]interfaces.SignedBeaconBlock, count)
+	roots := make([][32]byte, count)
+	for i := 0; i < count; i++ {
+		b := util.NewBeaconBlock()
+		b.Block.Slot = start + types.Slot(i)
+		b.Block.ParentRoot = bytesutil.SafeCopyBytes(parent[:])
+		blk, err := wrapper.WrappedSignedBeaconBlock(b)
+		require.NoError(t, err)
+		root, err := blk.Block().HashTreeRoot()
+		require.NoError(t, err)
+		blks[i], roots[i], parent = blk, root, root
+	}
+	return blks, roots
+}
+
+// assertSameRoots checks that got contains the wanted roots, in any order.
+func assertSameRoots(t *testing.T, want, got [][32]byte) {
+	require.Equal(t, len(want), len(got))
+	wanted := make(map[[32]byte]bool, len(want))
+	for _, root := range want {
+		wanted[root] = true
+	}
+	for _, root := range got {
+		assert.Equal(t, true, wanted[root], "Unexpected root %#x", root)
+	}
+}
+
+func TestStore_DirectoryBackends(t *testing.T) {
+	for _, kind := range []backend.Kind{backend.KindLevelDB, backend.KindPebble} {
+		t.Run(string(kind), func(t *testing.T) {
+			ctx := context.Background()
+			dir := t.TempDir()
+			db, err := NewKVStore(ctx, dir, &Config{Backend: kind})
+			require.NoError(t, err)
+			existing, ok := ExistingBackend(dir)
+			require.Equal(t, true, ok)
+			assert.Equal(t, kind, existing)
+
+			blks, roots := chainBlocks(t, 1, 20, [32]byte{})
+			require.NoError(t, db.SaveBlocks(ctx, blks))
+			summaries := make([]*ethpb.StateSummary, len(blks))
+			for i, blk := range blks {
+				summaries[i] = &ethpb.StateSummary{Slot: blk.Block().Slot(), Root: roots[i][:]}
+			}
+			require.NoError(t, db.SaveStateSummaries(ctx, summaries))
+			require.NoError(t, db.DeleteBlock(ctx, roots[19]))
+			require.NoError(t, db.Close())
+
+			// The configured backend only applies to new databases.
+			db, err = NewKVStore(ctx, dir, &Config{Backend: backend.KindBolt})
+			require.NoError(t, err)
+			defer func() {
+				require.NoError(t, db.Close())
+			}()
+			assert.Equal(t, false, db.HasBlock(ctx, roots[19]))
+			got, err := db.BlockRoots(ctx, filters.NewFilter().SetStartSlot(5).SetEndSlot(9))
+			require.NoError(t, err)
+			assertSameRoots(t, roots[4:9], got)
+			got, err = db.BlockRoots(ctx, filters.NewFilter().SetParentRoot(roots[9][:]))
+			require.NoError(t, err)
+			assertSameRoots(t, roots[10:11], got)
+			assert.Equal(t, true, db.HasStateSummary(ctx, roots[18]))
+			assert.Equal(t, false, db.HasStateSummary(ctx, roots[19]))
+		})
+	}
+}
+
+func TestMigrateBackend(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	db, err := NewKVStore(ctx, dir, &Config{})
+	require.NoError(t, err)
+	blks, roots := chainBlocks(t, 1, 10, [32]byte{})
+	require.NoError(t, db.SaveBlocks(ctx, blks))
+	require.NoError(t, db.Close())
+
+	assert.ErrorContains(t, "already uses the bolt backend", MigrateBackend(ctx, dir, backend.KindBolt))
+	require.NoError(t, MigrateBackend(ctx, dir, backend.KindLevelDB))
+	kind, ok := ExistingBackend(dir)
+	require.Equal(t, true, ok)
+	assert.Equal(t, backend.KindLevelDB, kind)
+	_, err = os.Stat(KVStoreDatafilePath(dir) + backendBackupSuffix)
+	require.NoError(t, err, "Previous database was not kept")
+
+	db, err = NewKVStore(ctx, dir, &Config{})
+	require.NoError(t, err)
+	for _, root := range roots {
+		assert.Equal(t, true, db.HasBlock(ctx, root))
+	}
+	got, err := db.BlockRoots(ctx, filters.NewFilter().SetStartSlot(1).SetEndSlot(10))
+	require.NoError(t, err)
+	assertSameRoots(t, roots, got)
+	require.NoError(t, db.Close())
+
+	require.NoError(t, MigrateBackend(ctx, dir, backend.KindPebble))
+	kind, _ = ExistingBackend(dir)
+	assert.Equal(t, backend.KindPebble, kind)
+	db, err = NewKVStore(ctx, dir, &Config{})
+	require.NoError(t, err)
+	got, err = db.BlockRoots(ctx, filters.NewFilter().SetStartSlot(1).SetEndSlot(10))
+	require.NoError(t, err)
+	assertSameRoots(t, roots, got)
+	require.NoError(t, db.Close())
+
+	// Migrate back, the previous bolt file being out of the way.
+	require.NoError(t, MigrateBackend(ctx, dir, backend.KindBolt))
+	kind, _ = ExistingBackend(dir)
+	assert.Equal(t, backend.KindBolt, kind)
+}
+
+func BenchmarkStore_InitialSync_Bolt(b *testing.B) {
+	benchmarkInitialSync(b, backend.KindBolt)
+}
+
+func BenchmarkStore_InitialSync_LevelDB(b *testing.B) {
+	benchmarkInitialSync(b, backend.KindLevelDB)
+}
+
+func BenchmarkStore_InitialSync_Pebble(b *testing.B) {
+	benchmarkInitialSync(b, backend.KindPebble)
+}
+
+// benchmarkInitialSync measures the write throughput of initial sync, which saves batches of
+// blocks and their state summaries as they are processed. Each operation saves one batch.
+func benchmarkInitialSync(b *testing.B, kind backend.Kind) {
+	const batchSize = 64
+	ctx := context.Background()
+	db, err := NewKVStore(ctx, b.TempDir(), &Config{Backend: kind})
+	require.NoError(b, err)
+	defer func() {
+		require.NoError(b, db.Close())
+	}()
+
+	blks, roots := chainBlocks(b, 1, b.N*batchSize, [32]byte{})
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		batch := blks[i*batchSize : (i+1)*batchSize]
+		require.NoError(b, db.SaveBlocks(ctx, batch))
+		summaries := make([]*ethpb.StateSummary, len(batch))
+		for j, blk := range batch {
+			summaries[j] = &ethpb.StateSummary{Slot: blk.Block().Slot(), Root: roots[i*batchSize+j][:]}
+		}
+		require.NoError(b, db.SaveStateSummaries(ctx, summaries))
+	}
+	b.StopTimer()
+}
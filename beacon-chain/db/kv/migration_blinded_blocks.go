@@ -0,0 +1,88 @@
+package kv
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/golang/snappy"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/backend"
+	"github.com/prysmaticlabs/prysm/consensus-types/wrapper"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/monitoring/progress"
+)
+
+var migrationBlindedBeaconBlocksKey = []byte("blinded_beacon_blocks_0")
+
+// migrateBlindedBeaconBlocks replaces the full bellatrix blocks of a database running with blinded
+// block storage by their blinded counterparts, keeping only the execution payload headers.
+func migrateBlindedBeaconBlocks(ctx context.Context, db backend.DB) error {
+	var keys [][]byte
+	if err := db.View(func(tx backend.Tx) error {
+		if tx.Bucket(chainMetadataBucket).Get(saveBlindedBeaconBlocksKey) == nil {
+			return nil // Blinded block storage is not enabled.
+		}
+		if b := tx.Bucket(migrationsBucket).Get(migrationBlindedBeaconBlocksKey); bytes.Equal(b, migrationCompleted) {
+			return nil // Migration already completed.
+		}
+		keys = make([][]byte, 0)
+		return tx.Bucket(blocksBucket).ForEach(func(k, v []byte) error {
+			enc, err := snappy.Decode(nil, v)
+			if err != nil {
+				return err
+			}
+			if hasBellatrixKey(enc) {
+				keys = append(keys, bytesutil.SafeCopyBytes(k))
+			}
+			return ctx.Err()
+		})
+	}); err != nil {
+		return err
+	}
+	if keys == nil {
+		return nil
+	}
+
+	log.Infof("Performing a one-time migration of %d blocks in bucket %s to blinded blocks", len(keys), blocksBucket)
+	bar := progress.InitializeProgressBar(len(keys), "Migrating blocks to blinded blocks.")
+	for batchIndex := 0; batchIndex < len(keys); batchIndex += batchSize {
+		end := batchIndex + batchSize
+		if end > len(keys) {
+			end = len(keys)
+		}
+		if err := db.Update(func(tx backend.Tx) error {
+			bkt := tx.Bucket(blocksBucket)
+			for _, k := range keys[batchIndex:end] {
+				blk, err := unmarshalBlock(ctx, bkt.Get(k))
+				if err != nil {
+					return err
+				}
+				blinded, err := wrapper.BlindSignedBeaconBlock(blk)
+				if err != nil {
+					return errors.Wrapf(err, "could not blind block %#x", k)
+				}
+				enc, err := marshalBlock(ctx, blinded)
+				if err != nil {
+					return err
+				}
+				if err := bkt.Put(k, enc); err != nil {
+					return err
+				}
+				if err := bar.Add(1); err != nil {
+					return err
+				}
+			}
+			return ctx.Err()
+		}); err != nil {
+			return err
+		}
+	}
+
+	if err := db.Update(func(tx backend.Tx) error {
+		return tx.Bucket(migrationsBucket).Put(migrationBlindedBeaconBlocksKey, migrationCompleted)
+	}); err != nil {
+		return err
+	}
+	log.Infof("Migration done for bucket %s", blocksBucket)
+	return nil
+}
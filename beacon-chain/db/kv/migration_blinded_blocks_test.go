@@ -0,0 +1,128 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/backend"
+	"github.com/prysmaticlabs/prysm/config/features"
+	"github.com/prysmaticlabs/prysm/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/consensus-types/wrapper"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/runtime/version"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	"github.com/prysmaticlabs/prysm/testing/util"
+)
+
+func fullBellatrixBlock(t *testing.T) interfaces.SignedBeaconBlock {
+	b := util.NewBeaconBlockBellatrix()
+	b.Block.Slot = 1
+	b.Block.Body.ExecutionPayload.BlockHash = bytesutil.PadTo([]byte("hash"), 32)
+	b.Block.Body.ExecutionPayload.Transactions = [][]byte{[]byte("tx")}
+	wsb, err := wrapper.WrappedSignedBeaconBlock(b)
+	require.NoError(t, err)
+	return wsb
+}
+
+func TestStore_SaveBlock_Blinded(t *testing.T) {
+	resetCfg := features.InitWithReset(&features.Flags{EnableOnlyBlindedBeaconBlocks: true})
+	defer resetCfg()
+	db := setupDB(t)
+	ctx := context.Background()
+
+	wsb := fullBellatrixBlock(t)
+	root, err := wsb.Block().HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveBlock(ctx, wsb))
+
+	got, err := db.Block(ctx, root)
+	require.NoError(t, err)
+	assert.Equal(t, version.BellatrixBlind, got.Version())
+	// Bypass the block cache.
+	db.blockCache.Clear()
+	got, err = db.Block(ctx, root)
+	require.NoError(t, err)
+	assert.Equal(t, version.BellatrixBlind, got.Version())
+	gotRoot, err := got.Block().HashTreeRoot()
+	require.NoError(t, err)
+	assert.Equal(t, root, gotRoot)
+}
+
+func TestStore_BlindedBeaconBlocks_Sticky(t *testing.T) {
+	dir := t.TempDir()
+	resetCfg := features.InitWithReset(&features.Flags{EnableOnlyBlindedBeaconBlocks: true})
+	db, err := NewKVStore(context.Background(), dir, &Config{})
+	require.NoError(t, err)
+	assert.Equal(t, true, db.saveBlindedBeaconBlocks)
+	require.NoError(t, db.Close())
+	resetCfg()
+
+	db, err = NewKVStore(context.Background(), dir, &Config{})
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, db.Close())
+	}()
+	assert.Equal(t, true, db.saveBlindedBeaconBlocks)
+}
+
+func Test_migrateBlindedBeaconBlocks(t *testing.T) {
+	tests := []struct {
+		name        string
+		blinded     bool
+		done        bool
+		wantVersion int
+	}{
+		{
+			name:        "blinded storage disabled",
+			wantVersion: version.Bellatrix,
+		},
+		{
+			name:        "migrates full blocks",
+			blinded:     true,
+			wantVersion: version.BellatrixBlind,
+		},
+		{
+			name:        "only runs once",
+			blinded:     true,
+			done:        true,
+			wantVersion: version.Bellatrix,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			s := setupDB(t)
+			wsb := fullBellatrixBlock(t)
+			root, err := wsb.Block().HashTreeRoot()
+			require.NoError(t, err)
+			enc, err := marshalBlock(ctx, wsb)
+			require.NoError(t, err)
+			require.NoError(t, s.db.Update(func(tx backend.Tx) error {
+				if err := tx.Bucket(blocksBucket).Put(root[:], enc); err != nil {
+					return err
+				}
+				if tt.blinded {
+					if err := tx.Bucket(chainMetadataBucket).Put(saveBlindedBeaconBlocksKey, []byte{1}); err != nil {
+						return err
+					}
+				}
+				if tt.done {
+					return tx.Bucket(migrationsBucket).Put(migrationBlindedBeaconBlocksKey, migrationCompleted)
+				}
+				return nil
+			}))
+
+			require.NoError(t, migrateBlindedBeaconBlocks(ctx, s.db))
+			require.NoError(t, s.db.View(func(tx backend.Tx) error {
+				blk, err := unmarshalBlock(ctx, tx.Bucket(blocksBucket).Get(root[:]))
+				require.NoError(t, err)
+				assert.Equal(t, tt.wantVersion, blk.Version())
+				gotRoot, err := blk.Block().HashTreeRoot()
+				require.NoError(t, err)
+				assert.Equal(t, root, gotRoot)
+				return nil
+			}))
+		})
+	}
+}
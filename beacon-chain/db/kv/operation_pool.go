@@ -0,0 +1,162 @@
+package kv
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/backend"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/monitoring/tracing"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"go.opencensus.io/trace"
+	"google.golang.org/protobuf/proto"
+)
+
+// SaveVoluntaryExitPool replaces the persisted voluntary exits of the operation pool.
+func (s *Store) SaveVoluntaryExitPool(ctx context.Context, exits []*ethpb.SignedVoluntaryExit) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveVoluntaryExitPool")
+	defer span.End()
+	msgs := make([]proto.Message, len(exits))
+	for i, e := range exits {
+		msgs[i] = e
+	}
+	err := s.savePoolObjects(ctx, poolVoluntaryExitsBucket, msgs)
+	tracing.AnnotateError(span, err)
+	return err
+}
+
+// VoluntaryExitPool retrieves the persisted voluntary exits of the operation pool.
+func (s *Store) VoluntaryExitPool(ctx context.Context) ([]*ethpb.SignedVoluntaryExit, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.VoluntaryExitPool")
+	defer span.End()
+	var exits []*ethpb.SignedVoluntaryExit
+	err := s.poolObjects(ctx, poolVoluntaryExitsBucket, func() proto.Message {
+		e := &ethpb.SignedVoluntaryExit{}
+		exits = append(exits, e)
+		return e
+	})
+	tracing.AnnotateError(span, err)
+	return exits, err
+}
+
+// SaveProposerSlashingPool replaces the persisted proposer slashings of the operation pool.
+func (s *Store) SaveProposerSlashingPool(ctx context.Context, slashings []*ethpb.ProposerSlashing) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveProposerSlashingPool")
+	defer span.End()
+	msgs := make([]proto.Message, len(slashings))
+	for i, sl := range slashings {
+		msgs[i] = sl
+	}
+	err := s.savePoolObjects(ctx, poolProposerSlashingsBucket, msgs)
+	tracing.AnnotateError(span, err)
+	return err
+}
+
+// ProposerSlashingPool retrieves the persisted proposer slashings of the operation pool.
+func (s *Store) ProposerSlashingPool(ctx context.Context) ([]*ethpb.ProposerSlashing, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.ProposerSlashingPool")
+	defer span.End()
+	var slashings []*ethpb.ProposerSlashing
+	err := s.poolObjects(ctx, poolProposerSlashingsBucket, func() proto.Message {
+		sl := &ethpb.ProposerSlashing{}
+		slashings = append(slashings, sl)
+		return sl
+	})
+	tracing.AnnotateError(span, err)
+	return slashings, err
+}
+
+// SaveAttesterSlashingPool replaces the persisted attester slashings of the operation pool.
+func (s *Store) SaveAttesterSlashingPool(ctx context.Context, slashings []*ethpb.AttesterSlashing) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveAttesterSlashingPool")
+	defer span.End()
+	msgs := make([]proto.Message, len(slashings))
+	for i, sl := range slashings {
+		msgs[i] = sl
+	}
+	err := s.savePoolObjects(ctx, poolAttesterSlashingsBucket, msgs)
+	tracing.AnnotateError(span, err)
+	return err
+}
+
+// AttesterSlashingPool retrieves the persisted attester slashings of the operation pool.
+func (s *Store) AttesterSlashingPool(ctx context.Context) ([]*ethpb.AttesterSlashing, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.AttesterSlashingPool")
+	defer span.End()
+	var slashings []*ethpb.AttesterSlashing
+	err := s.poolObjects(ctx, poolAttesterSlashingsBucket, func() proto.Message {
+		sl := &ethpb.AttesterSlashing{}
+		slashings = append(slashings, sl)
+		return sl
+	})
+	tracing.AnnotateError(span, err)
+	return slashings, err
+}
+
+// SaveAttestationPool replaces the persisted aggregated attestations of the operation pool.
+func (s *Store) SaveAttestationPool(ctx context.Context, atts []*ethpb.Attestation) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveAttestationPool")
+	defer span.End()
+	msgs := make([]proto.Message, len(atts))
+	for i, a := range atts {
+		msgs[i] = a
+	}
+	err := s.savePoolObjects(ctx, poolAttestationsBucket, msgs)
+	tracing.AnnotateError(span, err)
+	return err
+}
+
+// AttestationPool retrieves the persisted aggregated attestations of the operation pool.
+func (s *Store) AttestationPool(ctx context.Context) ([]*ethpb.Attestation, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.AttestationPool")
+	defer span.End()
+	var atts []*ethpb.Attestation
+	err := s.poolObjects(ctx, poolAttestationsBucket, func() proto.Message {
+		a := &ethpb.Attestation{}
+		atts = append(atts, a)
+		return a
+	})
+	tracing.AnnotateError(span, err)
+	return atts, err
+}
+
+// savePoolObjects replaces the content of the bucket with the objects, keyed by their position.
+func (s *Store) savePoolObjects(ctx context.Context, bucket []byte, objs []proto.Message) error {
+	encoded := make([][]byte, len(objs))
+	for i, obj := range objs {
+		enc, err := encode(ctx, obj)
+		if err != nil {
+			return errors.Wrapf(err, "could not encode object %d", i)
+		}
+		encoded[i] = enc
+	}
+	return s.db.Update(func(tx backend.Tx) error {
+		if err := tx.DeleteBucket(bucket); err != nil && !errors.Is(err, backend.ErrBucketNotFound) {
+			return err
+		}
+		bkt, err := tx.CreateBucket(bucket)
+		if err != nil {
+			return err
+		}
+		for i, enc := range encoded {
+			if err := bkt.Put(bytesutil.Uint64ToBytesBigEndian(uint64(i)), enc); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// poolObjects decodes the objects of the bucket, in the order they were saved, into the
+// messages returned by newObj.
+func (s *Store) poolObjects(ctx context.Context, bucket []byte, newObj func() proto.Message) error {
+	return s.db.View(func(tx backend.Tx) error {
+		bkt := tx.Bucket(bucket)
+		if bkt == nil {
+			return nil
+		}
+		return bkt.ForEach(func(_, v []byte) error {
+			return decode(ctx, v, newObj())
+		})
+	})
+}
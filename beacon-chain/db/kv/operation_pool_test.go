@@ -0,0 +1,78 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/go-bitfield"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	"github.com/prysmaticlabs/prysm/testing/util"
+)
+
+func TestStore_VoluntaryExitPool(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	exits, err := db.VoluntaryExitPool(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(exits))
+
+	saved := []*ethpb.SignedVoluntaryExit{
+		{Exit: &ethpb.VoluntaryExit{Epoch: 1, ValidatorIndex: 2}, Signature: make([]byte, 96)},
+		{Exit: &ethpb.VoluntaryExit{Epoch: 3, ValidatorIndex: 4}, Signature: make([]byte, 96)},
+	}
+	require.NoError(t, db.SaveVoluntaryExitPool(ctx, saved))
+	exits, err = db.VoluntaryExitPool(ctx)
+	require.NoError(t, err)
+	require.DeepSSZEqual(t, saved, exits)
+
+	// Saving replaces the previous content.
+	require.NoError(t, db.SaveVoluntaryExitPool(ctx, saved[1:]))
+	exits, err = db.VoluntaryExitPool(ctx)
+	require.NoError(t, err)
+	require.DeepSSZEqual(t, saved[1:], exits)
+}
+
+func TestStore_SlashingPools(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	proposerSlashings := []*ethpb.ProposerSlashing{{
+		Header_1: util.HydrateSignedBeaconHeader(&ethpb.SignedBeaconBlockHeader{}),
+		Header_2: util.HydrateSignedBeaconHeader(&ethpb.SignedBeaconBlockHeader{Header: &ethpb.BeaconBlockHeader{Slot: 1}}),
+	}}
+	require.NoError(t, db.SaveProposerSlashingPool(ctx, proposerSlashings))
+	retrievedProposerSlashings, err := db.ProposerSlashingPool(ctx)
+	require.NoError(t, err)
+	require.DeepSSZEqual(t, proposerSlashings, retrievedProposerSlashings)
+
+	attesterSlashings := []*ethpb.AttesterSlashing{{
+		Attestation_1: util.HydrateIndexedAttestation(&ethpb.IndexedAttestation{AttestingIndices: []uint64{1}}),
+		Attestation_2: util.HydrateIndexedAttestation(&ethpb.IndexedAttestation{AttestingIndices: []uint64{1}}),
+	}}
+	require.NoError(t, db.SaveAttesterSlashingPool(ctx, attesterSlashings))
+	retrievedAttesterSlashings, err := db.AttesterSlashingPool(ctx)
+	require.NoError(t, err)
+	require.DeepSSZEqual(t, attesterSlashings, retrievedAttesterSlashings)
+}
+
+func TestStore_AttestationPool(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	atts := []*ethpb.Attestation{
+		util.HydrateAttestation(&ethpb.Attestation{AggregationBits: bitfield.Bitlist{0b1101}, Data: &ethpb.AttestationData{Slot: 1}}),
+		util.HydrateAttestation(&ethpb.Attestation{AggregationBits: bitfield.Bitlist{0b1101}, Data: &ethpb.AttestationData{Slot: 2}}),
+	}
+	require.NoError(t, db.SaveAttestationPool(ctx, atts))
+	retrieved, err := db.AttestationPool(ctx)
+	require.NoError(t, err)
+	require.DeepSSZEqual(t, atts, retrieved)
+
+	require.NoError(t, db.SaveAttestationPool(ctx, nil))
+	retrieved, err = db.AttestationPool(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(retrieved))
+}
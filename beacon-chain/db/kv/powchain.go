@@ -4,9 +4,10 @@ import (
 	"context"
 	"errors"
 
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/backend"
+	"github.com/prysmaticlabs/prysm/container/trie"
 	"github.com/prysmaticlabs/prysm/monitoring/tracing"
 	v2 "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
-	bolt "go.etcd.io/bbolt"
 	"go.opencensus.io/trace"
 	"google.golang.org/protobuf/proto"
 )
@@ -22,7 +23,7 @@ func (s *Store) SavePowchainData(ctx context.Context, data *v2.ETH1ChainData) er
 		return err
 	}
 
-	err := s.db.Update(func(tx *bolt.Tx) error {
+	err := s.db.Update(func(tx backend.Tx) error {
 		bkt := tx.Bucket(powchainBucket)
 		enc, err := proto.Marshal(data)
 		if err != nil {
@@ -40,7 +41,7 @@ func (s *Store) PowchainData(ctx context.Context) (*v2.ETH1ChainData, error) {
 	defer span.End()
 
 	var data *v2.ETH1ChainData
-	err := s.db.View(func(tx *bolt.Tx) error {
+	err := s.db.View(func(tx backend.Tx) error {
 		bkt := tx.Bucket(powchainBucket)
 		enc := bkt.Get(powchainDataKey)
 		if len(enc) == 0 {
@@ -51,3 +52,43 @@ func (s *Store) PowchainData(ctx context.Context) (*v2.ETH1ChainData, error) {
 	})
 	return data, err
 }
+
+// SaveDepositSnapshot saves the finalized deposit tree snapshot, replacing any previous one.
+func (s *Store) SaveDepositSnapshot(ctx context.Context, snapshot *trie.DepositTreeSnapshot) error {
+	_, span := trace.StartSpan(ctx, "BeaconDB.SaveDepositSnapshot")
+	defer span.End()
+
+	if snapshot == nil {
+		err := errors.New("cannot save nil deposit snapshot")
+		tracing.AnnotateError(span, err)
+		return err
+	}
+
+	enc, err := snapshot.MarshalSSZ()
+	if err != nil {
+		tracing.AnnotateError(span, err)
+		return err
+	}
+	err = s.db.Update(func(tx backend.Tx) error {
+		return tx.Bucket(powchainBucket).Put(depositSnapshotKey, enc)
+	})
+	tracing.AnnotateError(span, err)
+	return err
+}
+
+// DepositSnapshot retrieves the finalized deposit tree snapshot. It returns nil if no snapshot was saved.
+func (s *Store) DepositSnapshot(ctx context.Context) (*trie.DepositTreeSnapshot, error) {
+	_, span := trace.StartSpan(ctx, "BeaconDB.DepositSnapshot")
+	defer span.End()
+
+	var snapshot *trie.DepositTreeSnapshot
+	err := s.db.View(func(tx backend.Tx) error {
+		enc := tx.Bucket(powchainBucket).Get(depositSnapshotKey)
+		if len(enc) == 0 {
+			return nil
+		}
+		snapshot = &trie.DepositTreeSnapshot{}
+		return snapshot.UnmarshalSSZ(enc)
+	})
+	return snapshot, err
+}
@@ -4,7 +4,11 @@ import (
 	"context"
 	"testing"
 
+	"github.com/prysmaticlabs/prysm/config/params"
+	"github.com/prysmaticlabs/prysm/container/trie"
 	v2 "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
 )
 
 func TestStore_SavePowchainData(t *testing.T) {
@@ -33,3 +37,24 @@ func TestStore_SavePowchainData(t *testing.T) {
 		})
 	}
 }
+
+func TestStore_DepositSnapshot(t *testing.T) {
+	ctx := context.Background()
+	store := setupDB(t)
+	snapshot, err := store.DepositSnapshot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, (*trie.DepositTreeSnapshot)(nil), snapshot)
+	require.ErrorContains(t, "cannot save nil deposit snapshot", store.SaveDepositSnapshot(ctx, nil))
+
+	depositTrie, err := trie.NewTrie(params.BeaconConfig().DepositContractTreeDepth)
+	require.NoError(t, err)
+	for i := 0; i < 5; i++ {
+		require.NoError(t, depositTrie.Insert([]byte{byte(i + 1)}, i))
+	}
+	want, err := depositTrie.Snapshot([32]byte{'a'}, 10)
+	require.NoError(t, err)
+	require.NoError(t, store.SaveDepositSnapshot(ctx, want))
+	got, err := store.DepositSnapshot(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, want, got)
+}
@@ -0,0 +1,249 @@
+package kv
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/backend"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/filters"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"go.opencensus.io/trace"
+)
+
+// repairBatchSize is the number of keys read, and of index entries written, per transaction when
+// rebuilding indices.
+var repairBatchSize = 10000
+
+type indexEntry struct {
+	root    []byte
+	indices map[string][]byte
+}
+
+// RebuildIndices recreates the block slot and parent root indices from the saved blocks, and the
+// state slot index from the saved states. It is meant to be run against the database of a stopped
+// beacon node.
+func (s *Store) RebuildIndices(ctx context.Context) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.RebuildIndices")
+	defer span.End()
+
+	if err := s.db.Update(func(tx backend.Tx) error {
+		for _, bkt := range [][]byte{blockSlotIndicesBucket, blockParentRootIndicesBucket, stateSlotIndicesBucket} {
+			if err := tx.DeleteBucket(bkt); err != nil && !errors.Is(err, backend.ErrBucketNotFound) {
+				return err
+			}
+			if _, err := tx.CreateBucket(bkt); err != nil {
+				return err
+			}
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "could not clear indices")
+	}
+
+	if err := s.rebuildIndicesFrom(ctx, blocksBucket, func(_ backend.Tx, k, v []byte) (map[string][]byte, error) {
+		if len(k) != 32 {
+			return nil, nil
+		}
+		blk, err := unmarshalBlock(ctx, v)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not decode block %#x", k)
+		}
+		return createBlockIndicesFromBlock(ctx, blk.Block()), nil
+	}); err != nil {
+		return errors.Wrap(err, "could not rebuild block indices")
+	}
+	if err := s.rebuildIndicesFrom(ctx, stateBucket, func(tx backend.Tx, k, _ []byte) (map[string][]byte, error) {
+		slot, ok, err := stateSlot(ctx, tx, k)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			log.WithField("root", bytesutil.Trunc(k)).Warn("Could not determine the slot of state, not indexing it")
+			return nil, nil
+		}
+		return createStateIndicesFromStateSlot(ctx, slot), nil
+	}); err != nil {
+		return errors.Wrap(err, "could not rebuild state indices")
+	}
+	return nil
+}
+
+// rebuildIndicesFrom walks the keys of the bucket in batches of repairBatchSize, writing the
+// indices returned by indices for a batch before reading the next one. A nil map skips the key.
+func (s *Store) rebuildIndicesFrom(
+	ctx context.Context,
+	bucket []byte,
+	indices func(tx backend.Tx, k, v []byte) (map[string][]byte, error),
+) error {
+	var last []byte
+	for {
+		var entries []*indexEntry
+		read := 0
+		if err := s.db.View(func(tx backend.Tx) error {
+			c := tx.Bucket(bucket).Cursor()
+			k, v := c.First()
+			if last != nil {
+				// Resume after the last key of the previous batch.
+				if k, v = c.Seek(last); bytes.Equal(k, last) {
+					k, v = c.Next()
+				}
+			}
+			for ; k != nil && read < repairBatchSize; k, v = c.Next() {
+				if err := ctx.Err(); err != nil {
+					return err
+				}
+				read++
+				last = bytesutil.SafeCopyBytes(k)
+				idx, err := indices(tx, k, v)
+				if err != nil {
+					return err
+				}
+				if idx == nil {
+					continue
+				}
+				entries = append(entries, &indexEntry{root: bytesutil.SafeCopyBytes(k), indices: idx})
+			}
+			return nil
+		}); err != nil {
+			return err
+		}
+		if len(entries) > 0 {
+			if err := s.db.Update(func(tx backend.Tx) error {
+				for _, e := range entries {
+					if err := updateValueForIndices(ctx, e.indices, e.root, tx); err != nil {
+						return err
+					}
+				}
+				return nil
+			}); err != nil {
+				return errors.Wrap(err, "could not write indices")
+			}
+		}
+		if read < repairBatchSize {
+			return nil
+		}
+	}
+}
+
+// stateSlot returns the slot of the state with the given root from its summary, or from the block
+// with the same root.
+func stateSlot(ctx context.Context, tx backend.Tx, root []byte) (types.Slot, bool, error) {
+	if enc := tx.Bucket(stateSummaryBucket).Get(root); enc != nil {
+		summary := &ethpb.StateSummary{}
+		if err := decode(ctx, enc, summary); err != nil {
+			return 0, false, err
+		}
+		return summary.Slot, true, nil
+	}
+	enc := tx.Bucket(blocksBucket).Get(root)
+	if enc == nil {
+		return 0, false, nil
+	}
+	blk, err := unmarshalBlock(ctx, enc)
+	if err != nil {
+		return 0, false, errors.Wrapf(err, "could not decode block %#x", root)
+	}
+	return blk.Block().Slot(), true, nil
+}
+
+// RebuildFinalizedBlockRootsIndex recreates the finalized block roots index by walking back from
+// the finalized checkpoint block to the genesis or origin checkpoint block. The walk is written in
+// chunks of repairBatchSize blocks, each read from the transaction writing its index entries.
+func (s *Store) RebuildFinalizedBlockRootsIndex(ctx context.Context) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.RebuildFinalizedBlockRootsIndex")
+	defer span.End()
+
+	checkpoint := &ethpb.Checkpoint{}
+	if err := s.db.Update(func(tx backend.Tx) error {
+		if err := tx.DeleteBucket(finalizedBlockRootsIndexBucket); err != nil && !errors.Is(err, backend.ErrBucketNotFound) {
+			return err
+		}
+		if _, err := tx.CreateBucket(finalizedBlockRootsIndexBucket); err != nil {
+			return err
+		}
+		enc := tx.Bucket(checkpointBucket).Get(finalizedCheckpointKey)
+		if enc == nil {
+			return nil
+		}
+		return decode(ctx, enc, checkpoint)
+	}); err != nil {
+		return err
+	}
+	if bytesutil.ToBytes32(checkpoint.Root) == [32]byte{} {
+		return nil
+	}
+
+	// Walk up the ancestry chain from the finalized block until the genesis or origin checkpoint block.
+	root := checkpoint.Root
+	var previousRoot []byte
+	for done := false; !done; {
+		if err := s.db.Update(func(tx backend.Tx) error {
+			bkt := tx.Bucket(finalizedBlockRootsIndexBucket)
+			genesisRoot := tx.Bucket(blocksBucket).Get(genesisBlockRootKey)
+			initCheckpointRoot := tx.Bucket(blocksBucket).Get(originCheckpointBlockRootKey)
+			for i := 0; i < repairBatchSize; i++ {
+				if err := ctx.Err(); err != nil {
+					return err
+				}
+				if bytes.Equal(root, genesisRoot) {
+					done = true
+					return nil
+				}
+				enc := tx.Bucket(blocksBucket).Get(root)
+				if enc == nil {
+					return errors.Errorf("could not find block %#x", root)
+				}
+				blk, err := unmarshalBlock(ctx, enc)
+				if err != nil {
+					return errors.Wrapf(err, "could not decode block %#x", root)
+				}
+				container := &ethpb.FinalizedBlockRootContainer{
+					ParentRoot: blk.Block().ParentRoot(),
+					ChildRoot:  previousRoot,
+				}
+				enc, err = encode(ctx, container)
+				if err != nil {
+					return err
+				}
+				if err := bkt.Put(root, enc); err != nil {
+					return err
+				}
+				if bytes.Equal(root, initCheckpointRoot) {
+					done = true
+					return nil
+				}
+				previousRoot = root
+				root = bytesutil.SafeCopyBytes(blk.Block().ParentRoot())
+			}
+			return nil
+		}); err != nil {
+			return errors.Wrap(err, "could not index finalized blocks")
+		}
+	}
+
+	// Index the other blocks from the finalized epoch.
+	roots, err := s.BlockRoots(ctx, filters.NewFilter().SetStartEpoch(checkpoint.Epoch).SetEndEpoch(checkpoint.Epoch+1))
+	if err != nil {
+		return err
+	}
+	return s.db.Update(func(tx backend.Tx) error {
+		bkt := tx.Bucket(finalizedBlockRootsIndexBucket)
+		for _, root := range roots {
+			root := root[:]
+			if bytes.Equal(root, checkpoint.Root) || bkt.Get(root) != nil {
+				continue
+			}
+			if err := bkt.Put(root, containerFinalizedButNotCanonical); err != nil {
+				return err
+			}
+		}
+		enc, err := encode(ctx, checkpoint)
+		if err != nil {
+			return err
+		}
+		return bkt.Put(previousFinalizedCheckpointKey, enc)
+	})
+}
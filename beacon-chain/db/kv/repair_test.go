@@ -0,0 +1,183 @@
+package kv
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/backend"
+	"github.com/prysmaticlabs/prysm/config/params"
+	"github.com/prysmaticlabs/prysm/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/consensus-types/wrapper"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	"github.com/prysmaticlabs/prysm/testing/util"
+	bolt "go.etcd.io/bbolt"
+)
+
+// setupRepairDB saves a genesis block followed by three epochs of blocks, with epoch 1 finalized.
+func setupRepairDB(t *testing.T) (*Store, []interfaces.SignedBeaconBlock) {
+	db := setupDB(t)
+	ctx := context.Background()
+	slotsPerEpoch := uint64(params.BeaconConfig().SlotsPerEpoch)
+
+	genesis, err := wrapper.WrappedSignedBeaconBlock(util.NewBeaconBlock())
+	require.NoError(t, err)
+	require.NoError(t, db.SaveBlock(ctx, genesis))
+	genesisRoot, err := genesis.Block().HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveGenesisBlockRoot(ctx, genesisRoot))
+
+	blks := makeBlocks(t, 0, slotsPerEpoch*3, genesisRoot)
+	require.NoError(t, db.SaveBlocks(ctx, blks))
+	root, err := blks[slotsPerEpoch].Block().HashTreeRoot()
+	require.NoError(t, err)
+	st, err := util.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, st.SetSlot(blks[slotsPerEpoch].Block().Slot()))
+	require.NoError(t, db.SaveState(ctx, st, root))
+	require.NoError(t, db.SaveFinalizedCheckpoint(ctx, &ethpb.Checkpoint{Epoch: 1, Root: root[:]}))
+	return db, blks
+}
+
+func blockRoot(t *testing.T, blk interfaces.SignedBeaconBlock) [32]byte {
+	root, err := blk.Block().HashTreeRoot()
+	require.NoError(t, err)
+	return root
+}
+
+func TestStore_VerifyConsistency(t *testing.T) {
+	db, blks := setupRepairDB(t)
+	ctx := context.Background()
+
+	report, err := db.VerifyConsistency(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, true, report.Consistent())
+	assert.Equal(t, len(blks)+1, report.Blocks)
+
+	root5, root6, root10 := blockRoot(t, blks[5]), blockRoot(t, blks[6]), blockRoot(t, blks[10])
+	orphan := bytesutil.ToBytes32([]byte("orphan"))
+	enc, err := encode(ctx, &ethpb.StateSummary{Slot: 100, Root: orphan[:]})
+	require.NoError(t, err)
+	require.NoError(t, db.db.Update(func(tx backend.Tx) error {
+		if err := tx.Bucket(stateSummaryBucket).Put(orphan[:], enc); err != nil {
+			return err
+		}
+		if err := tx.Bucket(blocksBucket).Delete(root5[:]); err != nil {
+			return err
+		}
+		return tx.Bucket(blockSlotIndicesBucket).Delete(bytesutil.SlotToBytesBigEndian(blks[10].Block().Slot()))
+	}))
+
+	report, err = db.VerifyConsistency(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, false, report.Consistent())
+	assert.DeepEqual(t, [][32]byte{root6}, report.MissingParents)
+	assert.DeepEqual(t, [][32]byte{root10}, report.UnindexedBlocks)
+	assert.DeepEqual(t, [][32]byte{orphan}, report.OrphanedSummaries)
+	assert.Equal(t, 1, report.OrphanedBlockSlotIndices)
+	assert.Equal(t, 1, report.OrphanedParentRootIndices)
+	assert.Equal(t, 0, report.OrphanedStateSlotIndices)
+}
+
+func TestStore_RebuildIndices(t *testing.T) {
+	// Rebuild in several batches.
+	defer func(size int) { repairBatchSize = size }(repairBatchSize)
+	repairBatchSize = 5
+	db, blks := setupRepairDB(t)
+	ctx := context.Background()
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+
+	bogus := bytesutil.PadTo([]byte("bogus"), 32)
+	require.NoError(t, db.db.Update(func(tx backend.Tx) error {
+		if err := tx.Bucket(blockSlotIndicesBucket).Delete(bytesutil.SlotToBytesBigEndian(blks[10].Block().Slot())); err != nil {
+			return err
+		}
+		if err := tx.Bucket(stateSlotIndicesBucket).Put(bytesutil.SlotToBytesBigEndian(1000), bogus); err != nil {
+			return err
+		}
+		return tx.Bucket(blockParentRootIndicesBucket).Put(bogus, bogus)
+	}))
+	report, err := db.VerifyConsistency(ctx)
+	require.NoError(t, err)
+	require.Equal(t, false, report.Consistent())
+
+	require.NoError(t, db.RebuildIndices(ctx))
+	report, err = db.VerifyConsistency(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, true, report.Consistent())
+
+	hasBlock, found, err := db.BlocksBySlot(ctx, blks[10].Block().Slot())
+	require.NoError(t, err)
+	assert.Equal(t, true, hasBlock)
+	assert.Equal(t, 1, len(found))
+	lastArchived, err := db.LastArchivedSlot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, slotsPerEpoch+1, lastArchived)
+}
+
+func TestStore_RebuildFinalizedBlockRootsIndex(t *testing.T) {
+	// Rebuild in several batches.
+	defer func(size int) { repairBatchSize = size }(repairBatchSize)
+	repairBatchSize = 5
+	db, blks := setupRepairDB(t)
+	ctx := context.Background()
+	slotsPerEpoch := uint64(params.BeaconConfig().SlotsPerEpoch)
+
+	finalized := make([]bool, len(blks))
+	for i := range blks {
+		finalized[i] = db.IsFinalizedBlock(ctx, blockRoot(t, blks[i]))
+	}
+	require.NoError(t, db.db.Update(func(tx backend.Tx) error {
+		bkt := tx.Bucket(finalizedBlockRootsIndexBucket)
+		return bkt.ForEach(func(k, _ []byte) error {
+			return bkt.Delete(k)
+		})
+	}))
+	assert.Equal(t, false, db.IsFinalizedBlock(ctx, blockRoot(t, blks[0])))
+
+	require.NoError(t, db.RebuildFinalizedBlockRootsIndex(ctx))
+	for i := range blks {
+		assert.Equal(t, finalized[i], db.IsFinalizedBlock(ctx, blockRoot(t, blks[i])), "Block at index %d", i)
+	}
+	for i := uint64(0); i < slotsPerEpoch*2; i++ {
+		assert.Equal(t, true, finalized[i], "Block at index %d was not considered finalized in the index", i)
+	}
+}
+
+func TestStore_BucketStatsAndCompact(t *testing.T) {
+	db, blks := setupRepairDB(t)
+	ctx := context.Background()
+
+	stats, err := db.BucketStats(ctx)
+	require.NoError(t, err)
+	keys := make(map[string]int)
+	for _, s := range stats {
+		keys[s.Name] = s.Keys
+		assert.Equal(t, true, s.InUseBytes <= s.AllocatedBytes, "bucket %s", s.Name)
+	}
+	// Blocks, the genesis block and the genesis block root key.
+	assert.Equal(t, len(blks)+2, keys[string(blocksBucket)])
+
+	dstDir := filepath.Join(t.TempDir(), "compacted")
+	require.NoError(t, db.Compact(ctx, dstDir))
+	assert.ErrorContains(t, "database already exists", db.Compact(ctx, dstDir))
+
+	compacted, err := backend.OpenBolt(KVStoreDatafilePath(dstDir), params.BeaconIoConfig().ReadWritePermissions, &bolt.Options{ReadOnly: true})
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, compacted.Close())
+	}()
+	require.NoError(t, compacted.View(func(tx backend.Tx) error {
+		n := 0
+		err := tx.ForEach(func(name []byte, bkt backend.Bucket) error {
+			n++
+			assert.Equal(t, keys[string(name)], bkt.Stats().KeyN, "bucket %s", name)
+			return nil
+		})
+		assert.Equal(t, len(stats), n)
+		return err
+	}))
+}
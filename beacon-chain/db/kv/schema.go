@@ -20,6 +20,12 @@ var (
 	stateValidatorsBucket   = []byte("state-validators")
 	feeRecipientBucket      = []byte("fee-recipient")
 
+	// Operation pool buckets, holding the pending operations of the pools across restarts.
+	poolVoluntaryExitsBucket    = []byte("pool-voluntary-exits")
+	poolProposerSlashingsBucket = []byte("pool-proposer-slashings")
+	poolAttesterSlashingsBucket = []byte("pool-attester-slashings")
+	poolAttestationsBucket      = []byte("pool-attestations")
+
 	// Deprecated: This bucket was migrated in PR 6461. Do not use, except for migrations.
 	slotsHasObjectBucket = []byte("slots-has-objects")
 	// Deprecated: This bucket was migrated in PR 6461. Do not use, except for migrations.
@@ -44,7 +50,9 @@ var (
 	justifiedCheckpointKey     = []byte("justified-checkpoint")
 	finalizedCheckpointKey     = []byte("finalized-checkpoint")
 	powchainDataKey            = []byte("powchain-data")
+	depositSnapshotKey         = []byte("deposit-snapshot")
 	lastValidatedCheckpointKey = []byte("last-validated-checkpoint")
+	saveBlindedBeaconBlocksKey = []byte("save-blinded-beacon-blocks")
 
 	// Below keys are used to identify objects are to be fork compatible.
 	// Objects that are only compatible with specific forks should be prefixed with such keys.
@@ -0,0 +1,174 @@
+package kv
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/backend"
+	"github.com/prysmaticlabs/prysm/config/params"
+	"github.com/prysmaticlabs/prysm/monitoring/backup"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// SnapshotMetadata returns the genesis block root and the finalized checkpoint of the database.
+func (s *Store) SnapshotMetadata(ctx context.Context) (*backup.Metadata, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SnapshotMetadata")
+	defer span.End()
+
+	var meta *backup.Metadata
+	err := s.db.View(func(tx backend.Tx) error {
+		var err error
+		meta, err = snapshotMetadata(ctx, tx)
+		return err
+	})
+	return meta, err
+}
+
+// Snapshot writes a copy of the database, as a bolt database file, to w. The copy is taken within
+// a single read transaction, so that it is consistent while the beacon node keeps writing to the
+// database.
+func (s *Store) Snapshot(ctx context.Context, w io.Writer) (*backup.Metadata, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.Snapshot")
+	defer span.End()
+
+	if s.db.Kind() != backend.KindBolt {
+		return s.snapshotToBolt(ctx, w)
+	}
+	var meta *backup.Metadata
+	err := s.db.View(func(tx backend.Tx) error {
+		var err error
+		if meta, err = snapshotMetadata(ctx, tx); err != nil {
+			return err
+		}
+		wt, ok := tx.(io.WriterTo)
+		if !ok {
+			return backend.ErrUnsupported
+		}
+		_, err = wt.WriteTo(w)
+		return err
+	})
+	return meta, err
+}
+
+// snapshotToBolt copies the database into a temporary bolt database file, and writes the file
+// to w, so that snapshots can be restored whatever the backend of the database.
+func (s *Store) snapshotToBolt(ctx context.Context, w io.Writer) (*backup.Metadata, error) {
+	tmpDir, err := os.MkdirTemp("", "prysm-snapshot")
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := os.RemoveAll(tmpDir); err != nil {
+			log.WithError(err).Error("Could not remove temporary snapshot directory")
+		}
+	}()
+	tmpFile := KVStoreDatafilePath(tmpDir)
+	copyDB, err := backend.OpenBolt(tmpFile, params.BeaconIoConfig().ReadWritePermissions, &bolt.Options{NoSync: true})
+	if err != nil {
+		return nil, err
+	}
+	copyDB.Bolt().AllocSize = boltAllocSize
+	if err := backend.Copy(ctx, copyDB, s.db); err != nil {
+		_ = copyDB.Close()
+		return nil, err
+	}
+	var meta *backup.Metadata
+	err = copyDB.View(func(tx backend.Tx) error {
+		var err error
+		meta, err = snapshotMetadata(ctx, tx)
+		return err
+	})
+	if err := copyDB.Close(); err != nil {
+		return nil, err
+	}
+	if err != nil {
+		return nil, err
+	}
+	f, err := os.Open(tmpFile) // #nosec G304
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := f.Close(); err != nil {
+			log.WithError(err).Error("Could not close temporary snapshot file")
+		}
+	}()
+	if _, err := io.Copy(w, f); err != nil {
+		return nil, err
+	}
+	return meta, nil
+}
+
+// ReadSnapshotMetadata returns the genesis block root and the finalized checkpoint of the
+// database file at the given path, which must not be in use by a beacon node.
+func ReadSnapshotMetadata(ctx context.Context, dbFile string) (*backup.Metadata, error) {
+	db, err := backend.OpenBolt(dbFile, params.BeaconIoConfig().ReadWritePermissions, &bolt.Options{
+		ReadOnly: true,
+		Timeout:  params.BeaconIoConfig().BoltTimeout,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := db.Close(); err != nil {
+			log.WithError(err).Error("Could not close database")
+		}
+	}()
+	var meta *backup.Metadata
+	err = db.View(func(tx backend.Tx) error {
+		var err error
+		meta, err = snapshotMetadata(ctx, tx)
+		return err
+	})
+	return meta, err
+}
+
+// ReadDatabaseMetadata returns the genesis block root and the finalized checkpoint of the database
+// in the directory path, whatever its storage backend. The database must not be in use by a beacon node.
+func ReadDatabaseMetadata(ctx context.Context, dirPath string) (*backup.Metadata, error) {
+	kind, ok := ExistingBackend(dirPath)
+	if !ok {
+		return nil, errors.Errorf("no database found in %s", dirPath)
+	}
+	db, err := openBackendKind(dirPath, kind, 0)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := db.Close(); err != nil {
+			log.WithError(err).Error("Could not close database")
+		}
+	}()
+	var meta *backup.Metadata
+	err = db.View(func(tx backend.Tx) error {
+		var err error
+		meta, err = snapshotMetadata(ctx, tx)
+		return err
+	})
+	return meta, err
+}
+
+func snapshotMetadata(ctx context.Context, tx backend.Tx) (*backup.Metadata, error) {
+	meta := &backup.Metadata{}
+	if bkt := tx.Bucket(blocksBucket); bkt != nil {
+		if root := bkt.Get(genesisBlockRootKey); len(root) != 0 {
+			meta.GenesisRoot = fmt.Sprintf("%#x", root)
+		}
+	}
+	if bkt := tx.Bucket(checkpointBucket); bkt != nil {
+		if enc := bkt.Get(finalizedCheckpointKey); enc != nil {
+			checkpoint := &ethpb.Checkpoint{}
+			if err := decode(ctx, enc, checkpoint); err != nil {
+				return nil, err
+			}
+			meta.FinalizedEpoch = uint64(checkpoint.Epoch)
+			meta.FinalizedRoot = fmt.Sprintf("%#x", checkpoint.Root)
+		}
+	}
+	return meta, nil
+}
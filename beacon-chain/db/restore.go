@@ -6,23 +6,32 @@ import (
 	"strings"
 
 	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/backend"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db/kv"
 	"github.com/prysmaticlabs/prysm/cmd"
 	"github.com/prysmaticlabs/prysm/io/file"
 	"github.com/prysmaticlabs/prysm/io/prompt"
+	"github.com/prysmaticlabs/prysm/monitoring/backup"
+	"github.com/sirupsen/logrus"
 	"github.com/urfave/cli/v2"
 )
 
 const dbExistsYesNoPrompt = "A database file already exists in the target directory. " +
 	"Are you sure that you want to overwrite it? [y/n]"
 
-// Restore a beacon chain database.
+// Restore a beacon chain database. The backup is extracted next to the target database file and
+// its checksum, genesis root and finalized checkpoint are verified against its manifest, and its
+// genesis root against the one of the current database, before it replaces the current database.
+// Backups without a manifest are only restored with --restore-allow-unverified.
 func Restore(cliCtx *cli.Context) error {
 	sourceFile := cliCtx.String(cmd.RestoreSourceFileFlag.Name)
 	targetDir := cliCtx.String(cmd.RestoreTargetDirFlag.Name)
 
 	restoreDir := path.Join(targetDir, kv.BeaconNodeDbDirName)
-	if file.FileExists(path.Join(restoreDir, kv.DatabaseFileName)) {
+	targetFile := path.Join(restoreDir, kv.DatabaseFileName)
+	// Backups are bolt database files, whatever the storage backend of the backed up database.
+	targetBackend, targetExists := kv.ExistingBackend(restoreDir)
+	if targetExists {
 		resp, err := prompt.ValidatePrompt(
 			os.Stdin, dbExistsYesNoPrompt, prompt.ValidateYesOrNo,
 		)
@@ -37,10 +46,62 @@ func Restore(cliCtx *cli.Context) error {
 	if err := file.MkdirAll(restoreDir); err != nil {
 		return err
 	}
-	if err := file.CopyFile(sourceFile, path.Join(restoreDir, kv.DatabaseFileName)); err != nil {
+
+	tmpFile := targetFile + ".restore"
+	if err := os.Remove(tmpFile); err != nil && !os.IsNotExist(err) {
 		return err
 	}
+	defer func() {
+		if err := os.Remove(tmpFile); err != nil && !os.IsNotExist(err) {
+			log.WithError(err).Error("Could not remove extracted backup")
+		}
+	}()
+	manifest, err := backup.Extract(sourceFile, tmpFile)
+	if err != nil {
+		return errors.Wrap(err, "could not extract backup")
+	}
+	restored, err := kv.ReadSnapshotMetadata(cliCtx.Context, tmpFile)
+	if err != nil {
+		return errors.Wrap(err, "could not read restored database")
+	}
+	if manifest != nil {
+		if err := manifest.Metadata.Verify(restored); err != nil {
+			return err
+		}
+	} else if !cliCtx.Bool(cmd.RestoreAllowUnverifiedFlag.Name) {
+		return errors.Errorf("backup %s has no manifest, its checksum cannot be verified. Use --%s to restore it anyway",
+			sourceFile, cmd.RestoreAllowUnverifiedFlag.Name)
+	}
+	if targetExists {
+		current, err := kv.ReadDatabaseMetadata(cliCtx.Context, restoreDir)
+		if err != nil {
+			return errors.Wrap(err, "could not read current database")
+		}
+		if current.GenesisRoot != "" && restored.GenesisRoot != current.GenesisRoot {
+			return errors.Errorf("backup genesis root %s does not match genesis root %s of the current database",
+				restored.GenesisRoot, current.GenesisRoot)
+		}
+		if restored.FinalizedEpoch < current.FinalizedEpoch {
+			log.WithFields(logrus.Fields{
+				"backupFinalizedEpoch":  restored.FinalizedEpoch,
+				"currentFinalizedEpoch": current.FinalizedEpoch,
+			}).Warn("Backup is older than the current database, the beacon node will sync the missing blocks again")
+		}
+	}
+	if err := os.Rename(tmpFile, targetFile); err != nil {
+		return err
+	}
+	if targetExists && targetBackend != backend.KindBolt {
+		previous := kv.BackendPath(restoreDir, targetBackend)
+		if err := os.Rename(previous, previous+".bak"); err != nil {
+			return errors.Wrapf(err, "could not move %s database out of the way", targetBackend)
+		}
+		log.WithField("previousDatabase", previous+".bak").Infof("Restored database replaces the %s database", targetBackend)
+	}
 
-	log.Info("Restore completed successfully")
+	log.WithFields(logrus.Fields{
+		"genesisRoot":    restored.GenesisRoot,
+		"finalizedEpoch": restored.FinalizedEpoch,
+	}).Info("Restore completed successfully")
 	return nil
 }
@@ -2,15 +2,20 @@ package db
 
 import (
 	"context"
+	"encoding/json"
 	"flag"
+	"fmt"
 	"os"
 	"path"
 	"testing"
 
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/backend"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db/kv"
 	"github.com/prysmaticlabs/prysm/cmd"
 	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/consensus-types/wrapper"
+	"github.com/prysmaticlabs/prysm/monitoring/backup"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/testing/assert"
 	"github.com/prysmaticlabs/prysm/testing/require"
 	"github.com/prysmaticlabs/prysm/testing/util"
@@ -50,8 +55,12 @@ func TestRestore(t *testing.T) {
 	set.String(cmd.RestoreTargetDirFlag.Name, "", "")
 	require.NoError(t, set.Set(cmd.RestoreSourceFileFlag.Name, path.Join(backupDb.DatabasePath(), "backup.db")))
 	require.NoError(t, set.Set(cmd.RestoreTargetDirFlag.Name, restoreDir))
+	set.Bool(cmd.RestoreAllowUnverifiedFlag.Name, false, "")
 	cliCtx := cli.NewContext(&app, set, nil)
 
+	// The backup has no manifest, it is only restored when explicitly allowed.
+	assert.ErrorContains(t, "has no manifest", Restore(cliCtx))
+	require.NoError(t, set.Set(cmd.RestoreAllowUnverifiedFlag.Name, "true"))
 	assert.NoError(t, Restore(cliCtx))
 
 	files, err := os.ReadDir(path.Join(restoreDir, kv.BeaconNodeDbDirName))
@@ -69,3 +78,89 @@ func TestRestore(t *testing.T) {
 	assert.LogsContain(t, logHook, "Restore completed successfully")
 
 }
+
+func TestRestore_Snapshot(t *testing.T) {
+	ctx := context.Background()
+
+	backupDb, err := kv.NewKVStore(ctx, t.TempDir(), &kv.Config{})
+	require.NoError(t, err)
+	blk := util.NewBeaconBlock()
+	wsb, err := wrapper.WrappedSignedBeaconBlock(blk)
+	require.NoError(t, err)
+	require.NoError(t, backupDb.SaveBlock(ctx, wsb))
+	root, err := blk.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, backupDb.SaveGenesisBlockRoot(ctx, root))
+	st, err := util.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, backupDb.SaveState(ctx, st, root))
+	require.NoError(t, backupDb.SaveFinalizedCheckpoint(ctx, &ethpb.Checkpoint{Epoch: 2, Root: root[:]}))
+	snapshotDir := t.TempDir()
+	manifest, err := backup.WriteSnapshot(ctx, backupDb, snapshotDir, "prysm_beacondb", true)
+	require.NoError(t, err)
+	require.NoError(t, backupDb.Close())
+	snapshotFile := path.Join(snapshotDir, manifest.File)
+
+	newCliCtx := func(restoreDir string) *cli.Context {
+		set := flag.NewFlagSet("test", 0)
+		set.String(cmd.RestoreSourceFileFlag.Name, snapshotFile, "")
+		set.String(cmd.RestoreTargetDirFlag.Name, restoreDir, "")
+		return cli.NewContext(&cli.App{}, set, nil)
+	}
+
+	t.Run("verified restore", func(t *testing.T) {
+		restoreDir := t.TempDir()
+		require.NoError(t, Restore(newCliCtx(restoreDir)))
+		files, err := os.ReadDir(path.Join(restoreDir, kv.BeaconNodeDbDirName))
+		require.NoError(t, err)
+		require.Equal(t, 1, len(files))
+		assert.Equal(t, kv.DatabaseFileName, files[0].Name())
+		meta, err := kv.ReadSnapshotMetadata(ctx, path.Join(restoreDir, kv.BeaconNodeDbDirName, kv.DatabaseFileName))
+		require.NoError(t, err)
+		assert.Equal(t, fmt.Sprintf("%#x", root), meta.GenesisRoot)
+		assert.Equal(t, uint64(2), meta.FinalizedEpoch)
+		assert.Equal(t, fmt.Sprintf("%#x", root), meta.FinalizedRoot)
+	})
+
+	t.Run("genesis root mismatch", func(t *testing.T) {
+		restoreDir := t.TempDir()
+		for _, kind := range []backend.Kind{backend.KindBolt, backend.KindPebble} {
+			t.Run(string(kind), func(t *testing.T) {
+				currentDir := path.Join(restoreDir, string(kind), kv.BeaconNodeDbDirName)
+				current, err := kv.NewKVStore(ctx, currentDir, &kv.Config{Backend: kind})
+				require.NoError(t, err)
+				require.NoError(t, current.SaveGenesisBlockRoot(ctx, [32]byte{'a'}))
+				require.NoError(t, current.Close())
+
+				tmpfile, err := os.CreateTemp(t.TempDir(), "stdin")
+				require.NoError(t, err)
+				_, err = tmpfile.Write([]byte("y\n"))
+				require.NoError(t, err)
+				_, err = tmpfile.Seek(0, 0)
+				require.NoError(t, err)
+				origStdin := os.Stdin
+				defer func() { os.Stdin = origStdin }()
+				os.Stdin = tmpfile
+
+				assert.ErrorContains(t, "does not match genesis root", Restore(newCliCtx(path.Join(restoreDir, string(kind)))))
+				existing, ok := kv.ExistingBackend(currentDir)
+				require.Equal(t, true, ok)
+				assert.Equal(t, kind, existing, "Current database was replaced")
+			})
+		}
+	})
+
+	t.Run("finalized checkpoint mismatch", func(t *testing.T) {
+		tampered := *manifest
+		tampered.Metadata = &backup.Metadata{GenesisRoot: manifest.Metadata.GenesisRoot, FinalizedEpoch: 3, FinalizedRoot: manifest.Metadata.FinalizedRoot}
+		enc, err := json.Marshal(&tampered)
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(snapshotFile+backup.ManifestSuffix, enc, 0600))
+
+		restoreDir := t.TempDir()
+		assert.ErrorContains(t, "finalized checkpoint", Restore(newCliCtx(restoreDir)))
+		files, err := os.ReadDir(path.Join(restoreDir, kv.BeaconNodeDbDirName))
+		require.NoError(t, err)
+		assert.Equal(t, 0, len(files))
+	})
+}
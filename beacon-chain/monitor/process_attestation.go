@@ -93,7 +93,7 @@ func (s *Service) processIncludedAttestation(ctx context.Context, state state.Be
 			inclusionSlotGauge.WithLabelValues(fmt.Sprintf("%d", idx)).Set(float64(latestPerf.inclusionSlot))
 			aggregatedPerf.totalDistance += uint64(latestPerf.inclusionSlot - latestPerf.attestedSlot)
 
-			if state.Version() == version.Altair {
+			if state.Version() >= version.Altair {
 				targetIdx := params.BeaconConfig().TimelyTargetFlagIndex
 				sourceIdx := params.BeaconConfig().TimelySourceFlagIndex
 				headIdx := params.BeaconConfig().TimelyHeadFlagIndex
@@ -146,6 +146,14 @@ func (s *Service) processIncludedAttestation(ctx context.Context, state state.Be
 					aggregatedPerf.totalCorrectTarget++
 				}
 			}
+			summary := s.epochSummary(types.ValidatorIndex(idx), att.Data.Target.Epoch)
+			summary.AttestationIncluded = true
+			summary.InclusionDelay = latestPerf.inclusionSlot - latestPerf.attestedSlot
+			summary.CorrectSource = latestPerf.timelySource
+			summary.CorrectTarget = latestPerf.timelyTarget
+			summary.CorrectHead = latestPerf.timelyHead
+			s.epochSummary(types.ValidatorIndex(idx), slots.ToEpoch(state.Slot())).EndBalance = balance
+
 			logFields["CorrectHead"] = latestPerf.timelyHead
 			logFields["CorrectSource"] = latestPerf.timelySource
 			logFields["CorrectTarget"] = latestPerf.timelyTarget
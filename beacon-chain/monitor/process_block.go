@@ -78,6 +78,10 @@ func (s *Service) processProposedBlock(state state.BeaconState, root [32]byte, b
 			return
 		}
 
+		summary := s.epochSummary(blk.ProposerIndex(), slots.ToEpoch(blk.Slot()))
+		summary.ProposedBlocks++
+		summary.EndBalance = balance
+
 		latestPerf := s.latestPerformance[blk.ProposerIndex()]
 		balanceChg := int64(balance - latestPerf.balance)
 		latestPerf.balanceChange = balanceChg
@@ -145,11 +149,11 @@ func (s *Service) logAggregatedPerformance() {
 
 	for idx, p := range s.aggregatedPerformance {
 		if p.totalAttestedCount == 0 || p.totalRequestedCount == 0 || p.startBalance == 0 {
-			break
+			continue
 		}
 		l, ok := s.latestPerformance[idx]
 		if !ok {
-			break
+			continue
 		}
 		percentAtt := float64(p.totalAttestedCount) / float64(p.totalRequestedCount)
 		percentBal := float64(l.balance-p.startBalance) / float64(p.startBalance)
@@ -6,6 +6,7 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/state"
 	"github.com/prysmaticlabs/prysm/consensus-types/interfaces"
 	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/time/slots"
 	"github.com/sirupsen/logrus"
 )
 
@@ -51,6 +52,11 @@ func (s *Service) processSyncAggregate(state state.BeaconState, blk interfaces.B
 				return
 			}
 
+			summary := s.epochSummary(validatorIdx, slots.ToEpoch(blk.Slot()))
+			summary.SyncContributions += uint64(contrib)
+			summary.ExpectedSyncContributions += uint64(len(committeeIndices))
+			summary.EndBalance = balance
+
 			latestPerf := s.latestPerformance[validatorIdx]
 			balanceChg := int64(balance - latestPerf.balance)
 			latestPerf.balanceChange = balanceChg
@@ -63,6 +63,14 @@ type ValidatorMonitorConfig struct {
 	AttestationNotifier operation.Notifier
 	HeadFetcher         blockchain.HeadFetcher
 	StateGen            stategen.StateManager
+	HistoryEpochs       types.Epoch
+}
+
+// ValidatorTracker changes the set of validators tracked by the monitor at runtime.
+type ValidatorTracker interface {
+	TrackValidators(indices []types.ValidatorIndex)
+	UntrackValidators(indices []types.ValidatorIndex)
+	TrackedIndices() []types.ValidatorIndex
 }
 
 // Service is the main structure that tracks validators and reports logs and
@@ -74,13 +82,14 @@ type Service struct {
 	isLogging bool
 
 	// Locks access to TrackedValidators, latestPerformance, aggregatedPerformance,
-	// trackedSyncedCommitteeIndices and lastSyncedEpoch
+	// trackedSyncedCommitteeIndices, summaries and lastSyncedEpoch
 	sync.RWMutex
 
 	TrackedValidators           map[types.ValidatorIndex]bool
 	latestPerformance           map[types.ValidatorIndex]ValidatorLatestPerformance
 	aggregatedPerformance       map[types.ValidatorIndex]ValidatorAggregatedPerformance
 	trackedSyncCommitteeIndices map[types.ValidatorIndex][]types.CommitteeIndex
+	summaries                   map[types.ValidatorIndex][]*EpochSummary
 	lastSyncedEpoch             types.Epoch
 }
 
@@ -95,6 +104,7 @@ func NewService(ctx context.Context, config *ValidatorMonitorConfig, tracked []t
 		latestPerformance:           make(map[types.ValidatorIndex]ValidatorLatestPerformance),
 		aggregatedPerformance:       make(map[types.ValidatorIndex]ValidatorAggregatedPerformance),
 		trackedSyncCommitteeIndices: make(map[types.ValidatorIndex][]types.CommitteeIndex),
+		summaries:                   make(map[types.ValidatorIndex][]*EpochSummary),
 		isLogging:                   false,
 	}
 	for _, idx := range tracked {
@@ -105,17 +115,8 @@ func NewService(ctx context.Context, config *ValidatorMonitorConfig, tracked []t
 
 // Start sets up the TrackedValidators map and then calls to wait until the beacon is synced.
 func (s *Service) Start() {
-	s.Lock()
-	defer s.Unlock()
-
-	tracked := make([]types.ValidatorIndex, 0, len(s.TrackedValidators))
-	for idx := range s.TrackedValidators {
-		tracked = append(tracked, idx)
-	}
-	sort.Slice(tracked, func(i, j int) bool { return tracked[i] < tracked[j] })
-
 	log.WithFields(logrus.Fields{
-		"ValidatorIndices": tracked,
+		"ValidatorIndices": s.TrackedIndices(),
 	}).Info("Starting service")
 
 	stateChannel := make(chan *feed.Event, 1)
@@ -165,20 +166,93 @@ func (s *Service) run(stateChannel chan *feed.Event, stateSub event.Subscription
 // and validatorAggregatedPerformance for each tracked validator.
 func (s *Service) initializePerformanceStructures(state state.BeaconState, epoch types.Epoch) {
 	for idx := range s.TrackedValidators {
-		balance, err := state.BalanceAtIndex(idx)
-		if err != nil {
-			log.WithError(err).WithField("ValidatorIndex", idx).Error(
-				"Could not fetch starting balance, skipping aggregated logs.")
-			balance = 0
-		}
-		s.aggregatedPerformance[idx] = ValidatorAggregatedPerformance{
-			startEpoch:   epoch,
-			startBalance: balance,
-		}
-		s.latestPerformance[idx] = ValidatorLatestPerformance{
-			balance: balance,
+		s.initializePerformance(state, epoch, idx)
+	}
+}
+
+// initializePerformance initializes the validatorLatestPerformance and
+// validatorAggregatedPerformance of a single tracked validator.
+func (s *Service) initializePerformance(state state.BeaconState, epoch types.Epoch, idx types.ValidatorIndex) {
+	balance, err := state.BalanceAtIndex(idx)
+	if err != nil {
+		log.WithError(err).WithField("ValidatorIndex", idx).Error(
+			"Could not fetch starting balance, skipping aggregated logs.")
+		balance = 0
+	}
+	s.aggregatedPerformance[idx] = ValidatorAggregatedPerformance{
+		startEpoch:   epoch,
+		startBalance: balance,
+	}
+	s.latestPerformance[idx] = ValidatorLatestPerformance{
+		balance: balance,
+	}
+}
+
+// TrackValidators adds validators to the tracked set. When the service is already
+// monitoring, the performance of the new validators is reported starting from the
+// head state.
+func (s *Service) TrackValidators(indices []types.ValidatorIndex) {
+	s.Lock()
+	added := make([]types.ValidatorIndex, 0, len(indices))
+	for _, idx := range indices {
+		if !s.trackedIndex(idx) {
+			s.TrackedValidators[idx] = true
+			added = append(added, idx)
 		}
 	}
+	isLogging := s.isLogging
+	s.Unlock()
+	if len(added) == 0 {
+		return
+	}
+	log.WithField("ValidatorIndices", added).Info("Tracking new validators")
+	if !isLogging {
+		// Performance structures are initialized once the node is synced.
+		return
+	}
+
+	st, err := s.config.HeadFetcher.HeadState(s.ctx)
+	if err != nil {
+		log.WithError(err).Error("Could not get head state")
+		return
+	}
+	if st == nil || st.IsNil() {
+		log.Error("Head state is nil")
+		return
+	}
+	s.Lock()
+	for _, idx := range added {
+		s.initializePerformance(st, slots.ToEpoch(st.Slot()), idx)
+	}
+	s.Unlock()
+	s.updateSyncCommitteeTrackedVals(st)
+}
+
+// UntrackValidators removes validators from the tracked set along with their
+// collected performance.
+func (s *Service) UntrackValidators(indices []types.ValidatorIndex) {
+	s.Lock()
+	defer s.Unlock()
+	for _, idx := range indices {
+		delete(s.TrackedValidators, idx)
+		delete(s.latestPerformance, idx)
+		delete(s.aggregatedPerformance, idx)
+		delete(s.trackedSyncCommitteeIndices, idx)
+		delete(s.summaries, idx)
+	}
+	log.WithField("ValidatorIndices", indices).Info("Stopped tracking validators")
+}
+
+// TrackedIndices returns the tracked validator indices in ascending order.
+func (s *Service) TrackedIndices() []types.ValidatorIndex {
+	s.RLock()
+	defer s.RUnlock()
+	tracked := make([]types.ValidatorIndex, 0, len(s.TrackedValidators))
+	for idx := range s.TrackedValidators {
+		tracked = append(tracked, idx)
+	}
+	sort.Slice(tracked, func(i, j int) bool { return tracked[i] < tracked[j] })
+	return tracked
 }
 
 // Status retrieves the status of the service.
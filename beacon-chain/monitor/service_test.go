@@ -310,3 +310,31 @@ func TestRun(t *testing.T) {
 	time.Sleep(1000 * time.Millisecond)
 	require.LogsContain(t, hook, "Synced to head epoch, starting reporting performance")
 }
+
+func TestTrackValidators(t *testing.T) {
+	hook := logTest.NewGlobal()
+	s := setupService(t)
+	s.isLogging = true
+
+	s.TrackValidators([]types.ValidatorIndex{2, 20, 3})
+	require.LogsContain(t, hook, "\"Tracking new validators\" ValidatorIndices=\"[20 3]\"")
+	require.DeepEqual(t, []types.ValidatorIndex{1, 2, 3, 12, 15, 20}, s.TrackedIndices())
+	require.Equal(t, uint64(32000000000), s.latestPerformance[20].balance)
+	require.Equal(t, uint64(32000000000), s.aggregatedPerformance[3].startBalance)
+
+	s.UntrackValidators([]types.ValidatorIndex{1, 20})
+	require.DeepEqual(t, []types.ValidatorIndex{2, 3, 12, 15}, s.TrackedIndices())
+	_, ok := s.latestPerformance[20]
+	require.Equal(t, false, ok)
+	_, ok = s.trackedSyncCommitteeIndices[1]
+	require.Equal(t, false, ok)
+}
+
+func TestTrackValidators_NotLogging(t *testing.T) {
+	s := setupService(t)
+
+	s.TrackValidators([]types.ValidatorIndex{20})
+	require.Equal(t, true, s.trackedIndex(20))
+	_, ok := s.latestPerformance[20]
+	require.Equal(t, false, ok)
+}
@@ -0,0 +1,83 @@
+package monitor
+
+import (
+	"sort"
+
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+)
+
+// DefaultHistoryEpochs is the number of epochs of summaries kept per tracked validator
+// when the monitor configuration does not specify a history window.
+const DefaultHistoryEpochs = types.Epoch(64)
+
+// EpochSummary is the performance of a tracked validator during a single epoch.
+// Attestation fields refer to the attestation targeting the epoch, while proposals,
+// sync committee contributions and balances refer to blocks of the epoch.
+type EpochSummary struct {
+	Epoch                     types.Epoch
+	AttestationIncluded       bool
+	InclusionDelay            types.Slot
+	CorrectSource             bool
+	CorrectTarget             bool
+	CorrectHead               bool
+	ProposedBlocks            uint64
+	SyncContributions         uint64
+	ExpectedSyncContributions uint64
+	StartBalance              uint64
+	EndBalance                uint64
+}
+
+// BalanceDelta is the change of the validator's balance observed during the epoch.
+func (e *EpochSummary) BalanceDelta() int64 {
+	return int64(e.EndBalance) - int64(e.StartBalance)
+}
+
+// Summaries returns the epoch summaries of a tracked validator in ascending epoch order.
+// It returns false if the validator is not tracked.
+func (s *Service) Summaries(idx types.ValidatorIndex) ([]EpochSummary, bool) {
+	s.RLock()
+	defer s.RUnlock()
+	if !s.trackedIndex(idx) {
+		return nil, false
+	}
+	summaries := make([]EpochSummary, len(s.summaries[idx]))
+	for i, summary := range s.summaries[idx] {
+		summaries[i] = *summary
+	}
+	return summaries, true
+}
+
+// epochSummary returns the summary of a tracked validator for the given epoch, creating it
+// if needed. New summaries start at the latest known balance of the validator, and summaries
+// falling out of the history window are pruned.
+// It assumes the caller holds the service Lock.
+func (s *Service) epochSummary(idx types.ValidatorIndex, epoch types.Epoch) *EpochSummary {
+	if s.summaries == nil {
+		s.summaries = make(map[types.ValidatorIndex][]*EpochSummary)
+	}
+	summaries := s.summaries[idx]
+	i := sort.Search(len(summaries), func(i int) bool {
+		return summaries[i].Epoch >= epoch
+	})
+	if i < len(summaries) && summaries[i].Epoch == epoch {
+		return summaries[i]
+	}
+
+	balance := s.latestPerformance[idx].balance
+	summary := &EpochSummary{Epoch: epoch, StartBalance: balance, EndBalance: balance}
+	summaries = append(summaries, nil)
+	copy(summaries[i+1:], summaries[i:])
+	summaries[i] = summary
+
+	history := DefaultHistoryEpochs
+	if s.config != nil && s.config.HistoryEpochs != 0 {
+		history = s.config.HistoryEpochs
+	}
+	newest := summaries[len(summaries)-1].Epoch
+	pruned := 0
+	for pruned < len(summaries) && summaries[pruned].Epoch+history <= newest {
+		pruned++
+	}
+	s.summaries[idx] = summaries[pruned:]
+	return summary
+}
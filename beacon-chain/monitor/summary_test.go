@@ -0,0 +1,70 @@
+package monitor
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/config/params"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/consensus-types/wrapper"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	"github.com/prysmaticlabs/prysm/testing/util"
+)
+
+func TestEpochSummary_HistoryWindow(t *testing.T) {
+	s := setupService(t)
+	s.config.HistoryEpochs = 3
+
+	s.Lock()
+	s.epochSummary(12, 5).ProposedBlocks++
+	s.epochSummary(12, 3).CorrectHead = true
+	s.epochSummary(12, 5).ProposedBlocks++
+	s.Unlock()
+
+	summaries, ok := s.Summaries(12)
+	require.Equal(t, true, ok)
+	require.Equal(t, 2, len(summaries))
+	require.Equal(t, types.Epoch(3), summaries[0].Epoch)
+	require.Equal(t, true, summaries[0].CorrectHead)
+	require.Equal(t, uint64(31900000000), summaries[0].StartBalance)
+	require.Equal(t, types.Epoch(5), summaries[1].Epoch)
+	require.Equal(t, uint64(2), summaries[1].ProposedBlocks)
+
+	s.Lock()
+	s.epochSummary(12, 6)
+	s.Unlock()
+	summaries, ok = s.Summaries(12)
+	require.Equal(t, true, ok)
+	require.Equal(t, 2, len(summaries))
+	require.Equal(t, types.Epoch(5), summaries[0].Epoch)
+	require.Equal(t, types.Epoch(6), summaries[1].Epoch)
+
+	_, ok = s.Summaries(13)
+	require.Equal(t, false, ok)
+}
+
+func TestEpochSummary_BalanceDelta(t *testing.T) {
+	summary := &EpochSummary{StartBalance: 32000000000, EndBalance: 31999000000}
+	require.Equal(t, int64(-1000000), summary.BalanceDelta())
+}
+
+func TestProcessProposedBlock_Summary(t *testing.T) {
+	s := setupService(t)
+	beaconState, _ := util.DeterministicGenesisState(t, 256)
+	wb, err := wrapper.WrappedBeaconBlock(&ethpb.BeaconBlock{
+		Slot:          params.BeaconConfig().SlotsPerEpoch + 1,
+		ProposerIndex: 12,
+		ParentRoot:    bytesutil.PadTo([]byte("hello-world"), 32),
+		StateRoot:     bytesutil.PadTo([]byte("state-world"), 32),
+	})
+	require.NoError(t, err)
+	s.processProposedBlock(beaconState, [32]byte{}, wb)
+
+	summaries, ok := s.Summaries(12)
+	require.Equal(t, true, ok)
+	require.Equal(t, 1, len(summaries))
+	require.Equal(t, types.Epoch(1), summaries[0].Epoch)
+	require.Equal(t, uint64(1), summaries[0].ProposedBlocks)
+	require.Equal(t, int64(100000000), summaries[0].BalanceDelta())
+}
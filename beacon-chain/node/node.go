@@ -16,6 +16,7 @@ import (
 	"syscall"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/gorilla/mux"
 	"github.com/pkg/errors"
 	apigateway "github.com/prysmaticlabs/prysm/api/gateway"
 	"github.com/prysmaticlabs/prysm/async/event"
@@ -23,6 +24,8 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/beacon-chain/cache/depositcache"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/backend"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/export"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db/kv"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db/slasherkv"
 	interopcoldstart "github.com/prysmaticlabs/prysm/beacon-chain/deterministic-genesis"
@@ -33,6 +36,7 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/monitor"
 	"github.com/prysmaticlabs/prysm/beacon-chain/node/registration"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/attestations"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/persistence"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/slashings"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/synccommittee"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/voluntaryexits"
@@ -40,6 +44,9 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/powchain"
 	"github.com/prysmaticlabs/prysm/beacon-chain/rpc"
 	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/apimiddleware"
+	rpcbeacon "github.com/prysmaticlabs/prysm/beacon-chain/rpc/prysm/beacon"
+	rpcdebug "github.com/prysmaticlabs/prysm/beacon-chain/rpc/prysm/debug"
+	rpcvalidator "github.com/prysmaticlabs/prysm/beacon-chain/rpc/prysm/validator"
 	"github.com/prysmaticlabs/prysm/beacon-chain/slasher"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
@@ -56,6 +63,7 @@ import (
 	"github.com/prysmaticlabs/prysm/container/slice"
 	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
 	"github.com/prysmaticlabs/prysm/monitoring/backup"
+	"github.com/prysmaticlabs/prysm/monitoring/clientstats"
 	"github.com/prysmaticlabs/prysm/monitoring/prometheus"
 	"github.com/prysmaticlabs/prysm/runtime"
 	"github.com/prysmaticlabs/prysm/runtime/debug"
@@ -107,6 +115,7 @@ type BeaconNode struct {
 	finalizedStateAtStartUp state.BeaconState
 	serviceFlagOpts         *serviceFlagOpts
 	blockchainFlagOpts      []blockchain.Option
+	router                  *mux.Router
 	GenesisInitializer      genesis.Initializer
 	CheckpointInitializer   checkpoint.Initializer
 }
@@ -171,6 +180,7 @@ func New(cliCtx *cli.Context, opts ...Option) (*BeaconNode, error) {
 		slasherAttestationsFeed: new(event.Feed),
 		serviceFlagOpts:         &serviceFlagOpts{},
 		proposerIdsCache:        cache.NewProposerPayloadIDsCache(),
+		router:                  mux.NewRouter(),
 	}
 
 	for _, opt := range opts {
@@ -231,6 +241,11 @@ func New(cliCtx *cli.Context, opts ...Option) (*BeaconNode, error) {
 		return nil, err
 	}
 
+	log.Debugln("Registering Operation Pool Persistence Service")
+	if err := beacon.registerPoolPersistenceService(); err != nil {
+		return nil, err
+	}
+
 	log.Debugln("Registering Intial Sync Service")
 	if err := beacon.registerInitialSyncService(); err != nil {
 		return nil, err
@@ -246,6 +261,11 @@ func New(cliCtx *cli.Context, opts ...Option) (*BeaconNode, error) {
 		return nil, err
 	}
 
+	log.Debugln("Registering Validator Monitoring Service")
+	if err := beacon.registerValidatorMonitorService(); err != nil {
+		return nil, err
+	}
+
 	log.Debugln("Registering RPC Service")
 	if err := beacon.registerRPCService(); err != nil {
 		return nil, err
@@ -256,9 +276,11 @@ func New(cliCtx *cli.Context, opts ...Option) (*BeaconNode, error) {
 		return nil, err
 	}
 
-	log.Debugln("Registering Validator Monitoring Service")
-	if err := beacon.registerValidatorMonitorService(); err != nil {
-		return nil, err
+	if cliCtx.Duration(cmd.DBBackupIntervalFlag.Name) > 0 {
+		log.Debugln("Registering Database Snapshot Service")
+		if err := beacon.registerSnapshotScheduler(); err != nil {
+			return nil, err
+		}
 	}
 
 	if !cliCtx.Bool(cmd.DisableMonitoringFlag.Name) {
@@ -277,6 +299,13 @@ func New(cliCtx *cli.Context, opts ...Option) (*BeaconNode, error) {
 	}
 	beacon.collector = c
 
+	if cliCtx.String(cmd.ClientStatsAPIURLFlag.Name) != "" {
+		log.Debugln("Registering Client Stats Service")
+		if err := beacon.registerClientStatsService(); err != nil {
+			return nil, err
+		}
+	}
+
 	return beacon, nil
 }
 
@@ -358,10 +387,21 @@ func (b *BeaconNode) startDB(cliCtx *cli.Context, depositAddress string) error {
 	clearDB := cliCtx.Bool(cmd.ClearDB.Name)
 	forceClearDB := cliCtx.Bool(cmd.ForceClearDB.Name)
 
+	// Only an explicitly set backend is compared against the backend of an existing database.
+	var dbBackend backend.Kind
+	if cliCtx.IsSet(flags.DBBackend.Name) {
+		kind, err := backend.ParseKind(cliCtx.String(flags.DBBackend.Name))
+		if err != nil {
+			return err
+		}
+		dbBackend = kind
+	}
+
 	log.WithField("database-path", dbPath).Info("Checking DB")
 
 	d, err := db.NewDB(b.ctx, dbPath, &kv.Config{
 		InitialMMapSize: cliCtx.Int(cmd.BoltMMapInitialSizeFlag.Name),
+		Backend:         dbBackend,
 	})
 	if err != nil {
 		return err
@@ -386,6 +426,7 @@ func (b *BeaconNode) startDB(cliCtx *cli.Context, depositAddress string) error {
 		}
 		d, err = db.NewDB(b.ctx, dbPath, &kv.Config{
 			InitialMMapSize: cliCtx.Int(cmd.BoltMMapInitialSizeFlag.Name),
+			Backend:         dbBackend,
 		})
 		if err != nil {
 			return errors.Wrap(err, "could not create new database")
@@ -613,6 +654,22 @@ func (b *BeaconNode) registerBlockchainService() error {
 	return b.services.RegisterService(blockchainService)
 }
 
+func (b *BeaconNode) registerPoolPersistenceService() error {
+	var chainService *blockchain.Service
+	if err := b.services.FetchService(&chainService); err != nil {
+		return err
+	}
+
+	s := persistence.NewService(b.ctx, &persistence.Config{
+		BeaconDB:        b.db,
+		HeadFetcher:     chainService,
+		AttestationPool: b.attestationPool,
+		ExitPool:        b.exitPool,
+		SlashingPool:    b.slashingsPool,
+	})
+	return b.services.RegisterService(s)
+}
+
 func (b *BeaconNode) registerPOWChainService() error {
 	if b.cliCtx.Bool(testSkipPowFlag) {
 		return b.services.RegisterService(&powchain.Service{})
@@ -678,6 +735,7 @@ func (b *BeaconNode) registerSyncService() error {
 		regularsync.WithStateGen(b.stateGen),
 		regularsync.WithSlasherAttestationsFeed(b.slasherAttestationsFeed),
 		regularsync.WithSlasherBlockHeadersFeed(b.slasherBlockHeadersFeed),
+		regularsync.WithExecutionPayloadReconstructor(web3Service),
 	)
 	return b.services.RegisterService(rs)
 }
@@ -744,6 +802,13 @@ func (b *BeaconNode) registerRPCService() error {
 		return err
 	}
 
+	// Validators registering through PrepareBeaconProposer are tracked when the monitor is enabled.
+	var validatorMonitor monitor.ValidatorTracker
+	var monitorService *monitor.Service
+	if err := b.services.FetchService(&monitorService); err == nil {
+		validatorMonitor = monitorService
+	}
+
 	var slasherService *slasher.Service
 	if features.Get().EnableSlasher {
 		if err := b.services.FetchService(&slasherService); err != nil {
@@ -781,50 +846,62 @@ func (b *BeaconNode) registerRPCService() error {
 		maxMsgSize = int(math.Max(float64(maxMsgSize), debugGrpcMaxMsgSize))
 	}
 
+	// The Ethereum API is served natively on the gateway's router when enabled, so it is only needed when
+	// the gateway runs.
+	var router *mux.Router
+	if b.cliCtx.Bool(flags.EnableNativeHTTPAPI.Name) && !b.cliCtx.Bool(flags.DisableGRPCGateway.Name) &&
+		flags.EnableHTTPEthAPI(b.cliCtx.String(flags.HTTPModules.Name)) {
+		router = b.router
+	}
+
 	p2pService := b.fetchP2P()
 	rpcService := rpc.NewService(b.ctx, &rpc.Config{
-		Host:                    host,
-		Port:                    port,
-		BeaconMonitoringHost:    beaconMonitoringHost,
-		BeaconMonitoringPort:    beaconMonitoringPort,
-		CertFlag:                cert,
-		KeyFlag:                 key,
-		BeaconDB:                b.db,
-		Broadcaster:             p2pService,
-		PeersFetcher:            p2pService,
-		PeerManager:             p2pService,
-		MetadataProvider:        p2pService,
-		ChainInfoFetcher:        chainService,
-		HeadUpdater:             chainService,
-		HeadFetcher:             chainService,
-		CanonicalFetcher:        chainService,
-		ForkFetcher:             chainService,
-		FinalizationFetcher:     chainService,
-		BlockReceiver:           chainService,
-		AttestationReceiver:     chainService,
-		GenesisTimeFetcher:      chainService,
-		GenesisFetcher:          chainService,
-		OptimisticModeFetcher:   chainService,
-		AttestationsPool:        b.attestationPool,
-		ExitPool:                b.exitPool,
-		SlashingsPool:           b.slashingsPool,
-		SlashingChecker:         slasherService,
-		SyncCommitteeObjectPool: b.syncCommitteePool,
-		POWChainService:         web3Service,
-		POWChainInfoFetcher:     web3Service,
-		ChainStartFetcher:       chainStartFetcher,
-		MockEth1Votes:           mockEth1DataVotes,
-		SyncService:             syncService,
-		DepositFetcher:          depositFetcher,
-		PendingDepositFetcher:   b.depositCache,
-		BlockNotifier:           b,
-		StateNotifier:           b,
-		OperationNotifier:       b,
-		StateGen:                b.stateGen,
-		EnableDebugRPCEndpoints: enableDebugRPCEndpoints,
-		MaxMsgSize:              maxMsgSize,
-		ProposerIdsCache:        b.proposerIdsCache,
-		ExecutionEngineCaller:   web3Service,
+		Host:                          host,
+		Port:                          port,
+		BeaconMonitoringHost:          beaconMonitoringHost,
+		BeaconMonitoringPort:          beaconMonitoringPort,
+		CertFlag:                      cert,
+		KeyFlag:                       key,
+		BeaconDB:                      b.db,
+		Broadcaster:                   p2pService,
+		PeersFetcher:                  p2pService,
+		PeerManager:                   p2pService,
+		MetadataProvider:              p2pService,
+		ChainInfoFetcher:              chainService,
+		HeadUpdater:                   chainService,
+		HeadFetcher:                   chainService,
+		CanonicalFetcher:              chainService,
+		ForkFetcher:                   chainService,
+		FinalizationFetcher:           chainService,
+		BlockReceiver:                 chainService,
+		AttestationReceiver:           chainService,
+		GenesisTimeFetcher:            chainService,
+		GenesisFetcher:                chainService,
+		OptimisticModeFetcher:         chainService,
+		AttestationsPool:              b.attestationPool,
+		ExitPool:                      b.exitPool,
+		SlashingsPool:                 b.slashingsPool,
+		SlashingChecker:               slasherService,
+		SyncCommitteeObjectPool:       b.syncCommitteePool,
+		POWChainService:               web3Service,
+		POWChainInfoFetcher:           web3Service,
+		ChainStartFetcher:             chainStartFetcher,
+		MockEth1Votes:                 mockEth1DataVotes,
+		SyncService:                   syncService,
+		DepositFetcher:                depositFetcher,
+		PendingDepositFetcher:         b.depositCache,
+		BlockNotifier:                 b,
+		StateNotifier:                 b,
+		OperationNotifier:             b,
+		StateGen:                      b.stateGen,
+		HistoricalStateCacheSize:      b.cliCtx.Int(flags.HistoricalStateCacheSize.Name),
+		EnableDebugRPCEndpoints:       enableDebugRPCEndpoints,
+		MaxMsgSize:                    maxMsgSize,
+		ProposerIdsCache:              b.proposerIdsCache,
+		ExecutionEngineCaller:         web3Service,
+		ExecutionPayloadReconstructor: web3Service,
+		Router:                        router,
+		ValidatorMonitor:              validatorMonitor,
 	})
 
 	return b.services.RegisterService(rpcService)
@@ -892,6 +969,7 @@ func (b *BeaconNode) registerGRPCGateway() error {
 	}
 
 	opts := []apigateway.Option{
+		apigateway.WithRouter(b.router),
 		apigateway.WithGatewayAddr(gatewayAddress),
 		apigateway.WithRemoteAddr(selfAddress),
 		apigateway.WithPbHandlers(muxs),
@@ -903,6 +981,48 @@ func (b *BeaconNode) registerGRPCGateway() error {
 	}
 	if flags.EnableHTTPEthAPI(httpModules) {
 		opts = append(opts, apigateway.WithApiMiddleware(&apimiddleware.BeaconEndpointFactory{}))
+		depositServer := &rpcbeacon.Server{DepositSnapshotFetcher: b.db}
+		depositServer.RegisterEthRoutes(b.router)
+	}
+	if flags.EnableHTTPPrysmAPI(httpModules) {
+		var chainService *blockchain.Service
+		if err := b.services.FetchService(&chainService); err != nil {
+			return err
+		}
+
+		// The validator monitor endpoints are only served when the monitor is enabled.
+		var monitorService *monitor.Service
+		if err := b.services.FetchService(&monitorService); err == nil {
+			validatorServer := &rpcvalidator.Server{ValidatorMonitor: monitorService, HeadFetcher: chainService}
+			validatorServer.RegisterRoutes(b.router)
+			// Like the debug endpoints, changing the tracked validators is only allowed when
+			// debug RPC endpoints are enabled.
+			if enableDebugRPCEndpoints {
+				validatorServer.RegisterTrackingRoutes(b.router)
+			}
+		}
+		var web3Service *powchain.Service
+		if err := b.services.FetchService(&web3Service); err != nil {
+			return err
+		}
+		history := stategen.NewCanonicalHistory(b.db, chainService, chainService)
+		beaconServer := &rpcbeacon.Server{
+			Exporter:                      export.New(b.db, chainService, history),
+			GenesisFetcher:                chainService,
+			ExecutionPayloadReconstructor: web3Service,
+		}
+		beaconServer.RegisterRoutes(b.router)
+
+		// The debug endpoints replay states and expose pool contents, so like the debug gRPC
+		// service they are only served when explicitly enabled.
+		if enableDebugRPCEndpoints {
+			debugServer := &rpcdebug.Server{
+				BeaconDB:          b.db,
+				StateGen:          b.stateGen,
+				SyncCommitteePool: b.syncCommitteePool,
+			}
+			debugServer.RegisterRoutes(b.router)
+		}
 	}
 	g, err := apigateway.New(b.ctx, opts...)
 	if err != nil {
@@ -939,12 +1059,81 @@ func (b *BeaconNode) registerDeterminsticGenesisService() error {
 	return nil
 }
 
+func (b *BeaconNode) registerSnapshotScheduler() error {
+	dir, err := backup.SnapshotDir(b.cliCtx.String(cmd.BackupWebhookOutputDir.Name), b.db.DatabasePath())
+	if err != nil {
+		return err
+	}
+	svc, err := backup.NewScheduler(b.ctx, &backup.SchedulerConfig{
+		Snapshotter:       b.db,
+		Dir:               dir,
+		Prefix:            "prysm_beacondb",
+		Interval:          b.cliCtx.Duration(cmd.DBBackupIntervalFlag.Name),
+		Retention:         b.cliCtx.Int(cmd.DBBackupRetentionFlag.Name),
+		Compress:          b.cliCtx.Bool(cmd.DBBackupCompressFlag.Name),
+		NewCheckpointOnly: true,
+	})
+	if err != nil {
+		return err
+	}
+	return b.services.RegisterService(svc)
+}
+
+func (b *BeaconNode) registerClientStatsService() error {
+	var chainService *blockchain.Service
+	if err := b.services.FetchService(&chainService); err != nil {
+		return err
+	}
+	var p2pService *p2p.Service
+	if err := b.services.FetchService(&p2pService); err != nil {
+		return err
+	}
+	var initSyncService *initialsync.Service
+	if err := b.services.FetchService(&initSyncService); err != nil {
+		return err
+	}
+	var web3Service *powchain.Service
+	if err := b.services.FetchService(&web3Service); err != nil {
+		return err
+	}
+
+	stats := func() clientstats.BeaconNodeStats {
+		bs := web3Service.BeaconNodeStats()
+		bs.SyncBeaconHeadSlot = int64(chainService.HeadSlot())
+		bs.SyncEth2Synced = !initSyncService.Syncing()
+		bs.NetworkPeersConnected = int64(len(p2pService.Peers().Connected()))
+		bs.SlasherActive = features.Get().EnableSlasher
+		dbBytes, err := b.collector.getCurrentDbBytes()
+		if err != nil {
+			log.WithError(err).Debug("Could not read database size for client-stats")
+		}
+		// float64->int64: the database size is a whole number of bytes
+		bs.DiskBeaconchainBytesTotal = int64(dbBytes)
+		return bs
+	}
+	spool := b.cliCtx.String(cmd.ClientStatsSpoolFileFlag.Name)
+	if spool == "" {
+		spool = filepath.Join(b.cliCtx.String(cmd.DataDirFlag.Name), "clientstats-spool.jsonl")
+	}
+	svc := clientstats.NewPusher(b.ctx, &clientstats.PusherConfig{
+		Scrapers:   []clientstats.Scraper{clientstats.NewBeaconNodeStatsScraper(stats)},
+		Updater:    clientstats.NewClientStatsHTTPPostUpdater(b.cliCtx.String(cmd.ClientStatsAPIURLFlag.Name)),
+		Interval:   b.cliCtx.Duration(cmd.ClientStatsPushIntervalFlag.Name),
+		Retries:    clientstats.DefaultPushRetries,
+		RetryDelay: clientstats.DefaultPushRetryDelay,
+		SpoolPath:  spool,
+	})
+	return b.services.RegisterService(svc)
+}
+
 func (b *BeaconNode) registerValidatorMonitorService() error {
-	if cmd.ValidatorMonitorIndicesFlag.Value == nil {
-		return nil
+	var cliSlice []int
+	if cmd.ValidatorMonitorIndicesFlag.Value != nil {
+		cliSlice = cmd.ValidatorMonitorIndicesFlag.Value.Value()
 	}
-	cliSlice := cmd.ValidatorMonitorIndicesFlag.Value.Value()
-	if cliSlice == nil {
+	// Without indices to track, the monitor is only registered when validators are to be
+	// tracked at runtime through the API.
+	if len(cliSlice) == 0 && !b.cliCtx.Bool(cmd.EnableValidatorMonitorFlag.Name) {
 		return nil
 	}
 	tracked := make([]types.ValidatorIndex, len(cliSlice))
@@ -961,6 +1150,7 @@ func (b *BeaconNode) registerValidatorMonitorService() error {
 		AttestationNotifier: b,
 		StateGen:            b.stateGen,
 		HeadFetcher:         chainService,
+		HistoryEpochs:       types.Epoch(b.cliCtx.Uint64(cmd.ValidatorMonitorHistoryEpochsFlag.Name)),
 	}
 	svc, err := monitor.NewService(b.ctx, monitorConfig, tracked)
 	if err != nil {
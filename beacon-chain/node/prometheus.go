@@ -3,6 +3,7 @@ package node
 import (
 	"fmt"
 	"os"
+	"path/filepath"
 
 	"github.com/prometheus/client_golang/prometheus"
 )
@@ -53,7 +54,24 @@ func (bc *bcnodeCollector) getCurrentDbBytes() (float64, error) {
 	if err != nil {
 		return 0, fmt.Errorf("could not collect database file size for prometheus, path=%s, err=%s", bc.dbPath, err)
 	}
-	return float64(fs.Size()), nil
+	if !fs.IsDir() {
+		return float64(fs.Size()), nil
+	}
+	// Databases using the leveldb or pebble backend are stored in a directory of files.
+	var size int64
+	err = filepath.Walk(bc.dbPath, func(_ string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.IsDir() {
+			size += info.Size()
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, fmt.Errorf("could not collect database directory size for prometheus, path=%s, err=%s", bc.dbPath, err)
+	}
+	return float64(size), nil
 }
 
 func (bc *bcnodeCollector) unregister() {
@@ -0,0 +1,166 @@
+package persistence
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/config/params"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/time/slots"
+	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
+)
+
+// savePools saves the pending operations of the pools to the DB, replacing the previously saved ones.
+func (s *Service) savePools(ctx context.Context) error {
+	ctx, span := trace.StartSpan(ctx, "persistence.savePools")
+	defer span.End()
+
+	headState, err := s.headState(ctx)
+	if err != nil || headState == nil {
+		return err
+	}
+	exits := s.cfg.ExitPool.PendingExits(headState, params.BeaconConfig().FarFutureSlot, true /* no limit */)
+	if err := s.cfg.BeaconDB.SaveVoluntaryExitPool(ctx, exits); err != nil {
+		return errors.Wrap(err, "could not save voluntary exits")
+	}
+	proposerSlashings := s.cfg.SlashingPool.PendingProposerSlashings(ctx, headState, true /* no limit */)
+	if err := s.cfg.BeaconDB.SaveProposerSlashingPool(ctx, proposerSlashings); err != nil {
+		return errors.Wrap(err, "could not save proposer slashings")
+	}
+	attesterSlashings := s.cfg.SlashingPool.PendingAttesterSlashings(ctx, headState, true /* no limit */)
+	if err := s.cfg.BeaconDB.SaveAttesterSlashingPool(ctx, attesterSlashings); err != nil {
+		return errors.Wrap(err, "could not save attester slashings")
+	}
+	currentSlot := slots.CurrentSlot(headState.GenesisTime())
+	aggregatedAtts := s.cfg.AttestationPool.AggregatedAttestations()
+	atts := make([]*ethpb.Attestation, 0, len(aggregatedAtts))
+	for _, att := range aggregatedAtts {
+		if isRecent(att.Data.Slot, currentSlot) {
+			atts = append(atts, att)
+		}
+	}
+	if err := s.cfg.BeaconDB.SaveAttestationPool(ctx, atts); err != nil {
+		return errors.Wrap(err, "could not save aggregated attestations")
+	}
+
+	log.WithFields(logrus.Fields{
+		"voluntaryExits":    len(exits),
+		"proposerSlashings": len(proposerSlashings),
+		"attesterSlashings": len(attesterSlashings),
+		"attestations":      len(atts),
+	}).Debug("Saved operation pools")
+	return nil
+}
+
+// restorePools inserts the operations saved to the DB into the pools. The operations are
+// revalidated against the head state, and the ones which are no longer valid are dropped.
+func (s *Service) restorePools(ctx context.Context) {
+	ctx, span := trace.StartSpan(ctx, "persistence.restorePools")
+	defer span.End()
+
+	headState, err := s.headState(ctx)
+	if err != nil {
+		log.WithError(err).Error("Could not restore operation pools")
+		return
+	}
+	if headState == nil {
+		// The chain has not started, so there is nothing to restore.
+		return
+	}
+
+	exits, err := s.cfg.BeaconDB.VoluntaryExitPool(ctx)
+	if err != nil {
+		log.WithError(err).Error("Could not retrieve saved voluntary exits")
+	}
+	restoredExits := 0
+	for _, exit := range exits {
+		val, err := headState.ValidatorAtIndexReadOnly(exit.Exit.ValidatorIndex)
+		if err != nil {
+			continue
+		}
+		// The exit was included in a block since it was saved.
+		if val.ExitEpoch() != params.BeaconConfig().FarFutureEpoch {
+			continue
+		}
+		if err := blocks.VerifyExitAndSignature(val, headState.Slot(), headState.Fork(), exit, headState.GenesisValidatorsRoot()); err != nil {
+			continue
+		}
+		s.cfg.ExitPool.InsertVoluntaryExit(ctx, headState, exit)
+		restoredExits++
+	}
+
+	proposerSlashings, err := s.cfg.BeaconDB.ProposerSlashingPool(ctx)
+	if err != nil {
+		log.WithError(err).Error("Could not retrieve saved proposer slashings")
+	}
+	restoredProposerSlashings := 0
+	for _, slashing := range proposerSlashings {
+		// The slashing is verified by the pool.
+		if err := s.cfg.SlashingPool.InsertProposerSlashing(ctx, headState, slashing); err != nil {
+			continue
+		}
+		restoredProposerSlashings++
+	}
+
+	attesterSlashings, err := s.cfg.BeaconDB.AttesterSlashingPool(ctx)
+	if err != nil {
+		log.WithError(err).Error("Could not retrieve saved attester slashings")
+	}
+	restoredAttesterSlashings := 0
+	for _, slashing := range attesterSlashings {
+		// The slashing is verified by the pool.
+		if err := s.cfg.SlashingPool.InsertAttesterSlashing(ctx, headState, slashing); err != nil {
+			continue
+		}
+		restoredAttesterSlashings++
+	}
+
+	atts, err := s.cfg.BeaconDB.AttestationPool(ctx)
+	if err != nil {
+		log.WithError(err).Error("Could not retrieve saved aggregated attestations")
+	}
+	currentSlot := slots.CurrentSlot(headState.GenesisTime())
+	verifiedAtts := make([]*ethpb.Attestation, 0, len(atts))
+	for _, att := range atts {
+		if !isRecent(att.Data.Slot, currentSlot) {
+			continue
+		}
+		if err := blocks.VerifyAttestationSignature(ctx, headState, att); err != nil {
+			continue
+		}
+		verifiedAtts = append(verifiedAtts, att)
+	}
+	if err := s.cfg.AttestationPool.SaveAggregatedAttestations(verifiedAtts); err != nil {
+		log.WithError(err).Error("Could not restore aggregated attestations")
+		verifiedAtts = nil
+	}
+
+	log.WithFields(logrus.Fields{
+		"voluntaryExits":    restoredExits,
+		"proposerSlashings": restoredProposerSlashings,
+		"attesterSlashings": restoredAttesterSlashings,
+		"attestations":      len(verifiedAtts),
+	}).Info("Restored operation pools")
+}
+
+// headState returns the head state, or nil if the chain has not started.
+func (s *Service) headState(ctx context.Context) (state.BeaconState, error) {
+	headState, err := s.cfg.HeadFetcher.HeadState(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get head state")
+	}
+	if headState == nil || headState.IsNil() {
+		return nil, nil
+	}
+	return headState, nil
+}
+
+// isRecent returns true if the attestation slot is within the last epoch, in which the attestation
+// can still be included in a block.
+func isRecent(attSlot, currentSlot types.Slot) bool {
+	return attSlot+params.BeaconConfig().SlotsPerEpoch >= currentSlot
+}
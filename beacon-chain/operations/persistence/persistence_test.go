@@ -0,0 +1,157 @@
+package persistence
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/signing"
+	coreTime "github.com/prysmaticlabs/prysm/beacon-chain/core/time"
+	dbtest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/attestations"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/slashings"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/voluntaryexits"
+	"github.com/prysmaticlabs/prysm/config/params"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	"github.com/prysmaticlabs/prysm/testing/util"
+	logTest "github.com/sirupsen/logrus/hooks/test"
+)
+
+func TestService_SaveAndRestorePools(t *testing.T) {
+	ctx := context.Background()
+	st, privs := util.DeterministicGenesisState(t, 64)
+	// Validators may only exit after the shard committee period.
+	slot := params.BeaconConfig().SlotsPerEpoch.Mul(uint64(params.BeaconConfig().ShardCommitteePeriod))
+	require.NoError(t, st.SetSlot(slot))
+	require.NoError(t, st.SetGenesisTime(uint64(time.Now().Unix())-uint64(slot)*params.BeaconConfig().SecondsPerSlot))
+
+	validExit := &ethpb.SignedVoluntaryExit{Exit: &ethpb.VoluntaryExit{ValidatorIndex: 0, Epoch: coreTime.CurrentEpoch(st)}}
+	var err error
+	validExit.Signature, err = signing.ComputeDomainAndSign(st, coreTime.CurrentEpoch(st), validExit.Exit, params.BeaconConfig().DomainVoluntaryExit, privs[0])
+	require.NoError(t, err)
+	// Signed by another validator.
+	invalidExit := &ethpb.SignedVoluntaryExit{Exit: &ethpb.VoluntaryExit{ValidatorIndex: 1, Epoch: coreTime.CurrentEpoch(st)}}
+	invalidExit.Signature, err = signing.ComputeDomainAndSign(st, coreTime.CurrentEpoch(st), invalidExit.Exit, params.BeaconConfig().DomainVoluntaryExit, privs[0])
+	require.NoError(t, err)
+	proposerSlashing, err := util.GenerateProposerSlashingForValidator(st, privs[2], 2)
+	require.NoError(t, err)
+	attesterSlashing, err := util.GenerateAttesterSlashingForValidator(st, privs[3], 3)
+	require.NoError(t, err)
+	atts, err := util.GenerateAttestations(st, privs, 1, st.Slot()-1, false)
+	require.NoError(t, err)
+
+	beaconDB := dbtest.SetupDB(t)
+	chain := &mock.ChainService{State: st}
+	saved := NewService(ctx, &Config{
+		BeaconDB:        beaconDB,
+		HeadFetcher:     chain,
+		AttestationPool: attestations.NewPool(),
+		ExitPool:        voluntaryexits.NewPool(),
+		SlashingPool:    slashings.NewPool(),
+	})
+	saved.cfg.ExitPool.InsertVoluntaryExit(ctx, st, validExit)
+	saved.cfg.ExitPool.InsertVoluntaryExit(ctx, st, invalidExit)
+	require.NoError(t, saved.cfg.SlashingPool.InsertProposerSlashing(ctx, st, proposerSlashing))
+	require.NoError(t, saved.cfg.SlashingPool.InsertAttesterSlashing(ctx, st, attesterSlashing))
+	require.NoError(t, saved.cfg.AttestationPool.SaveAggregatedAttestations(atts))
+	// Expired attestations are not saved.
+	expired := util.HydrateAttestation(&ethpb.Attestation{AggregationBits: []byte{0b1101}, Data: &ethpb.AttestationData{Slot: 1}})
+	require.NoError(t, saved.cfg.AttestationPool.SaveAggregatedAttestation(expired))
+	require.NoError(t, saved.savePools(ctx))
+
+	restored := NewService(ctx, &Config{
+		BeaconDB:        beaconDB,
+		HeadFetcher:     chain,
+		AttestationPool: attestations.NewPool(),
+		ExitPool:        voluntaryexits.NewPool(),
+		SlashingPool:    slashings.NewPool(),
+	})
+	restored.restorePools(ctx)
+	require.DeepSSZEqual(t, []*ethpb.SignedVoluntaryExit{validExit}, restored.cfg.ExitPool.PendingExits(st, st.Slot(), true))
+	require.DeepSSZEqual(t, []*ethpb.ProposerSlashing{proposerSlashing}, restored.cfg.SlashingPool.PendingProposerSlashings(ctx, st, true))
+	require.DeepSSZEqual(t, []*ethpb.AttesterSlashing{attesterSlashing}, restored.cfg.SlashingPool.PendingAttesterSlashings(ctx, st, true))
+	require.Equal(t, 1, len(atts))
+	require.DeepSSZEqual(t, atts, restored.cfg.AttestationPool.AggregatedAttestations())
+}
+
+func TestService_RestorePoolsDropsStaleOperations(t *testing.T) {
+	ctx := context.Background()
+	st, privs := util.DeterministicGenesisState(t, 64)
+	slot := params.BeaconConfig().SlotsPerEpoch.Mul(uint64(params.BeaconConfig().ShardCommitteePeriod))
+	require.NoError(t, st.SetSlot(slot))
+	require.NoError(t, st.SetGenesisTime(uint64(time.Now().Unix())-uint64(slot)*params.BeaconConfig().SecondsPerSlot))
+
+	exit := &ethpb.SignedVoluntaryExit{Exit: &ethpb.VoluntaryExit{ValidatorIndex: 0, Epoch: coreTime.CurrentEpoch(st)}}
+	var err error
+	exit.Signature, err = signing.ComputeDomainAndSign(st, coreTime.CurrentEpoch(st), exit.Exit, params.BeaconConfig().DomainVoluntaryExit, privs[0])
+	require.NoError(t, err)
+	proposerSlashing, err := util.GenerateProposerSlashingForValidator(st, privs[2], 2)
+	require.NoError(t, err)
+	beaconDB := dbtest.SetupDB(t)
+	require.NoError(t, beaconDB.SaveVoluntaryExitPool(ctx, []*ethpb.SignedVoluntaryExit{exit}))
+	require.NoError(t, beaconDB.SaveProposerSlashingPool(ctx, []*ethpb.ProposerSlashing{proposerSlashing}))
+
+	// The exit and the slashing were included in blocks while the node was stopped.
+	exited, err := st.ValidatorAtIndex(0)
+	require.NoError(t, err)
+	exited.ExitEpoch = coreTime.CurrentEpoch(st) + params.BeaconConfig().MaxSeedLookahead + 1
+	require.NoError(t, st.UpdateValidatorAtIndex(0, exited))
+	slashed, err := st.ValidatorAtIndex(2)
+	require.NoError(t, err)
+	slashed.Slashed = true
+	require.NoError(t, st.UpdateValidatorAtIndex(2, slashed))
+
+	s := NewService(ctx, &Config{
+		BeaconDB:        beaconDB,
+		HeadFetcher:     &mock.ChainService{State: st},
+		AttestationPool: attestations.NewPool(),
+		ExitPool:        voluntaryexits.NewPool(),
+		SlashingPool:    slashings.NewPool(),
+	})
+	hook := logTest.NewGlobal()
+	s.restorePools(ctx)
+	require.LogsContain(t, hook, "Restored operation pools")
+	assert.Equal(t, 0, hook.LastEntry().Data["voluntaryExits"])
+	assert.Equal(t, 0, hook.LastEntry().Data["proposerSlashings"])
+	assert.Equal(t, 0, len(s.cfg.ExitPool.PendingExits(st, st.Slot(), true)))
+	assert.Equal(t, 0, len(s.cfg.SlashingPool.PendingProposerSlashings(ctx, st, true)))
+}
+
+func TestService_StopBeforeRestore(t *testing.T) {
+	ctx := context.Background()
+	st, _ := util.DeterministicGenesisState(t, 64)
+	beaconDB := dbtest.SetupDB(t)
+	saved := []*ethpb.SignedVoluntaryExit{{Exit: &ethpb.VoluntaryExit{ValidatorIndex: 1}, Signature: make([]byte, 96)}}
+	require.NoError(t, beaconDB.SaveVoluntaryExitPool(ctx, saved))
+
+	s := NewService(ctx, &Config{
+		BeaconDB:        beaconDB,
+		HeadFetcher:     &mock.ChainService{State: st},
+		AttestationPool: attestations.NewPool(),
+		ExitPool:        voluntaryexits.NewPool(),
+		SlashingPool:    slashings.NewPool(),
+	})
+	// The pools are not saved before the persisted operations are restored.
+	require.NoError(t, s.Stop())
+	exits, err := beaconDB.VoluntaryExitPool(ctx)
+	require.NoError(t, err)
+	require.DeepSSZEqual(t, saved, exits)
+
+	s = NewService(ctx, &Config{
+		BeaconDB:        beaconDB,
+		HeadFetcher:     &mock.ChainService{State: st},
+		AttestationPool: attestations.NewPool(),
+		ExitPool:        voluntaryexits.NewPool(),
+		SlashingPool:    slashings.NewPool(),
+		SaveInterval:    time.Hour,
+	})
+	s.Start()
+	require.NoError(t, s.Stop())
+	// The invalid exit was dropped when restoring, and the empty pool saved at shutdown.
+	exits, err = beaconDB.VoluntaryExitPool(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(exits))
+}
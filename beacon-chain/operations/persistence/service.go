@@ -0,0 +1,99 @@
+// Package persistence defines a service saving the pending operations of the pools which are not
+// time critical, voluntary exits, slashings and recent aggregated attestations, to the beacon DB.
+// The operations are restored and revalidated against the head state when the node starts, so that
+// they survive restarts of the node.
+package persistence
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/async/abool"
+	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/attestations"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/slashings"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/voluntaryexits"
+	"github.com/prysmaticlabs/prysm/config/params"
+)
+
+// Service of operation pool persistence.
+type Service struct {
+	cfg    *Config
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+	// restored is set once the persisted operations are restored, the pools are not saved before
+	// so that the persisted operations are not overwritten.
+	restored *abool.AtomicBool
+}
+
+// Config options for the service.
+type Config struct {
+	BeaconDB        db.NoHeadAccessDatabase
+	HeadFetcher     blockchain.HeadFetcher
+	AttestationPool attestations.Pool
+	ExitPool        voluntaryexits.PoolManager
+	SlashingPool    slashings.PoolManager
+	// SaveInterval is the interval at which the pools are saved, in addition to the node shutdown.
+	SaveInterval time.Duration
+}
+
+// NewService instantiates a new operation pool persistence service instance that will
+// be registered into a running beacon node.
+func NewService(ctx context.Context, cfg *Config) *Service {
+	if cfg.SaveInterval == 0 {
+		// Save the pools every epoch.
+		cfg.SaveInterval = time.Duration(uint64(params.BeaconConfig().SlotsPerEpoch)*params.BeaconConfig().SecondsPerSlot) * time.Second
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	return &Service{
+		cfg:      cfg,
+		ctx:      ctx,
+		cancel:   cancel,
+		restored: abool.New(),
+	}
+}
+
+// Start restores the persisted operations into the pools, and saves the pools at every interval.
+func (s *Service) Start() {
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		s.restorePools(s.ctx)
+		s.restored.Set()
+		s.savePoolsRoutine()
+	}()
+}
+
+// Stop the service, saving the pools a last time.
+func (s *Service) Stop() error {
+	s.cancel()
+	s.wg.Wait()
+	if !s.restored.IsSet() {
+		return nil
+	}
+	return s.savePools(context.Background())
+}
+
+// Status returns nil, the service has no failure state.
+func (*Service) Status() error {
+	return nil
+}
+
+func (s *Service) savePoolsRoutine() {
+	ticker := time.NewTicker(s.cfg.SaveInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if err := s.savePools(s.ctx); err != nil {
+				log.WithError(err).Error("Could not save operation pools")
+			}
+		case <-s.ctx.Done():
+			log.Debug("Context closed, exiting routine")
+			return
+		}
+	}
+}
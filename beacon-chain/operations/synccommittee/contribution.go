@@ -58,9 +58,11 @@ func (s *Store) SaveSyncCommitteeContribution(cont *ethpb.SyncCommitteeContribut
 
 	// Trim contributions in queue down to syncCommitteeMaxQueueSize.
 	if s.contributionCache.Len() > syncCommitteeMaxQueueSize {
-		if _, err := s.contributionCache.Pop(); err != nil {
+		item, err := s.contributionCache.Pop()
+		if err != nil {
 			return err
 		}
+		prunedSyncCommitteeContributionTotal.Add(float64(itemLen(item)))
 	}
 
 	return nil
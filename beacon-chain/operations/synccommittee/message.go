@@ -51,9 +51,11 @@ func (s *Store) SaveSyncCommitteeMessage(msg *ethpb.SyncCommitteeMessage) error
 
 	// Trim messages in queue down to syncCommitteeMaxQueueSize.
 	if s.messageCache.Len() > syncCommitteeMaxQueueSize {
-		if _, err := s.messageCache.Pop(); err != nil {
+		item, err := s.messageCache.Pop()
+		if err != nil {
 			return err
 		}
+		prunedSyncCommitteeMessageTotal.Add(float64(itemLen(item)))
 	}
 
 	return nil
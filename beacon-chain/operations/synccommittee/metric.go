@@ -14,4 +14,12 @@ var (
 		Name: "saved_sync_committee_contribution_total",
 		Help: "The number of saved sync committee contribution total.",
 	})
+	prunedSyncCommitteeMessageTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "pruned_sync_committee_message_total",
+		Help: "The number of sync committee messages pruned from the pool.",
+	})
+	prunedSyncCommitteeContributionTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "pruned_sync_committee_contribution_total",
+		Help: "The number of sync committee contributions pruned from the pool.",
+	})
 )
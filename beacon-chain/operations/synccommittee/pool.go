@@ -19,6 +19,10 @@ type Pool interface {
 	// Methods for Sync Committee Messages.
 	SaveSyncCommitteeMessage(sig *ethpb.SyncCommitteeMessage) error
 	SyncCommitteeMessages(slot types.Slot) ([]*ethpb.SyncCommitteeMessage, error)
+
+	// Methods for pruning and inspecting the pool.
+	PruneSyncCommitteePool(slot types.Slot) error
+	SyncCommitteePoolSlots() ([]types.Slot, error)
 }
 
 // NewPool returns the sync committee store fulfilling the pool interface.
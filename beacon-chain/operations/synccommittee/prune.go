@@ -0,0 +1,111 @@
+package synccommittee
+
+import (
+	"sort"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/container/queue"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+)
+
+// PruneSyncCommitteePool removes the sync committee messages and contributions of the slots
+// lower than the given slot.
+func (s *Store) PruneSyncCommitteePool(slot types.Slot) error {
+	s.messageLock.Lock()
+	numMessages, err := pruneQueue(s.messageCache, slot)
+	s.messageLock.Unlock()
+	if err != nil {
+		return errors.Wrap(err, "could not prune sync committee messages")
+	}
+	prunedSyncCommitteeMessageTotal.Add(float64(numMessages))
+
+	s.contributionLock.Lock()
+	numContributions, err := pruneQueue(s.contributionCache, slot)
+	s.contributionLock.Unlock()
+	if err != nil {
+		return errors.Wrap(err, "could not prune sync committee contributions")
+	}
+	prunedSyncCommitteeContributionTotal.Add(float64(numContributions))
+	return nil
+}
+
+// SyncCommitteePoolSlots returns the slots having sync committee messages or contributions
+// in the pool, in ascending order.
+func (s *Store) SyncCommitteePoolSlots() ([]types.Slot, error) {
+	s.messageLock.Lock()
+	messageSlots, err := queueSlots(s.messageCache)
+	s.messageLock.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	s.contributionLock.Lock()
+	contributionSlots, err := queueSlots(s.contributionCache)
+	s.contributionLock.Unlock()
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[types.Slot]bool, len(messageSlots)+len(contributionSlots))
+	poolSlots := make([]types.Slot, 0, len(messageSlots)+len(contributionSlots))
+	for _, slot := range append(messageSlots, contributionSlots...) {
+		if !seen[slot] {
+			seen[slot] = true
+			poolSlots = append(poolSlots, slot)
+		}
+	}
+	sort.Slice(poolSlots, func(i, j int) bool {
+		return poolSlots[i] < poolSlots[j]
+	})
+	return poolSlots, nil
+}
+
+// pruneQueue pops the items of the slots lower than the given slot and returns the number of
+// objects they held. Items are prioritized by slot, so the lowest slot is always popped first.
+func pruneQueue(pq *queue.PriorityQueue, slot types.Slot) (int, error) {
+	pruned := 0
+	for pq.Len() > 0 {
+		item, err := pq.Pop()
+		if err != nil {
+			return pruned, err
+		}
+		if item.Priority >= int64(slot) {
+			return pruned, pq.Push(item)
+		}
+		pruned += itemLen(item)
+	}
+	return pruned, nil
+}
+
+// queueSlots returns the slots of the items in the queue. The queue does not expose its items, so
+// they are popped and pushed back, which is cheap as the queue holds a handful of slots.
+func queueSlots(pq *queue.PriorityQueue) ([]types.Slot, error) {
+	items := make([]*queue.Item, 0, pq.Len())
+	for pq.Len() > 0 {
+		item, err := pq.Pop()
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+	slots := make([]types.Slot, len(items))
+	for i, item := range items {
+		if err := pq.Push(item); err != nil {
+			return nil, err
+		}
+		slots[i] = types.Slot(item.Priority)
+	}
+	return slots, nil
+}
+
+// itemLen returns the number of messages or contributions held by the item.
+func itemLen(item *queue.Item) int {
+	switch v := item.Value.(type) {
+	case []*ethpb.SyncCommitteeMessage:
+		return len(v)
+	case []*ethpb.SyncCommitteeContribution:
+		return len(v)
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,41 @@
+package synccommittee
+
+import (
+	"testing"
+
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/testing/require"
+)
+
+func TestStore_PruneSyncCommitteePool(t *testing.T) {
+	store := NewStore()
+	for _, slot := range []types.Slot{3, 1, 2} {
+		require.NoError(t, store.SaveSyncCommitteeMessage(&ethpb.SyncCommitteeMessage{Slot: slot}))
+	}
+	for _, slot := range []types.Slot{2, 4} {
+		require.NoError(t, store.SaveSyncCommitteeContribution(&ethpb.SyncCommitteeContribution{Slot: slot}))
+	}
+
+	poolSlots, err := store.SyncCommitteePoolSlots()
+	require.NoError(t, err)
+	require.DeepEqual(t, []types.Slot{1, 2, 3, 4}, poolSlots)
+
+	require.NoError(t, store.PruneSyncCommitteePool(3))
+	poolSlots, err = store.SyncCommitteePoolSlots()
+	require.NoError(t, err)
+	require.DeepEqual(t, []types.Slot{3, 4}, poolSlots)
+
+	msgs, err := store.SyncCommitteeMessages(2)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(msgs))
+	msgs, err = store.SyncCommitteeMessages(3)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(msgs))
+	contributions, err := store.SyncCommitteeContributions(2)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(contributions))
+	contributions, err = store.SyncCommitteeContributions(4)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(contributions))
+}
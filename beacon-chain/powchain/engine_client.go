@@ -10,10 +10,13 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	gethTypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/holiman/uint256"
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/config/params"
+	"github.com/prysmaticlabs/prysm/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/consensus-types/wrapper"
 	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
 	pb "github.com/prysmaticlabs/prysm/proto/engine/v1"
 	"github.com/sirupsen/logrus"
@@ -33,6 +36,8 @@ const (
 	ExecutionBlockByHashMethod = "eth_getBlockByHash"
 	// ExecutionBlockByNumberMethod request string for JSON-RPC.
 	ExecutionBlockByNumberMethod = "eth_getBlockByNumber"
+	// GetPayloadBodiesByHashMethod v1 request string for JSON-RPC.
+	GetPayloadBodiesByHashMethod = "engine_getPayloadBodiesByHashV1"
 	// Defines the seconds to wait before timing out engine endpoints with block execution semantics (newPayload, forkchoiceUpdated).
 	payloadAndForkchoiceUpdatedTimeout = 8 * time.Second
 	// Defines the seconds before timing out engine endpoints with non-block execution semantics.
@@ -46,6 +51,26 @@ type ForkchoiceUpdatedResponse struct {
 	PayloadId *pb.PayloadIDBytes `json:"payloadId"`
 }
 
+// ExecutionPayloadReconstructor defines a service that can reconstruct a full beacon block,
+// with its execution payload, from a blinded beacon block and the execution client.
+type ExecutionPayloadReconstructor interface {
+	ReconstructFullBellatrixBlock(
+		ctx context.Context, blindedBlock interfaces.SignedBeaconBlock,
+	) (interfaces.SignedBeaconBlock, error)
+}
+
+// executionPayloadBody is the response kind received by the
+// engine_getPayloadBodiesByHashV1 endpoint.
+type executionPayloadBody struct {
+	Transactions []hexutil.Bytes `json:"transactions"`
+}
+
+// executionBlockWithTxs is the subset of an execution block received by the
+// eth_getBlockByHash endpoint when requesting full transaction objects.
+type executionBlockWithTxs struct {
+	Transactions []*gethTypes.Transaction `json:"transactions"`
+}
+
 // EngineCaller defines a client that can interact with an Ethereum
 // execution node's engine service via JSON-RPC.
 type EngineCaller interface {
@@ -290,6 +315,98 @@ func (s *Service) ExecutionBlockByHash(ctx context.Context, hash common.Hash) (*
 	return result, handleRPCError(err)
 }
 
+// ReconstructFullBellatrixBlock takes in a blinded beacon block and reconstructs
+// a beacon block with a full execution payload, fetching the payload transactions
+// from the execution client. Blocks which are not blinded are returned as is.
+func (s *Service) ReconstructFullBellatrixBlock(
+	ctx context.Context, blindedBlock interfaces.SignedBeaconBlock,
+) (interfaces.SignedBeaconBlock, error) {
+	ctx, span := trace.StartSpan(ctx, "powchain.engine-api-client.ReconstructFullBellatrixBlock")
+	defer span.End()
+
+	if err := wrapper.BeaconBlockIsNil(blindedBlock); err != nil {
+		return nil, errors.Wrap(err, "cannot reconstruct bellatrix block from nil data")
+	}
+	if !blindedBlock.Block().IsBlinded() {
+		return blindedBlock, nil
+	}
+	header, err := blindedBlock.Block().Body().ExecutionPayloadHeader()
+	if err != nil {
+		return nil, err
+	}
+	// Blocks built before the merge have an empty payload, which has no transactions
+	// and is not known to the execution client.
+	txs := make([][]byte, 0)
+	blockHash := common.BytesToHash(header.BlockHash)
+	if blockHash != (common.Hash{}) {
+		txs, err = s.payloadTransactionsByHash(ctx, blockHash)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not fetch transactions of execution block %#x", blockHash)
+		}
+	}
+	payload := &pb.ExecutionPayload{
+		ParentHash:    header.ParentHash,
+		FeeRecipient:  header.FeeRecipient,
+		StateRoot:     header.StateRoot,
+		ReceiptsRoot:  header.ReceiptsRoot,
+		LogsBloom:     header.LogsBloom,
+		PrevRandao:    header.PrevRandao,
+		BlockNumber:   header.BlockNumber,
+		GasLimit:      header.GasLimit,
+		GasUsed:       header.GasUsed,
+		Timestamp:     header.Timestamp,
+		ExtraData:     header.ExtraData,
+		BaseFeePerGas: header.BaseFeePerGas,
+		BlockHash:     header.BlockHash,
+		Transactions:  txs,
+	}
+	fullBlock, err := wrapper.BuildSignedBeaconBlockFromExecutionPayload(blindedBlock, payload)
+	if err != nil {
+		return nil, err
+	}
+	reconstructedExecutionPayloadCount.Add(1)
+	return fullBlock, nil
+}
+
+// payloadTransactionsByHash fetches the transactions of an execution block by calling
+// engine_getPayloadBodiesByHashV1 via JSON-RPC, falling back to eth_getBlockByHash with
+// full transaction objects for execution clients which do not support it.
+func (s *Service) payloadTransactionsByHash(ctx context.Context, hash common.Hash) ([][]byte, error) {
+	var bodies []*executionPayloadBody
+	err := handleRPCError(s.rpcClient.CallContext(ctx, &bodies, GetPayloadBodiesByHashMethod, []common.Hash{hash}))
+	switch {
+	case err == nil:
+		if len(bodies) != 1 || bodies[0] == nil {
+			return nil, ErrNilResponse
+		}
+		txs := make([][]byte, len(bodies[0].Transactions))
+		for i, tx := range bodies[0].Transactions {
+			txs[i] = tx
+		}
+		return txs, nil
+	case errors.Is(err, ErrMethodNotFound):
+	default:
+		return nil, err
+	}
+
+	var blk *executionBlockWithTxs
+	err = s.rpcClient.CallContext(ctx, &blk, ExecutionBlockByHashMethod, hash, true /* full transaction objects */)
+	if err != nil {
+		return nil, handleRPCError(err)
+	}
+	if blk == nil {
+		return nil, ErrNilResponse
+	}
+	txs := make([][]byte, len(blk.Transactions))
+	for i, tx := range blk.Transactions {
+		txs[i], err = tx.MarshalBinary()
+		if err != nil {
+			return nil, errors.Wrap(err, "could not marshal transaction")
+		}
+	}
+	return txs, nil
+}
+
 // Handles errors received from the RPC server according to the specification.
 func handleRPCError(err error) error {
 	if err == nil {
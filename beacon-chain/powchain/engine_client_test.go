@@ -10,23 +10,33 @@ import (
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	gethTypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/holiman/uint256"
 	"github.com/pkg/errors"
 	mocks "github.com/prysmaticlabs/prysm/beacon-chain/powchain/testing"
 	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/config/params"
+	"github.com/prysmaticlabs/prysm/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/consensus-types/wrapper"
 	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/network/authorization"
 	pb "github.com/prysmaticlabs/prysm/proto/engine/v1"
+	"github.com/prysmaticlabs/prysm/testing/engine"
 	"github.com/prysmaticlabs/prysm/testing/require"
+	"github.com/prysmaticlabs/prysm/testing/util"
 	"google.golang.org/protobuf/proto"
 )
 
 var (
 	_ = EngineCaller(&Service{})
 	_ = EngineCaller(&mocks.EngineClient{})
+	_ = ExecutionPayloadReconstructor(&Service{})
+	_ = ExecutionPayloadReconstructor(&mocks.EngineClient{})
 )
 
 func TestClient_IPC(t *testing.T) {
@@ -606,6 +616,117 @@ func TestExchangeTransitionConfiguration(t *testing.T) {
 	})
 }
 
+func TestReconstructFullBellatrixBlock(t *testing.T) {
+	ctx := context.Background()
+	tx := gethTypes.NewTx(&gethTypes.LegacyTx{
+		Nonce:    1,
+		GasPrice: big.NewInt(1),
+		Gas:      21000,
+		To:       &common.Address{'a'},
+		Value:    big.NewInt(1),
+	})
+	txBytes, err := tx.MarshalBinary()
+	require.NoError(t, err)
+	payload, ok := fixtures()["ExecutionPayload"].(*pb.ExecutionPayload)
+	require.Equal(t, true, ok)
+	payload.Transactions = [][]byte{txBytes}
+
+	blindedBlock := func(t *testing.T, payload *pb.ExecutionPayload) interfaces.SignedBeaconBlock {
+		b := util.NewBeaconBlockBellatrix()
+		b.Block.Body.ExecutionPayload = payload
+		wsb, err := wrapper.WrappedSignedBeaconBlock(b)
+		require.NoError(t, err)
+		blinded, err := wrapper.BlindSignedBeaconBlock(wsb)
+		require.NoError(t, err)
+		return blinded
+	}
+	// The execution client answers with the given results, keyed by method, or with a method not
+	// found error for methods without result.
+	setup := func(t *testing.T, results map[string]interface{}) *Service {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			defer func() {
+				require.NoError(t, r.Body.Close())
+			}()
+			req := struct {
+				ID     json.RawMessage `json:"id"`
+				Method string          `json:"method"`
+			}{}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+			resp := map[string]interface{}{
+				"jsonrpc": "2.0",
+				"id":      req.ID,
+			}
+			if result, ok := results[req.Method]; ok {
+				resp["result"] = result
+			} else {
+				resp["error"] = map[string]interface{}{"code": -32601, "message": "method not found"}
+			}
+			require.NoError(t, json.NewEncoder(w).Encode(resp))
+		}))
+		t.Cleanup(srv.Close)
+		rpcClient, err := rpc.DialHTTP(srv.URL)
+		require.NoError(t, err)
+		t.Cleanup(rpcClient.Close)
+		service := &Service{}
+		service.rpcClient = rpcClient
+		return service
+	}
+
+	t.Run("not blinded", func(t *testing.T) {
+		wsb, err := wrapper.WrappedSignedBeaconBlock(util.NewBeaconBlockBellatrix())
+		require.NoError(t, err)
+		service := setup(t, nil)
+		got, err := service.ReconstructFullBellatrixBlock(ctx, wsb)
+		require.NoError(t, err)
+		require.DeepEqual(t, wsb, got)
+	})
+	t.Run("empty payload", func(t *testing.T) {
+		empty := util.NewBeaconBlockBellatrix()
+		blinded := blindedBlock(t, empty.Block.Body.ExecutionPayload)
+		service := setup(t, nil)
+		got, err := service.ReconstructFullBellatrixBlock(ctx, blinded)
+		require.NoError(t, err)
+		gotPayload, err := got.Block().Body().ExecutionPayload()
+		require.NoError(t, err)
+		require.DeepSSZEqual(t, empty.Block.Body.ExecutionPayload, gotPayload)
+	})
+	t.Run("payload bodies by hash", func(t *testing.T) {
+		service := setup(t, map[string]interface{}{
+			GetPayloadBodiesByHashMethod: []*executionPayloadBody{{Transactions: []hexutil.Bytes{txBytes}}},
+		})
+		got, err := service.ReconstructFullBellatrixBlock(ctx, blindedBlock(t, payload))
+		require.NoError(t, err)
+		gotPayload, err := got.Block().Body().ExecutionPayload()
+		require.NoError(t, err)
+		require.DeepSSZEqual(t, payload, gotPayload)
+	})
+	t.Run("falls back to block by hash", func(t *testing.T) {
+		service := setup(t, map[string]interface{}{
+			ExecutionBlockByHashMethod: map[string]interface{}{"transactions": []*gethTypes.Transaction{tx}},
+		})
+		got, err := service.ReconstructFullBellatrixBlock(ctx, blindedBlock(t, payload))
+		require.NoError(t, err)
+		gotPayload, err := got.Block().Body().ExecutionPayload()
+		require.NoError(t, err)
+		require.DeepSSZEqual(t, payload, gotPayload)
+	})
+	t.Run("unknown block", func(t *testing.T) {
+		service := setup(t, map[string]interface{}{
+			ExecutionBlockByHashMethod: nil,
+		})
+		_, err := service.ReconstructFullBellatrixBlock(ctx, blindedBlock(t, payload))
+		require.ErrorIs(t, err, ErrNilResponse)
+	})
+	t.Run("mismatched transactions", func(t *testing.T) {
+		service := setup(t, map[string]interface{}{
+			GetPayloadBodiesByHashMethod: []*executionPayloadBody{{Transactions: []hexutil.Bytes{[]byte("foo")}}},
+		})
+		_, err := service.ReconstructFullBellatrixBlock(ctx, blindedBlock(t, payload))
+		require.ErrorContains(t, "does not match payload root", err)
+	})
+}
+
 type customError struct {
 	code    int
 	timeout bool
@@ -719,6 +840,91 @@ func Test_handleRPCError(t *testing.T) {
 	}
 }
 
+func TestClient_EngineServer(t *testing.T) {
+	ctx := context.Background()
+	secret := bytesutil.PadTo([]byte("secret"), fieldparams.RootLength)
+	server, err := engine.New(engine.WithJWTSecret(secret))
+	require.NoError(t, err)
+	defer server.Stop()
+
+	endpoint := HttpEndpoint(server.URL())
+	endpoint.Auth.Method = authorization.Bearer
+	endpoint.Auth.Value = string(secret)
+	service := &Service{}
+	service.rpcClient, err = service.newRPCClientWithAuth(ctx, endpoint)
+	require.NoError(t, err)
+	require.NoError(t, service.ExchangeTransitionConfiguration(ctx, &pb.TransitionConfiguration{}))
+
+	buildPayload := func(t *testing.T, parent []byte, timestamp uint64) *pb.ExecutionPayload {
+		id, _, err := service.ForkchoiceUpdated(ctx, &pb.ForkchoiceState{HeadBlockHash: parent}, &pb.PayloadAttributes{
+			Timestamp:             timestamp,
+			PrevRandao:            make([]byte, fieldparams.RootLength),
+			SuggestedFeeRecipient: make([]byte, fieldparams.FeeRecipientLength),
+		})
+		require.NoError(t, err)
+		require.NotNil(t, id)
+		payload, err := service.GetPayload(ctx, *id)
+		require.NoError(t, err)
+		return payload
+	}
+
+	t.Run("valid payload", func(t *testing.T) {
+		payload := buildPayload(t, server.GenesisHash().Bytes(), 1)
+		lvh, err := service.NewPayload(ctx, payload)
+		require.NoError(t, err)
+		require.DeepEqual(t, payload.BlockHash, lvh)
+		_, lvh, err = service.ForkchoiceUpdated(ctx, &pb.ForkchoiceState{HeadBlockHash: payload.BlockHash}, nil)
+		require.NoError(t, err)
+		require.DeepEqual(t, payload.BlockHash, lvh)
+		blk, err := service.LatestExecutionBlock(ctx)
+		require.NoError(t, err)
+		require.DeepEqual(t, payload.BlockHash, blk.Hash)
+	})
+	t.Run("unknown parent is syncing", func(t *testing.T) {
+		payload := buildPayload(t, server.HeadHash().Bytes(), 2)
+		payload.ParentHash = bytesutil.PadTo([]byte("unknown"), fieldparams.RootLength)
+		_, err := service.NewPayload(ctx, payload)
+		require.ErrorIs(t, err, ErrAcceptedSyncingPayloadStatus)
+		_, _, err = service.ForkchoiceUpdated(ctx, &pb.ForkchoiceState{HeadBlockHash: payload.BlockHash}, nil)
+		require.ErrorIs(t, err, ErrAcceptedSyncingPayloadStatus)
+	})
+	t.Run("scripted invalid payload", func(t *testing.T) {
+		defer server.ClearFaults()
+		head := server.HeadHash()
+		payload := buildPayload(t, head.Bytes(), 3)
+		server.SetPayloadStatus(bytesutil.ToBytes32(payload.BlockHash), &pb.PayloadStatus{
+			Status:          pb.PayloadStatus_INVALID,
+			LatestValidHash: head.Bytes(),
+		})
+		lvh, err := service.NewPayload(ctx, payload)
+		require.ErrorIs(t, err, ErrInvalidPayloadStatus)
+		require.DeepEqual(t, head.Bytes(), lvh)
+		require.Equal(t, false, server.HasBlock(common.BytesToHash(payload.BlockHash)))
+	})
+	t.Run("scripted accepted payload", func(t *testing.T) {
+		defer server.ClearFaults()
+		payload := buildPayload(t, server.HeadHash().Bytes(), 4)
+		server.SetDefaultPayloadStatus(&pb.PayloadStatus{Status: pb.PayloadStatus_ACCEPTED})
+		_, err := service.NewPayload(ctx, payload)
+		require.ErrorIs(t, err, ErrAcceptedSyncingPayloadStatus)
+	})
+	t.Run("latency", func(t *testing.T) {
+		defer server.ClearFaults()
+		payload := buildPayload(t, server.HeadHash().Bytes(), 5)
+		server.SetLatency(engine.NewPayloadMethod, time.Second)
+		timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+		defer cancel()
+		_, err := service.NewPayload(timeoutCtx, payload)
+		require.ErrorIs(t, err, ErrHTTPTimeout)
+	})
+	t.Run("scripted error", func(t *testing.T) {
+		defer server.ClearFaults()
+		server.SetError(engine.ForkchoiceUpdatedMethod, &rpc.CustomError{Code: -38002})
+		_, _, err := service.ForkchoiceUpdated(ctx, &pb.ForkchoiceState{HeadBlockHash: server.HeadHash().Bytes()}, nil)
+		require.ErrorIs(t, err, ErrInvalidForkchoiceState)
+	})
+}
+
 func newTestIPCServer(t *testing.T) *rpc.Server {
 	server := rpc.NewServer()
 	err := server.RegisterName("engine", new(testEngineService))
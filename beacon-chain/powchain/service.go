@@ -215,6 +215,11 @@ func NewService(ctx context.Context, opts ...Option) (*Service, error) {
 	if err != nil {
 		return nil, errors.Wrap(err, "unable to retrieve eth1 data")
 	}
+	if eth1Data == nil {
+		if err := s.initializeFromDepositSnapshot(ctx); err != nil {
+			return nil, err
+		}
+	}
 
 	if err := s.initializeEth1Data(ctx, eth1Data); err != nil {
 		return nil, err
@@ -298,6 +303,11 @@ func (s *Service) Status() error {
 }
 
 func (s *Service) updateBeaconNodeStats() {
+	s.cfg.beaconNodeStatsUpdater.Update(s.BeaconNodeStats())
+}
+
+// BeaconNodeStats returns the client-stats describing the eth1 connection of the service.
+func (s *Service) BeaconNodeStats() clientstats.BeaconNodeStats {
 	bs := clientstats.BeaconNodeStats{}
 	if len(s.cfg.httpEndpoints) > 1 {
 		bs.SyncEth1FallbackConfigured = true
@@ -309,7 +319,7 @@ func (s *Service) updateBeaconNodeStats() {
 			bs.SyncEth1FallbackConnected = true
 		}
 	}
-	s.cfg.beaconNodeStatsUpdater.Update(bs)
+	return bs
 }
 
 func (s *Service) updateCurrHttpEndpoint(endpoint network.Endpoint) {
@@ -378,6 +388,16 @@ func (s *Service) followedBlockHeight(_ context.Context) (uint64, error) {
 }
 
 func (s *Service) initDepositCaches(ctx context.Context, ctrs []*ethpb.DepositContainer) error {
+	// Finalized deposits covered by the deposit snapshot do not have to be inserted one by one.
+	snapshot, err := s.cfg.beaconDB.DepositSnapshot(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not retrieve deposit snapshot")
+	}
+	if snapshot != nil {
+		if err := s.cfg.depositCache.InsertDepositSnapshot(ctx, snapshot); err != nil {
+			return errors.Wrap(err, "could not insert deposit snapshot")
+		}
+	}
 	if len(ctrs) == 0 {
 		return nil
 	}
@@ -423,16 +443,43 @@ func (s *Service) initDepositCaches(ctx context.Context, ctrs []*ethpb.DepositCo
 		}
 	}
 	validDepositsCount.Add(float64(currIndex))
-	// Only add pending deposits if the container slice length
-	// is more than the current index in state.
-	if uint64(len(ctrs)) > currIndex {
-		for _, c := range ctrs[currIndex:] {
+	// Only add pending deposits which are not yet included in the state.
+	for _, c := range ctrs {
+		if c.Index >= int64(currIndex) { // lint:ignore uintcast -- deposit index will not exceed int64 in your lifetime.
 			s.cfg.depositCache.InsertPendingDeposit(ctx, c.Deposit, c.Eth1BlockHeight, c.Index, bytesutil.ToBytes32(c.DepositRoot))
 		}
 	}
 	return nil
 }
 
+// initializeFromDepositSnapshot sets up the deposit trie and caches from the finalized deposit snapshot
+// saved in the database, if there is one, so that deposit logs are only requested after the execution
+// block of the snapshot.
+func (s *Service) initializeFromDepositSnapshot(ctx context.Context) error {
+	snapshot, err := s.cfg.beaconDB.DepositSnapshot(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not retrieve deposit snapshot")
+	}
+	if snapshot == nil {
+		return nil
+	}
+	depositTrie, err := trie.CreateTrieFromSnapshot(snapshot, params.BeaconConfig().DepositContractTreeDepth)
+	if err != nil {
+		return errors.Wrap(err, "could not create deposit trie from snapshot")
+	}
+	if err := s.cfg.depositCache.InsertDepositSnapshot(ctx, snapshot); err != nil {
+		return errors.Wrap(err, "could not insert deposit snapshot")
+	}
+	s.depositTrie = depositTrie
+	s.lastReceivedMerkleIndex = int64(snapshot.DepositCount) - 1 // lint:ignore uintcast -- deposit count will not exceed int64 in your lifetime.
+	s.latestEth1Data.LastRequestedBlock = snapshot.ExecutionBlockHeight
+	log.WithFields(logrus.Fields{
+		"depositCount":         snapshot.DepositCount,
+		"executionBlockHeight": snapshot.ExecutionBlockHeight,
+	}).Info("Initialized deposits from deposit snapshot")
+	return nil
+}
+
 // processBlockHeader adds a newly observed eth1 block to the block cache and
 // updates the latest blockHeight, blockHash, and blockTime properties of the service.
 func (s *Service) processBlockHeader(header *gethTypes.Header) {
@@ -789,8 +836,9 @@ func (s *Service) initializeEth1Data(ctx context.Context, eth1DataInDB *ethpb.ET
 }
 
 // Validates that all deposit containers are valid and have their relevant indices
-// in order.
-func validateDepositContainers(ctrs []*ethpb.DepositContainer) bool {
+// in order. Containers may only start after the first deposit when the earlier deposits
+// are covered by the given deposit snapshot.
+func validateDepositContainers(ctrs []*ethpb.DepositContainer, snapshot *trie.DepositTreeSnapshot) bool {
 	ctrLen := len(ctrs)
 	// Exit for empty containers.
 	if ctrLen == 0 {
@@ -801,6 +849,9 @@ func validateDepositContainers(ctrs []*ethpb.DepositContainer) bool {
 		return ctrs[i].Index < ctrs[j].Index
 	})
 	startIndex := int64(0)
+	if snapshot != nil && ctrs[0].Index <= int64(snapshot.DepositCount) { // lint:ignore uintcast -- deposit count will not exceed int64 in your lifetime.
+		startIndex = ctrs[0].Index
+	}
 	for _, c := range ctrs {
 		if c.Index != startIndex {
 			log.Info("Recovering missing deposit containers, node is re-requesting missing deposit data")
@@ -826,7 +877,11 @@ func (s *Service) ensureValidPowchainData(ctx context.Context) error {
 	if err != nil {
 		return errors.Wrap(err, "unable to retrieve eth1 data")
 	}
-	if eth1Data == nil || !eth1Data.ChainstartData.Chainstarted || !validateDepositContainers(eth1Data.DepositContainers) {
+	snapshot, err := s.cfg.beaconDB.DepositSnapshot(ctx)
+	if err != nil {
+		return errors.Wrap(err, "unable to retrieve deposit snapshot")
+	}
+	if eth1Data == nil || !eth1Data.ChainstartData.Chainstarted || !validateDepositContainers(eth1Data.DepositContainers, snapshot) {
 		var pbState *ethpb.BeaconState
 		var err error
 		if features.Get().EnableNativeState {
@@ -844,6 +899,9 @@ func (s *Service) ensureValidPowchainData(ctx context.Context) error {
 			Eth1Data:           genState.Eth1Data(),
 			ChainstartDeposits: make([]*ethpb.Deposit, 0),
 		}
+		if err := s.initializeFromDepositSnapshot(ctx); err != nil {
+			return err
+		}
 		eth1Data = &ethpb.ETH1ChainData{
 			CurrentEth1Data:   s.latestEth1Data,
 			ChainstartData:    s.chainStartData,
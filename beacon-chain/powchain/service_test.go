@@ -22,6 +22,7 @@ import (
 	mockPOW "github.com/prysmaticlabs/prysm/beacon-chain/powchain/testing"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
 	"github.com/prysmaticlabs/prysm/config/params"
+	"github.com/prysmaticlabs/prysm/container/trie"
 	contracts "github.com/prysmaticlabs/prysm/contracts/deposit"
 	"github.com/prysmaticlabs/prysm/contracts/deposit/mock"
 	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
@@ -682,6 +683,40 @@ func TestService_EnsureConsistentPowchainData(t *testing.T) {
 	assert.Equal(t, true, eth1Data.ChainstartData.Chainstarted)
 }
 
+func TestNewService_InitializesFromDepositSnapshot(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := dbutil.SetupDB(t)
+	cache, err := depositcache.New()
+	require.NoError(t, err)
+	srv, endpoint, err := mockPOW.SetupRPCServer()
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		srv.Stop()
+	})
+
+	depositTrie, err := trie.NewTrie(params.BeaconConfig().DepositContractTreeDepth)
+	require.NoError(t, err)
+	for i := 0; i < 11; i++ {
+		require.NoError(t, depositTrie.Insert([]byte{byte(i + 1)}, i))
+	}
+	snapshot, err := depositTrie.Snapshot([32]byte{'a'}, 1234)
+	require.NoError(t, err)
+	require.NoError(t, beaconDB.SaveDepositSnapshot(ctx, snapshot))
+
+	s, err := NewService(ctx,
+		WithHttpEndpoints([]string{endpoint}),
+		WithDatabase(beaconDB),
+		WithDepositCache(cache),
+	)
+	require.NoError(t, err)
+	root, err := s.depositTrie.HashTreeRoot()
+	require.NoError(t, err)
+	assert.Equal(t, snapshot.DepositRoot, root)
+	assert.Equal(t, int64(10), s.lastReceivedMerkleIndex)
+	assert.Equal(t, uint64(1234), s.latestEth1Data.LastRequestedBlock)
+	assert.Equal(t, int64(10), cache.FinalizedDeposits(ctx).MerkleTrieIndex)
+}
+
 func TestService_InitializeCorrectly(t *testing.T) {
 	beaconDB := dbutil.SetupDB(t)
 	cache, err := depositcache.New()
@@ -799,8 +834,16 @@ func TestService_ValidateDepositContainers(t *testing.T) {
 	}
 
 	for _, test := range tt {
-		assert.Equal(t, test.expectedRes, validateDepositContainers(test.ctrsFunc()))
+		assert.Equal(t, test.expectedRes, validateDepositContainers(test.ctrsFunc(), nil))
+	}
+
+	// Containers may start after the first deposit if the earlier ones are in the deposit snapshot.
+	ctrs := make([]*ethpb.DepositContainer, 0)
+	for i := 5; i < 10; i++ {
+		ctrs = append(ctrs, &ethpb.DepositContainer{Index: int64(i), Eth1BlockHeight: uint64(i + 10)})
 	}
+	assert.Equal(t, true, validateDepositContainers(ctrs, &trie.DepositTreeSnapshot{DepositCount: 7}))
+	assert.Equal(t, false, validateDepositContainers(ctrs, &trie.DepositTreeSnapshot{DepositCount: 4}))
 }
 
 func TestTimestampIsChecked(t *testing.T) {
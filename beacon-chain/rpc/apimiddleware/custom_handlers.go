@@ -58,6 +58,14 @@ func handleGetBeaconBlockSSZV2(m *apimiddleware.ApiProxyMiddleware, endpoint api
 	return handleGetSSZ(m, endpoint, w, req, config)
 }
 
+func handleGetBlindedBeaconBlockSSZ(m *apimiddleware.ApiProxyMiddleware, endpoint apimiddleware.Endpoint, w http.ResponseWriter, req *http.Request) (handled bool) {
+	config := sszConfig{
+		fileName:     "beacon_block.ssz",
+		responseJson: &versionedSSZResponseJson{},
+	}
+	return handleGetSSZ(m, endpoint, w, req, config)
+}
+
 func handleSubmitBlockSSZ(m *apimiddleware.ApiProxyMiddleware, endpoint apimiddleware.Endpoint, w http.ResponseWriter, req *http.Request) (handled bool) {
 	return handlePostSSZ(m, endpoint, w, req, sszConfig{})
 }
@@ -359,6 +367,20 @@ func receiveEvents(eventChan <-chan *sse.Event, w http.ResponseWriter, req *http
 				data = &eventChainReorgJson{}
 			case events.SyncCommitteeContributionTopic:
 				data = &signedContributionAndProofJson{}
+			case events.PayloadAttributesTopic:
+				data = &eventPayloadAttributesJson{}
+			case events.BlockGossipTopic:
+				data = &eventBlockGossipJson{}
+			case events.AttesterSlashingTopic:
+				data = &attesterSlashingJson{}
+			case events.ProposerSlashingTopic:
+				data = &proposerSlashingJson{}
+			case events.SyncStatusTopic:
+				data = &eventSyncStatusJson{}
+			case events.OptimisticToValidTopic:
+				data = &eventOptimisticToValidJson{}
+			case events.InvalidatedPayloadTopic:
+				data = &eventInvalidatedPayloadJson{}
 			case "error":
 				data = &eventErrorJson{}
 			default:
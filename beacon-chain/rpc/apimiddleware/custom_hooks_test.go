@@ -711,6 +711,88 @@ func TestSerializeV2Block(t *testing.T) {
 	})
 }
 
+func TestSerializeBlindedBlock(t *testing.T) {
+	t.Run("Phase 0", func(t *testing.T) {
+		response := &blindedBlockResponseJson{
+			Version: ethpbv2.Version_PHASE0.String(),
+			Data: &signedBlindedBeaconBlockContainerJson{
+				Phase0Block: &beaconBlockJson{
+					Slot:          "1",
+					ProposerIndex: "1",
+					ParentRoot:    "root",
+					StateRoot:     "root",
+					Body:          &beaconBlockBodyJson{},
+				},
+				Signature: "sig",
+			},
+		}
+		runDefault, j, errJson := serializeBlindedBlock(response)
+		require.Equal(t, nil, errJson)
+		require.Equal(t, apimiddleware.RunDefault(false), runDefault)
+		require.NotNil(t, j)
+		resp := &phase0BlockResponseJson{}
+		require.NoError(t, json.Unmarshal(j, resp))
+		require.NotNil(t, resp.Data)
+		require.NotNil(t, resp.Data.Message)
+		beaconBlock := resp.Data.Message
+		assert.Equal(t, "1", beaconBlock.Slot)
+		assert.Equal(t, "sig", resp.Data.Signature)
+		require.NotNil(t, beaconBlock.Body)
+	})
+
+	t.Run("Bellatrix", func(t *testing.T) {
+		response := &blindedBlockResponseJson{
+			Version: ethpbv2.Version_BELLATRIX.String(),
+			Data: &signedBlindedBeaconBlockContainerJson{
+				BellatrixBlock: &blindedBeaconBlockBellatrixJson{
+					Slot:          "1",
+					ProposerIndex: "1",
+					ParentRoot:    "root",
+					StateRoot:     "root",
+					Body: &blindedBeaconBlockBodyBellatrixJson{
+						ExecutionPayloadHeader: &executionPayloadHeaderJson{TransactionsRoot: "root"},
+					},
+				},
+				Signature: "sig",
+			},
+		}
+		runDefault, j, errJson := serializeBlindedBlock(response)
+		require.Equal(t, nil, errJson)
+		require.Equal(t, apimiddleware.RunDefault(false), runDefault)
+		require.NotNil(t, j)
+		resp := &bellatrixBlindedBlockResponseJson{}
+		require.NoError(t, json.Unmarshal(j, resp))
+		require.NotNil(t, resp.Data)
+		require.NotNil(t, resp.Data.Message)
+		beaconBlock := resp.Data.Message
+		assert.Equal(t, "1", beaconBlock.Slot)
+		assert.Equal(t, "sig", resp.Data.Signature)
+		require.NotNil(t, beaconBlock.Body)
+		require.NotNil(t, beaconBlock.Body.ExecutionPayloadHeader)
+		assert.Equal(t, "root", beaconBlock.Body.ExecutionPayloadHeader.TransactionsRoot)
+	})
+
+	t.Run("incorrect response type", func(t *testing.T) {
+		response := &types.Empty{}
+		runDefault, j, errJson := serializeBlindedBlock(response)
+		require.Equal(t, apimiddleware.RunDefault(false), runDefault)
+		require.Equal(t, 0, len(j))
+		require.NotNil(t, errJson)
+		assert.Equal(t, true, strings.Contains(errJson.Msg(), "container is not of the correct type"))
+	})
+
+	t.Run("unsupported block version", func(t *testing.T) {
+		response := &blindedBlockResponseJson{
+			Version: "unsupported",
+		}
+		runDefault, j, errJson := serializeBlindedBlock(response)
+		require.Equal(t, apimiddleware.RunDefault(false), runDefault)
+		require.Equal(t, 0, len(j))
+		require.NotNil(t, errJson)
+		assert.Equal(t, true, strings.Contains(errJson.Msg(), "unsupported block version"))
+	})
+}
+
 func TestSerializeV2State(t *testing.T) {
 	t.Run("Phase 0", func(t *testing.T) {
 		response := &beaconStateV2ResponseJson{
@@ -121,6 +121,13 @@ type blockV2ResponseJson struct {
 	ExecutionOptimistic bool                              `json:"execution_optimistic"`
 }
 
+// blindedBlockResponseJson is used in /beacon/blinded_blocks/{block_id} API endpoint.
+type blindedBlockResponseJson struct {
+	Version             string                                 `json:"version" enum:"true"`
+	Data                *signedBlindedBeaconBlockContainerJson `json:"data"`
+	ExecutionOptimistic bool                                   `json:"execution_optimistic"`
+}
+
 // blockRootResponseJson is used in /beacon/blocks/{block_id}/root API endpoint.
 type blockRootResponseJson struct {
 	Data                *blockRootContainerJson `json:"data"`
@@ -387,6 +394,13 @@ type blindedBeaconBlockContainerJson struct {
 	BellatrixBlock *blindedBeaconBlockBellatrixJson `json:"bellatrix_block"`
 }
 
+type signedBlindedBeaconBlockContainerJson struct {
+	Phase0Block    *beaconBlockJson                 `json:"phase0_block"`
+	AltairBlock    *beaconBlockAltairJson           `json:"altair_block"`
+	BellatrixBlock *blindedBeaconBlockBellatrixJson `json:"bellatrix_block"`
+	Signature      string                           `json:"signature" hex:"true"`
+}
+
 type signedBeaconBlockAltairContainerJson struct {
 	Message   *beaconBlockAltairJson `json:"message"`
 	Signature string                 `json:"signature" hex:"true"`
@@ -903,6 +917,36 @@ type eventFinalizedCheckpointJson struct {
 	ExecutionOptimistic bool   `json:"execution_optimistic"`
 }
 
+type eventPayloadAttributesJson struct {
+	ProposalSlot          string `json:"proposal_slot"`
+	ProposerIndex         string `json:"proposer_index"`
+	ParentBlockRoot       string `json:"parent_block_root" hex:"true"`
+	ParentBlockHash       string `json:"parent_block_hash" hex:"true"`
+	Timestamp             string `json:"timestamp"`
+	PrevRandao            string `json:"prev_randao" hex:"true"`
+	SuggestedFeeRecipient string `json:"suggested_fee_recipient" hex:"true"`
+}
+
+type eventBlockGossipJson struct {
+	Slot        string `json:"slot"`
+	Block       string `json:"block" hex:"true"`
+	ArrivalTime string `json:"arrival_time"`
+}
+
+type eventSyncStatusJson struct {
+	Status   string `json:"status"`
+	HeadSlot string `json:"head_slot"`
+}
+
+type eventOptimisticToValidJson struct {
+	Block string `json:"block" hex:"true"`
+}
+
+type eventInvalidatedPayloadJson struct {
+	InvalidBlocks   []string `json:"invalid_blocks" hex:"true"`
+	LatestValidHash string   `json:"latest_valid_hash" hex:"true"`
+}
+
 type eventChainReorgJson struct {
 	Slot                string `json:"slot"`
 	Depth               string `json:"depth"`
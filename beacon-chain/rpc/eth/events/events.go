@@ -9,6 +9,7 @@ import (
 	blockfeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/block"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed/operation"
 	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
 	ethpbservice "github.com/prysmaticlabs/prysm/proto/eth/service"
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1"
 	"github.com/prysmaticlabs/prysm/proto/migration"
@@ -33,6 +34,20 @@ const (
 	ChainReorgTopic = "chain_reorg"
 	// SyncCommitteeContributionTopic represents a new sync committee contribution event topic.
 	SyncCommitteeContributionTopic = "contribution_and_proof"
+	// PayloadAttributesTopic represents a new payload attributes event topic, sent for upcoming block proposals.
+	PayloadAttributesTopic = "payload_attributes"
+	// BlockGossipTopic represents a block received over gossip, sent before the block is imported.
+	BlockGossipTopic = "block_gossip"
+	// AttesterSlashingTopic represents a new received attester slashing event topic.
+	AttesterSlashingTopic = "attester_slashing"
+	// ProposerSlashingTopic represents a new received proposer slashing event topic.
+	ProposerSlashingTopic = "proposer_slashing"
+	// SyncStatusTopic represents a sync status transition event topic.
+	SyncStatusTopic = "sync_status"
+	// OptimisticToValidTopic represents an optimistic block validated by the execution engine event topic.
+	OptimisticToValidTopic = "optimistic_to_valid"
+	// InvalidatedPayloadTopic represents blocks invalidated by the execution engine event topic.
+	InvalidatedPayloadTopic = "invalidated_payload"
 )
 
 var casesHandled = map[string]bool{
@@ -43,6 +58,13 @@ var casesHandled = map[string]bool{
 	FinalizedCheckpointTopic:       true,
 	ChainReorgTopic:                true,
 	SyncCommitteeContributionTopic: true,
+	PayloadAttributesTopic:         true,
+	BlockGossipTopic:               true,
+	AttesterSlashingTopic:          true,
+	ProposerSlashingTopic:          true,
+	SyncStatusTopic:                true,
+	OptimisticToValidTopic:         true,
+	InvalidatedPayloadTopic:        true,
 }
 
 // StreamEvents allows requesting all events from a set of topics defined in the Ethereum consensus API standard.
@@ -129,6 +151,20 @@ func handleBlockEvents(
 			ExecutionOptimistic: blkData.IsOptimistic,
 		}
 		return streamData(stream, BlockTopic, eventBlock)
+	case blockfeed.GossipBlockReceived:
+		if _, ok := requestedTopics[BlockGossipTopic]; !ok {
+			return nil
+		}
+		blkData, ok := event.Data.(*blockfeed.GossipBlockReceivedData)
+		if !ok {
+			return nil
+		}
+		eventBlock := &ethpb.EventBlockGossip{
+			Slot:        blkData.SignedBlock.Block().Slot(),
+			Block:       bytesutil.SafeCopyBytes(blkData.BlockRoot[:]),
+			ArrivalTime: uint64(blkData.ArrivalTime.UnixMilli()),
+		}
+		return streamData(stream, BlockGossipTopic, eventBlock)
 	default:
 		return nil
 	}
@@ -178,6 +214,26 @@ func handleBlockOperationEvents(
 		}
 		v2Data := migration.V1Alpha1SignedContributionAndProofToV2(contributionData.Contribution)
 		return streamData(stream, SyncCommitteeContributionTopic, v2Data)
+	case operation.AttesterSlashingReceived:
+		if _, ok := requestedTopics[AttesterSlashingTopic]; !ok {
+			return nil
+		}
+		slashingData, ok := event.Data.(*operation.AttesterSlashingReceivedData)
+		if !ok {
+			return nil
+		}
+		v1Data := migration.V1Alpha1AttSlashingToV1(slashingData.AttesterSlashing)
+		return streamData(stream, AttesterSlashingTopic, v1Data)
+	case operation.ProposerSlashingReceived:
+		if _, ok := requestedTopics[ProposerSlashingTopic]; !ok {
+			return nil
+		}
+		slashingData, ok := event.Data.(*operation.ProposerSlashingReceivedData)
+		if !ok {
+			return nil
+		}
+		v1Data := migration.V1Alpha1ProposerSlashingToV1(slashingData.ProposerSlashing)
+		return streamData(stream, ProposerSlashingTopic, v1Data)
 	default:
 		return nil
 	}
@@ -214,6 +270,42 @@ func handleStateEvents(
 			return nil
 		}
 		return streamData(stream, ChainReorgTopic, reorg)
+	case statefeed.PayloadAttributes:
+		if _, ok := requestedTopics[PayloadAttributesTopic]; !ok {
+			return nil
+		}
+		attributes, ok := event.Data.(*ethpb.EventPayloadAttributes)
+		if !ok {
+			return nil
+		}
+		return streamData(stream, PayloadAttributesTopic, attributes)
+	case statefeed.SyncStatusChanged:
+		if _, ok := requestedTopics[SyncStatusTopic]; !ok {
+			return nil
+		}
+		syncStatus, ok := event.Data.(*ethpb.EventSyncStatus)
+		if !ok {
+			return nil
+		}
+		return streamData(stream, SyncStatusTopic, syncStatus)
+	case statefeed.OptimisticToValid:
+		if _, ok := requestedTopics[OptimisticToValidTopic]; !ok {
+			return nil
+		}
+		optimisticToValid, ok := event.Data.(*ethpb.EventOptimisticToValid)
+		if !ok {
+			return nil
+		}
+		return streamData(stream, OptimisticToValidTopic, optimisticToValid)
+	case statefeed.InvalidatedPayload:
+		if _, ok := requestedTopics[InvalidatedPayloadTopic]; !ok {
+			return nil
+		}
+		invalidated, ok := event.Data.(*ethpb.EventInvalidatedPayload)
+		if !ok {
+			return nil
+		}
+		return streamData(stream, InvalidatedPayloadTopic, invalidated)
 	default:
 		return nil
 	}
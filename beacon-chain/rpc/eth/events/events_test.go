@@ -3,6 +3,7 @@ package events
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/proto/gateway"
@@ -14,6 +15,7 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed/operation"
 	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
 	"github.com/prysmaticlabs/prysm/consensus-types/wrapper"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1"
 	"github.com/prysmaticlabs/prysm/proto/migration"
 	eth "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
@@ -84,6 +86,48 @@ func TestStreamEvents_BlockEvents(t *testing.T) {
 			feed: srv.BlockNotifier.BlockFeed(),
 		})
 	})
+	t.Run(BlockGossipTopic, func(t *testing.T) {
+		ctx := context.Background()
+		srv, ctrl, mockStream := setupServer(ctx, t)
+		defer ctrl.Finish()
+
+		wantedBlock := util.HydrateSignedBeaconBlock(&eth.SignedBeaconBlock{
+			Block: &eth.BeaconBlock{
+				Slot: 8,
+			},
+		})
+		wantedBlockRoot, err := wantedBlock.Block.HashTreeRoot()
+		require.NoError(t, err)
+		arrivalTime := time.Unix(1600000000, 250*int64(time.Millisecond))
+		genericResponse, err := anypb.New(&ethpb.EventBlockGossip{
+			Slot:        8,
+			Block:       wantedBlockRoot[:],
+			ArrivalTime: 1600000000250,
+		})
+		require.NoError(t, err)
+		wantedMessage := &gateway.EventSource{
+			Event: BlockGossipTopic,
+			Data:  genericResponse,
+		}
+		wsb, err := wrapper.WrappedSignedBeaconBlock(wantedBlock)
+		require.NoError(t, err)
+		assertFeedSendAndReceive(ctx, &assertFeedArgs{
+			t:             t,
+			srv:           srv,
+			topics:        []string{BlockGossipTopic},
+			stream:        mockStream,
+			shouldReceive: wantedMessage,
+			itemToSend: &feed.Event{
+				Type: blockfeed.GossipBlockReceived,
+				Data: &blockfeed.GossipBlockReceivedData{
+					SignedBlock: wsb,
+					BlockRoot:   wantedBlockRoot,
+					ArrivalTime: arrivalTime,
+				},
+			},
+			feed: srv.BlockNotifier.BlockFeed(),
+		})
+	})
 }
 
 func TestStreamEvents_OperationsEvents(t *testing.T) {
@@ -189,6 +233,70 @@ func TestStreamEvents_OperationsEvents(t *testing.T) {
 			feed: srv.OperationNotifier.OperationFeed(),
 		})
 	})
+	t.Run(AttesterSlashingTopic, func(t *testing.T) {
+		ctx := context.Background()
+		srv, ctrl, mockStream := setupServer(ctx, t)
+		defer ctrl.Finish()
+
+		wantedSlashingV1alpha1 := &eth.AttesterSlashing{
+			Attestation_1: util.HydrateIndexedAttestation(&eth.IndexedAttestation{AttestingIndices: []uint64{1, 2}}),
+			Attestation_2: util.HydrateIndexedAttestation(&eth.IndexedAttestation{AttestingIndices: []uint64{2, 3}}),
+		}
+		genericResponse, err := anypb.New(migration.V1Alpha1AttSlashingToV1(wantedSlashingV1alpha1))
+		require.NoError(t, err)
+
+		wantedMessage := &gateway.EventSource{
+			Event: AttesterSlashingTopic,
+			Data:  genericResponse,
+		}
+
+		assertFeedSendAndReceive(ctx, &assertFeedArgs{
+			t:             t,
+			srv:           srv,
+			topics:        []string{AttesterSlashingTopic},
+			stream:        mockStream,
+			shouldReceive: wantedMessage,
+			itemToSend: &feed.Event{
+				Type: operation.AttesterSlashingReceived,
+				Data: &operation.AttesterSlashingReceivedData{
+					AttesterSlashing: wantedSlashingV1alpha1,
+				},
+			},
+			feed: srv.OperationNotifier.OperationFeed(),
+		})
+	})
+	t.Run(ProposerSlashingTopic, func(t *testing.T) {
+		ctx := context.Background()
+		srv, ctrl, mockStream := setupServer(ctx, t)
+		defer ctrl.Finish()
+
+		wantedSlashingV1alpha1 := &eth.ProposerSlashing{
+			Header_1: util.HydrateSignedBeaconHeader(&eth.SignedBeaconBlockHeader{Header: &eth.BeaconBlockHeader{Slot: 1}}),
+			Header_2: util.HydrateSignedBeaconHeader(&eth.SignedBeaconBlockHeader{Header: &eth.BeaconBlockHeader{Slot: 1, StateRoot: bytesutil.PadTo([]byte{'a'}, 32)}}),
+		}
+		genericResponse, err := anypb.New(migration.V1Alpha1ProposerSlashingToV1(wantedSlashingV1alpha1))
+		require.NoError(t, err)
+
+		wantedMessage := &gateway.EventSource{
+			Event: ProposerSlashingTopic,
+			Data:  genericResponse,
+		}
+
+		assertFeedSendAndReceive(ctx, &assertFeedArgs{
+			t:             t,
+			srv:           srv,
+			topics:        []string{ProposerSlashingTopic},
+			stream:        mockStream,
+			shouldReceive: wantedMessage,
+			itemToSend: &feed.Event{
+				Type: operation.ProposerSlashingReceived,
+				Data: &operation.ProposerSlashingReceivedData{
+					ProposerSlashing: wantedSlashingV1alpha1,
+				},
+			},
+			feed: srv.OperationNotifier.OperationFeed(),
+		})
+	})
 	t.Run(SyncCommitteeContributionTopic, func(t *testing.T) {
 		ctx := context.Background()
 		srv, ctrl, mockStream := setupServer(ctx, t)
@@ -335,6 +443,126 @@ func TestStreamEvents_StateEvents(t *testing.T) {
 			feed: srv.StateNotifier.StateFeed(),
 		})
 	})
+	t.Run(PayloadAttributesTopic, func(t *testing.T) {
+		ctx := context.Background()
+		srv, ctrl, mockStream := setupServer(ctx, t)
+		defer ctrl.Finish()
+
+		wantedAttributes := &ethpb.EventPayloadAttributes{
+			ProposalSlot:          9,
+			ProposerIndex:         3,
+			ParentBlockRoot:       make([]byte, 32),
+			ParentBlockHash:       make([]byte, 32),
+			Timestamp:             1000,
+			PrevRandao:            make([]byte, 32),
+			SuggestedFeeRecipient: make([]byte, 20),
+		}
+		genericResponse, err := anypb.New(wantedAttributes)
+		require.NoError(t, err)
+		wantedMessage := &gateway.EventSource{
+			Event: PayloadAttributesTopic,
+			Data:  genericResponse,
+		}
+
+		assertFeedSendAndReceive(ctx, &assertFeedArgs{
+			t:             t,
+			srv:           srv,
+			topics:        []string{PayloadAttributesTopic},
+			stream:        mockStream,
+			shouldReceive: wantedMessage,
+			itemToSend: &feed.Event{
+				Type: statefeed.PayloadAttributes,
+				Data: wantedAttributes,
+			},
+			feed: srv.StateNotifier.StateFeed(),
+		})
+	})
+	t.Run(SyncStatusTopic, func(t *testing.T) {
+		ctx := context.Background()
+		srv, ctrl, mockStream := setupServer(ctx, t)
+		defer ctrl.Finish()
+
+		wantedStatus := &ethpb.EventSyncStatus{
+			Status:   "optimistic",
+			HeadSlot: 8,
+		}
+		genericResponse, err := anypb.New(wantedStatus)
+		require.NoError(t, err)
+		wantedMessage := &gateway.EventSource{
+			Event: SyncStatusTopic,
+			Data:  genericResponse,
+		}
+
+		assertFeedSendAndReceive(ctx, &assertFeedArgs{
+			t:             t,
+			srv:           srv,
+			topics:        []string{SyncStatusTopic},
+			stream:        mockStream,
+			shouldReceive: wantedMessage,
+			itemToSend: &feed.Event{
+				Type: statefeed.SyncStatusChanged,
+				Data: wantedStatus,
+			},
+			feed: srv.StateNotifier.StateFeed(),
+		})
+	})
+	t.Run(OptimisticToValidTopic, func(t *testing.T) {
+		ctx := context.Background()
+		srv, ctrl, mockStream := setupServer(ctx, t)
+		defer ctrl.Finish()
+
+		wantedBlock := &ethpb.EventOptimisticToValid{
+			Block: make([]byte, 32),
+		}
+		genericResponse, err := anypb.New(wantedBlock)
+		require.NoError(t, err)
+		wantedMessage := &gateway.EventSource{
+			Event: OptimisticToValidTopic,
+			Data:  genericResponse,
+		}
+
+		assertFeedSendAndReceive(ctx, &assertFeedArgs{
+			t:             t,
+			srv:           srv,
+			topics:        []string{OptimisticToValidTopic},
+			stream:        mockStream,
+			shouldReceive: wantedMessage,
+			itemToSend: &feed.Event{
+				Type: statefeed.OptimisticToValid,
+				Data: wantedBlock,
+			},
+			feed: srv.StateNotifier.StateFeed(),
+		})
+	})
+	t.Run(InvalidatedPayloadTopic, func(t *testing.T) {
+		ctx := context.Background()
+		srv, ctrl, mockStream := setupServer(ctx, t)
+		defer ctrl.Finish()
+
+		wantedInvalidated := &ethpb.EventInvalidatedPayload{
+			InvalidBlocks:   [][]byte{make([]byte, 32), make([]byte, 32)},
+			LatestValidHash: make([]byte, 32),
+		}
+		genericResponse, err := anypb.New(wantedInvalidated)
+		require.NoError(t, err)
+		wantedMessage := &gateway.EventSource{
+			Event: InvalidatedPayloadTopic,
+			Data:  genericResponse,
+		}
+
+		assertFeedSendAndReceive(ctx, &assertFeedArgs{
+			t:             t,
+			srv:           srv,
+			topics:        []string{InvalidatedPayloadTopic},
+			stream:        mockStream,
+			shouldReceive: wantedMessage,
+			itemToSend: &feed.Event{
+				Type: statefeed.InvalidatedPayload,
+				Data: wantedInvalidated,
+			},
+			feed: srv.StateNotifier.StateFeed(),
+		})
+	})
 }
 
 func TestStreamEvents_CommaSeparatedTopics(t *testing.T) {
@@ -0,0 +1,374 @@
+package httpapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// decoder fills protobuf messages from JSON in the format of the Ethereum Beacon API, the inverse of
+// encoder. Fork-specific oneof members are chosen by the consensus version of the request when it is
+// known, and otherwise by trying each member in declaration order until one of them decodes strictly.
+type decoder struct {
+	version string
+	strict  bool
+}
+
+// DecodeJSON decodes data in the format of the Ethereum Beacon API into m, choosing fork-specific
+// variants by the consensus version when it is not empty.
+func DecodeJSON(data []byte, m proto.Message, version string) error {
+	return decodeJSON(data, m.ProtoReflect(), version)
+}
+
+// decodeJSON decodes data into m. A top-level JSON array is decoded into the only repeated field of m,
+// which is how the API represents request bodies such as lists of attestations.
+func decodeJSON(data []byte, m protoreflect.Message, version string) error {
+	d := json.NewDecoder(bytes.NewReader(data))
+	d.UseNumber()
+	var v interface{}
+	if err := d.Decode(&v); err != nil {
+		return errors.Wrap(err, "could not decode JSON")
+	}
+	dec := decoder{version: strings.ToLower(version)}
+	if arr, ok := v.([]interface{}); ok && !unwrappedMessages[m.Descriptor().FullName()] {
+		fd := soleListField(m.Descriptor())
+		if fd == nil {
+			return fmt.Errorf("cannot decode JSON array into %s", m.Descriptor().FullName())
+		}
+		return dec.list(arr, fd, m.Mutable(fd).List())
+	}
+	return dec.message(v, m)
+}
+
+func (d decoder) message(v interface{}, m protoreflect.Message) error {
+	if v == nil {
+		return nil
+	}
+	md := m.Descriptor()
+	if md.FullName() == timestampName {
+		seconds, err := parseInt(v, 64)
+		if err != nil {
+			return err
+		}
+		m.Set(md.Fields().ByName("seconds"), protoreflect.ValueOfInt64(seconds))
+		return nil
+	}
+	if unwrappedMessages[md.FullName()] {
+		arr, ok := v.([]interface{})
+		if !ok {
+			return fmt.Errorf("expected array for %s", md.FullName())
+		}
+		fd := md.Fields().Get(0)
+		return d.list(arr, fd, m.Mutable(fd).List())
+	}
+	if oneof := containerOneof(md); oneof != nil {
+		return d.oneof(v, m, oneof)
+	}
+
+	obj, ok := v.(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("expected object for %s", md.FullName())
+	}
+	for key, val := range obj {
+		if fd := md.Fields().ByName(protoreflect.Name(key)); fd != nil && !isOneofMember(fd) {
+			if err := d.field(val, m, fd); err != nil {
+				return errors.Wrapf(err, "invalid value for %s", key)
+			}
+			continue
+		}
+		if oneof := md.Oneofs().ByName(protoreflect.Name(key)); oneof != nil && !oneof.IsSynthetic() {
+			if err := d.oneof(val, m, oneof); err != nil {
+				return errors.Wrapf(err, "invalid value for %s", key)
+			}
+			continue
+		}
+		if d.strict {
+			return fmt.Errorf("unknown field %s in %s", key, md.FullName())
+		}
+	}
+	return nil
+}
+
+// oneof decodes v into the member of the oneof matching the consensus version, or into the first member
+// which v strictly decodes into when the version is not known.
+func (d decoder) oneof(v interface{}, m protoreflect.Message, oneof protoreflect.OneofDescriptor) error {
+	if v == nil {
+		return nil
+	}
+	fields := oneof.Fields()
+	if d.version != "" {
+		for i := 0; i < fields.Len(); i++ {
+			fd := fields.Get(i)
+			if strings.HasPrefix(string(fd.Name()), d.version+"_") {
+				return d.field(v, m, fd)
+			}
+		}
+		return fmt.Errorf("no %s variant for version %s", oneof.Name(), d.version)
+	}
+	strict := decoder{strict: true}
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		candidate := m.NewField(fd)
+		if fd.Message() != nil {
+			if err := strict.message(v, candidate.Message()); err != nil {
+				continue
+			}
+		} else {
+			val, err := scalar(v, fd)
+			if err != nil {
+				continue
+			}
+			candidate = val
+		}
+		m.Set(fd, candidate)
+		return nil
+	}
+	return fmt.Errorf("value does not match any %s variant", oneof.Name())
+}
+
+func (d decoder) field(v interface{}, m protoreflect.Message, fd protoreflect.FieldDescriptor) error {
+	switch {
+	case fd.IsList():
+		if v == nil {
+			return nil
+		}
+		arr, ok := v.([]interface{})
+		if !ok {
+			return errors.New("expected array")
+		}
+		return d.list(arr, fd, m.Mutable(fd).List())
+	case fd.IsMap():
+		if v == nil {
+			return nil
+		}
+		obj, ok := v.(map[string]interface{})
+		if !ok {
+			return errors.New("expected object")
+		}
+		mp := m.Mutable(fd).Map()
+		for key, val := range obj {
+			k, err := scalar(key, fd.MapKey())
+			if err != nil {
+				return err
+			}
+			if fd.MapValue().Message() != nil {
+				elem := mp.NewValue()
+				if err := d.message(val, elem.Message()); err != nil {
+					return err
+				}
+				mp.Set(k.MapKey(), elem)
+				continue
+			}
+			elem, err := scalar(val, fd.MapValue())
+			if err != nil {
+				return err
+			}
+			mp.Set(k.MapKey(), elem)
+		}
+		return nil
+	case fd.Message() != nil:
+		if v == nil {
+			return nil
+		}
+		return d.message(v, m.Mutable(fd).Message())
+	default:
+		val, err := scalar(v, fd)
+		if err != nil {
+			return err
+		}
+		m.Set(fd, val)
+		return nil
+	}
+}
+
+func (d decoder) list(arr []interface{}, fd protoreflect.FieldDescriptor, l protoreflect.List) error {
+	for i, v := range arr {
+		if fd.Message() != nil {
+			elem := l.NewElement()
+			if err := d.message(v, elem.Message()); err != nil {
+				return errors.Wrapf(err, "invalid element %d", i)
+			}
+			l.Append(elem)
+			continue
+		}
+		elem, err := scalar(v, fd)
+		if err != nil {
+			return errors.Wrapf(err, "invalid element %d", i)
+		}
+		l.Append(elem)
+	}
+	return nil
+}
+
+// scalar converts a JSON value into the value of a non-message field.
+func scalar(v interface{}, fd protoreflect.FieldDescriptor) (protoreflect.Value, error) {
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		switch b := v.(type) {
+		case bool:
+			return protoreflect.ValueOfBool(b), nil
+		case string:
+			parsed, err := strconv.ParseBool(b)
+			if err != nil {
+				return protoreflect.Value{}, err
+			}
+			return protoreflect.ValueOfBool(parsed), nil
+		}
+		return protoreflect.Value{}, errors.New("expected boolean")
+	case protoreflect.StringKind:
+		s, ok := v.(string)
+		if !ok {
+			return protoreflect.Value{}, errors.New("expected string")
+		}
+		return protoreflect.ValueOfString(s), nil
+	case protoreflect.BytesKind:
+		s, ok := v.(string)
+		if !ok {
+			return protoreflect.Value{}, errors.New("expected string")
+		}
+		if uint256Fields[fd.Name()] {
+			b, err := uint256Bytes(s)
+			if err != nil {
+				return protoreflect.Value{}, err
+			}
+			return protoreflect.ValueOfBytes(b), nil
+		}
+		b, err := hexutil.Decode(s)
+		if err != nil {
+			return protoreflect.Value{}, errors.Wrap(err, "invalid hex string")
+		}
+		return protoreflect.ValueOfBytes(b), nil
+	case protoreflect.EnumKind:
+		switch e := v.(type) {
+		case string:
+			if ev := fd.Enum().Values().ByName(protoreflect.Name(strings.ToUpper(e))); ev != nil {
+				return protoreflect.ValueOfEnum(ev.Number()), nil
+			}
+			n, err := strconv.ParseInt(e, 10, 32)
+			if err != nil {
+				return protoreflect.Value{}, fmt.Errorf("invalid %s value %q", fd.Enum().Name(), e)
+			}
+			return protoreflect.ValueOfEnum(protoreflect.EnumNumber(n)), nil
+		case json.Number:
+			n, err := strconv.ParseInt(e.String(), 10, 32)
+			if err != nil {
+				return protoreflect.Value{}, err
+			}
+			return protoreflect.ValueOfEnum(protoreflect.EnumNumber(n)), nil
+		}
+		return protoreflect.Value{}, errors.New("expected string")
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		n, err := parseInt(v, 32)
+		if err != nil {
+			return protoreflect.Value{}, err
+		}
+		return protoreflect.ValueOfInt32(int32(n)), nil
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		n, err := parseInt(v, 64)
+		if err != nil {
+			return protoreflect.Value{}, err
+		}
+		return protoreflect.ValueOfInt64(n), nil
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		n, err := parseUint(v, 32)
+		if err != nil {
+			return protoreflect.Value{}, err
+		}
+		return protoreflect.ValueOfUint32(uint32(n)), nil
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		n, err := parseUint(v, 64)
+		if err != nil {
+			return protoreflect.Value{}, err
+		}
+		return protoreflect.ValueOfUint64(n), nil
+	case protoreflect.FloatKind, protoreflect.DoubleKind:
+		n, err := strconv.ParseFloat(numberString(v), 64)
+		if err != nil {
+			return protoreflect.Value{}, err
+		}
+		if fd.Kind() == protoreflect.FloatKind {
+			return protoreflect.ValueOfFloat32(float32(n)), nil
+		}
+		return protoreflect.ValueOfFloat64(n), nil
+	default:
+		return protoreflect.Value{}, fmt.Errorf("unsupported kind %s", fd.Kind())
+	}
+}
+
+// param converts a path or query parameter into the value of a non-message field. Unlike JSON values,
+// bytes parameters which are not 0x-prefixed hex are taken verbatim, e.g. a state ID of "head".
+func param(s string, fd protoreflect.FieldDescriptor) (protoreflect.Value, error) {
+	if fd.Kind() == protoreflect.BytesKind && !uint256Fields[fd.Name()] {
+		if b, err := hexutil.Decode(s); err == nil {
+			return protoreflect.ValueOfBytes(b), nil
+		}
+		return protoreflect.ValueOfBytes([]byte(s)), nil
+	}
+	return scalar(s, fd)
+}
+
+func parseInt(v interface{}, bitSize int) (int64, error) {
+	s := numberString(v)
+	if s == "" {
+		return 0, errors.New("expected integer")
+	}
+	return strconv.ParseInt(s, 10, bitSize)
+}
+
+func parseUint(v interface{}, bitSize int) (uint64, error) {
+	s := numberString(v)
+	if s == "" {
+		return 0, errors.New("expected integer")
+	}
+	return strconv.ParseUint(s, 10, bitSize)
+}
+
+func numberString(v interface{}) string {
+	switch n := v.(type) {
+	case string:
+		return n
+	case json.Number:
+		return n.String()
+	}
+	return ""
+}
+
+func uint256Bytes(s string) ([]byte, error) {
+	n, ok := new(big.Int).SetString(s, 10)
+	if !ok || n.Sign() < 0 || n.BitLen() > 256 {
+		return nil, fmt.Errorf("invalid uint256 value %q", s)
+	}
+	bigEndian := n.FillBytes(make([]byte, 32))
+	littleEndian := make([]byte, 32)
+	for i := range bigEndian {
+		littleEndian[i] = bigEndian[31-i]
+	}
+	return littleEndian, nil
+}
+
+func isOneofMember(fd protoreflect.FieldDescriptor) bool {
+	oneof := fd.ContainingOneof()
+	return oneof != nil && !oneof.IsSynthetic()
+}
+
+// soleListField returns the only repeated field of a message, or nil if there is not exactly one.
+func soleListField(md protoreflect.MessageDescriptor) protoreflect.FieldDescriptor {
+	var found protoreflect.FieldDescriptor
+	for i := 0; i < md.Fields().Len(); i++ {
+		if fd := md.Fields().Get(i); fd.IsList() {
+			if found != nil {
+				return nil
+			}
+			found = fd
+		}
+	}
+	return found
+}
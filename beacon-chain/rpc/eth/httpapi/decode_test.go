@@ -0,0 +1,94 @@
+package httpapi
+
+import (
+	"testing"
+
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	ethpbv1 "github.com/prysmaticlabs/prysm/proto/eth/v1"
+	ethpbv2 "github.com/prysmaticlabs/prysm/proto/eth/v2"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func altairBlockContainer() *ethpbv2.SignedBeaconBlockContainerV2 {
+	return &ethpbv2.SignedBeaconBlockContainerV2{
+		Message: &ethpbv2.SignedBeaconBlockContainerV2_AltairBlock{AltairBlock: &ethpbv2.BeaconBlockAltair{
+			Slot:          5,
+			ProposerIndex: 3,
+			ParentRoot:    []byte{0xab},
+			Body: &ethpbv2.BeaconBlockBodyAltair{
+				RandaoReveal:  []byte{0x01},
+				Eth1Data:      &ethpbv1.Eth1Data{DepositCount: 2},
+				SyncAggregate: &ethpbv1.SyncAggregate{SyncCommitteeBits: []byte{0xff}},
+			},
+		}},
+		Signature: []byte{0x01, 0x02},
+	}
+}
+
+func TestDecode_RoundTrip(t *testing.T) {
+	want := altairBlockContainer()
+	got := &ethpbv2.SignedBeaconBlockContainerV2{}
+	require.NoError(t, decodeJSON([]byte(encode(t, want)), got.ProtoReflect(), ""))
+	assert.DeepSSZEqual(t, want, got)
+}
+
+func TestDecode_OneofByVersion(t *testing.T) {
+	body := `{"message":{"slot":"5","body":{"randao_reveal":"0x01"}},"signature":"0x0102"}`
+
+	got := &ethpbv2.SignedBeaconBlockContainerV2{}
+	require.NoError(t, decodeJSON([]byte(body), got.ProtoReflect(), ""))
+	require.NotNil(t, got.GetPhase0Block(), "phase0 block should be the first variant to match")
+
+	got = &ethpbv2.SignedBeaconBlockContainerV2{}
+	require.NoError(t, decodeJSON([]byte(body), got.ProtoReflect(), "Bellatrix"))
+	require.NotNil(t, got.GetBellatrixBlock())
+	assert.Equal(t, types.Slot(5), got.GetBellatrixBlock().Slot)
+
+	err := decodeJSON([]byte(body), got.ProtoReflect(), "capella")
+	assert.ErrorContains(t, "no message variant for version capella", err)
+}
+
+func TestDecode_TopLevelArray(t *testing.T) {
+	req := &ethpbv1.AttesterDutiesRequest{Epoch: 1}
+	require.NoError(t, decodeJSON([]byte(`["1","2",3]`), req.ProtoReflect(), ""))
+	assert.DeepEqual(t, []types.ValidatorIndex{1, 2, 3}, req.Index)
+	assert.Equal(t, types.Epoch(1), req.Epoch)
+
+	err := decodeJSON([]byte(`[]`), (&ethpbv1.StateRequest{}).ProtoReflect(), "")
+	assert.ErrorContains(t, "cannot decode JSON array", err)
+}
+
+func TestDecode_InvalidValues(t *testing.T) {
+	tests := []struct {
+		name    string
+		body    string
+		msg     proto.Message
+		wantErr string
+	}{
+		{name: "bad hex", body: `{"root":"0xzz"}`, msg: &ethpbv1.StateRootResponse_StateRoot{}, wantErr: "invalid hex string"},
+		{name: "bad integer", body: `{"slot":"five"}`, msg: &ethpbv1.BeaconBlock{}, wantErr: "invalid value for slot"},
+		{name: "object instead of array", body: `{"index":{}}`, msg: &ethpbv1.AttesterDutiesRequest{}, wantErr: "expected array"},
+		{name: "not JSON", body: `{`, msg: &ethpbv1.BeaconBlock{}, wantErr: "could not decode JSON"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.ErrorContains(t, tt.wantErr, decodeJSON([]byte(tt.body), tt.msg.ProtoReflect(), ""))
+		})
+	}
+}
+
+func TestParam(t *testing.T) {
+	req := &ethpbv1.StateValidatorsRequest{}
+	m := req.ProtoReflect()
+	fields := m.Descriptor().Fields()
+	require.NoError(t, setParam(m, fields.ByName("state_id"), []string{"head"}))
+	require.NoError(t, setParam(m, fields.ByName("id"), []string{"0x0102,7", "8"}))
+	require.NoError(t, setParam(m, fields.ByName("status"), []string{"active_ongoing"}))
+	assert.DeepEqual(t, []byte("head"), req.StateId)
+	assert.DeepEqual(t, [][]byte{{0x01, 0x02}, []byte("7"), []byte("8")}, req.Id)
+	assert.DeepEqual(t, []ethpbv1.ValidatorStatus{ethpbv1.ValidatorStatus_ACTIVE_ONGOING}, req.Status)
+
+	assert.ErrorContains(t, "invalid ValidatorStatus value", setParam(m, fields.ByName("status"), []string{"bogus"}))
+}
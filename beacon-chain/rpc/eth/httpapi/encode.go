@@ -0,0 +1,241 @@
+package httpapi
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const timestampName = protoreflect.FullName("google.protobuf.Timestamp")
+
+// uint256Fields are bytes fields holding little-endian uint256 values, which the API represents as
+// decimal strings.
+var uint256Fields = map[protoreflect.Name]bool{
+	"base_fee_per_gas": true,
+}
+
+// unwrappedMessages are messages with a single repeated field which the API represents as a plain array,
+// e.g. the validator aggregates of sync committees.
+var unwrappedMessages = map[protoreflect.FullName]bool{
+	"ethereum.eth.v2.SyncSubcommitteeValidators": true,
+}
+
+// encoder writes protobuf messages as JSON in the format of the Ethereum Beacon API: integers as decimal
+// strings, bytes as 0x-prefixed hex, enums as lowercase names and fork-specific oneof members under the
+// name of their oneof. Values are written as they are visited, so large messages such as beacon states
+// are streamed without building an intermediate representation.
+type encoder struct {
+	w *bufio.Writer
+}
+
+// EncodeJSON encodes m in the format of the Ethereum Beacon API, the inverse of DecodeJSON.
+func EncodeJSON(m proto.Message) ([]byte, error) {
+	var b bytes.Buffer
+	w := bufio.NewWriter(&b)
+	if err := (&encoder{w: w}).message(m.ProtoReflect()); err != nil {
+		return nil, err
+	}
+	if err := w.Flush(); err != nil {
+		return nil, err
+	}
+	return b.Bytes(), nil
+}
+
+func (e *encoder) message(m protoreflect.Message) error {
+	md := m.Descriptor()
+	if md.FullName() == timestampName {
+		seconds := m.Get(md.Fields().ByName("seconds")).Int()
+		return e.quoted(strconv.FormatInt(seconds, 10))
+	}
+	if unwrappedMessages[md.FullName()] {
+		fd := md.Fields().Get(0)
+		return e.list(fd, m.Get(fd).List())
+	}
+	if oneof := containerOneof(md); oneof != nil {
+		fd := m.WhichOneof(oneof)
+		if fd == nil {
+			return e.raw("null")
+		}
+		return e.message(m.Get(fd).Message())
+	}
+
+	if err := e.w.WriteByte('{'); err != nil {
+		return err
+	}
+	first := true
+	fields := md.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		name := fd.Name()
+		if oneof := fd.ContainingOneof(); oneof != nil {
+			if !m.Has(fd) {
+				continue
+			}
+			if !oneof.IsSynthetic() {
+				name = oneof.Name()
+			}
+		}
+		if !first {
+			if err := e.w.WriteByte(','); err != nil {
+				return err
+			}
+		}
+		first = false
+		if err := e.quoted(string(name)); err != nil {
+			return err
+		}
+		if err := e.w.WriteByte(':'); err != nil {
+			return err
+		}
+		if err := e.field(m, fd); err != nil {
+			return err
+		}
+	}
+	return e.w.WriteByte('}')
+}
+
+func (e *encoder) field(m protoreflect.Message, fd protoreflect.FieldDescriptor) error {
+	switch {
+	case fd.IsList():
+		return e.list(fd, m.Get(fd).List())
+	case fd.IsMap():
+		return e.mapValue(fd, m.Get(fd).Map())
+	case fd.Message() != nil:
+		if !m.Has(fd) {
+			return e.raw("null")
+		}
+		return e.message(m.Get(fd).Message())
+	default:
+		return e.scalar(fd, m.Get(fd))
+	}
+}
+
+func (e *encoder) list(fd protoreflect.FieldDescriptor, l protoreflect.List) error {
+	if err := e.w.WriteByte('['); err != nil {
+		return err
+	}
+	for i := 0; i < l.Len(); i++ {
+		if i > 0 {
+			if err := e.w.WriteByte(','); err != nil {
+				return err
+			}
+		}
+		var err error
+		if fd.Message() != nil {
+			err = e.message(l.Get(i).Message())
+		} else {
+			err = e.scalar(fd, l.Get(i))
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return e.w.WriteByte(']')
+}
+
+func (e *encoder) mapValue(fd protoreflect.FieldDescriptor, mp protoreflect.Map) error {
+	keys := make([]protoreflect.MapKey, 0, mp.Len())
+	mp.Range(func(k protoreflect.MapKey, _ protoreflect.Value) bool {
+		keys = append(keys, k)
+		return true
+	})
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].String() < keys[j].String()
+	})
+	if err := e.w.WriteByte('{'); err != nil {
+		return err
+	}
+	for i, k := range keys {
+		if i > 0 {
+			if err := e.w.WriteByte(','); err != nil {
+				return err
+			}
+		}
+		if err := e.quoted(k.String()); err != nil {
+			return err
+		}
+		if err := e.w.WriteByte(':'); err != nil {
+			return err
+		}
+		v := mp.Get(k)
+		var err error
+		if fd.MapValue().Message() != nil {
+			err = e.message(v.Message())
+		} else {
+			err = e.scalar(fd.MapValue(), v)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return e.w.WriteByte('}')
+}
+
+func (e *encoder) scalar(fd protoreflect.FieldDescriptor, v protoreflect.Value) error {
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		return e.raw(strconv.FormatBool(v.Bool()))
+	case protoreflect.StringKind:
+		return e.quoted(v.String())
+	case protoreflect.BytesKind:
+		if uint256Fields[fd.Name()] {
+			return e.quoted(uint256String(v.Bytes()))
+		}
+		return e.quoted(hexutil.Encode(v.Bytes()))
+	case protoreflect.EnumKind:
+		if ev := fd.Enum().Values().ByNumber(v.Enum()); ev != nil {
+			return e.quoted(strings.ToLower(string(ev.Name())))
+		}
+		return e.quoted(strconv.Itoa(int(v.Enum())))
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind,
+		protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return e.quoted(strconv.FormatInt(v.Int(), 10))
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind, protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return e.quoted(strconv.FormatUint(v.Uint(), 10))
+	case protoreflect.FloatKind, protoreflect.DoubleKind:
+		return e.raw(strconv.FormatFloat(v.Float(), 'g', -1, 64))
+	default:
+		return fmt.Errorf("unsupported kind %s of field %s", fd.Kind(), fd.FullName())
+	}
+}
+
+func (e *encoder) quoted(s string) error {
+	b, err := json.Marshal(s)
+	if err != nil {
+		return err
+	}
+	_, err = e.w.Write(b)
+	return err
+}
+
+func (e *encoder) raw(s string) error {
+	_, err := e.w.WriteString(s)
+	return err
+}
+
+// containerOneof returns the oneof of messages whose fields all belong to a single oneof, such as
+// fork-specific block containers. The API represents such messages as the value of their set member.
+func containerOneof(md protoreflect.MessageDescriptor) protoreflect.OneofDescriptor {
+	oneofs := md.Oneofs()
+	if oneofs.Len() != 1 || oneofs.Get(0).IsSynthetic() || oneofs.Get(0).Fields().Len() != md.Fields().Len() {
+		return nil
+	}
+	return oneofs.Get(0)
+}
+
+func uint256String(littleEndian []byte) string {
+	bigEndian := make([]byte, len(littleEndian))
+	for i := range littleEndian {
+		bigEndian[i] = littleEndian[len(littleEndian)-1-i]
+	}
+	return new(big.Int).SetBytes(bigEndian).String()
+}
@@ -0,0 +1,98 @@
+package httpapi
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	ethpbv1 "github.com/prysmaticlabs/prysm/proto/eth/v1"
+	ethpbv2 "github.com/prysmaticlabs/prysm/proto/eth/v2"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func encode(t *testing.T, m proto.Message) string {
+	var b bytes.Buffer
+	w := bufio.NewWriter(&b)
+	require.NoError(t, (&encoder{w: w}).message(m.ProtoReflect()))
+	require.NoError(t, w.Flush())
+	require.Equal(t, true, json.Valid(b.Bytes()), "invalid JSON: %s", b.String())
+	return b.String()
+}
+
+func TestEncode_VersionedBlock(t *testing.T) {
+	resp := &ethpbv2.BlockResponseV2{
+		Version: ethpbv2.Version_ALTAIR,
+		Data: &ethpbv2.SignedBeaconBlockContainerV2{
+			Message: &ethpbv2.SignedBeaconBlockContainerV2_AltairBlock{AltairBlock: &ethpbv2.BeaconBlockAltair{
+				Slot:          5,
+				ProposerIndex: 3,
+				ParentRoot:    []byte{0xab},
+			}},
+			Signature: []byte{0x01, 0x02},
+		},
+	}
+	assert.Equal(t,
+		`{"version":"altair","data":{"message":{"slot":"5","proposer_index":"3","parent_root":"0xab","state_root":"0x","body":null},"signature":"0x0102"},"execution_optimistic":false}`,
+		encode(t, resp),
+	)
+}
+
+func TestEncode_SyncCommitteeAggregates(t *testing.T) {
+	resp := &ethpbv2.StateSyncCommitteesResponse{
+		Data: &ethpbv2.SyncCommitteeValidators{
+			Validators: []types.ValidatorIndex{1, 2, 3},
+			ValidatorAggregates: []*ethpbv2.SyncSubcommitteeValidators{
+				{Validators: []types.ValidatorIndex{1, 2}},
+				{Validators: []types.ValidatorIndex{3}},
+			},
+		},
+	}
+	assert.Equal(t,
+		`{"data":{"validators":["1","2","3"],"validator_aggregates":[["1","2"],["3"]]},"execution_optimistic":false}`,
+		encode(t, resp),
+	)
+}
+
+func TestEncode_Timestamp(t *testing.T) {
+	resp := &ethpbv1.GenesisResponse{
+		Data: &ethpbv1.GenesisResponse_Genesis{
+			GenesisTime:        &timestamppb.Timestamp{Seconds: 1606824023},
+			GenesisForkVersion: []byte{0, 0, 0, 0},
+		},
+	}
+	assert.Equal(t,
+		`{"data":{"genesis_time":"1606824023","genesis_validators_root":"0x","genesis_fork_version":"0x00000000"}}`,
+		encode(t, resp),
+	)
+}
+
+func TestEncode_EnumsAndMaps(t *testing.T) {
+	assert.Equal(t,
+		`{"data":{"index":"7","balance":"32","status":"active_ongoing","validator":null},"execution_optimistic":false}`,
+		encode(t, &ethpbv1.StateValidatorResponse{Data: &ethpbv1.ValidatorContainer{
+			Index:   7,
+			Balance: 32,
+			Status:  ethpbv1.ValidatorStatus_ACTIVE_ONGOING,
+		}}),
+	)
+	assert.Equal(t,
+		`{"data":{"A":"1","B":"2"}}`,
+		encode(t, &ethpbv1.SpecResponse{Data: map[string]string{"B": "2", "A": "1"}}),
+	)
+}
+
+func TestUint256(t *testing.T) {
+	b, err := uint256Bytes("1000000007")
+	require.NoError(t, err)
+	assert.Equal(t, 32, len(b))
+	assert.Equal(t, byte(0x07), b[0])
+	assert.Equal(t, "1000000007", uint256String(b))
+
+	_, err = uint256Bytes("-1")
+	assert.ErrorContains(t, "invalid uint256 value", err)
+}
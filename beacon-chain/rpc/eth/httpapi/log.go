@@ -0,0 +1,5 @@
+package httpapi
+
+import "github.com/sirupsen/logrus"
+
+var log = logrus.WithField("prefix", "httpapi")
@@ -0,0 +1,448 @@
+// Package httpapi serves the Ethereum Beacon API over plain HTTP by calling the implementations of the
+// Ethereum API gRPC services directly, without going through grpc-gateway and the API middleware.
+// Routes, path parameters and request bodies are taken from the google.api.http annotations of the
+// services, so every annotated method is served under the same path as before, minus the internal prefix.
+package httpapi
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/gorilla/mux"
+	gwruntime "github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/pkg/errors"
+	grpcutil "github.com/prysmaticlabs/prysm/api/grpc"
+	ethpbservice "github.com/prysmaticlabs/prysm/proto/eth/service"
+	"google.golang.org/genproto/googleapis/api/annotations"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+const (
+	versionHeader = "Eth-Consensus-Version"
+	internalPath  = "/internal"
+	sszPathSuffix = "/ssz"
+	jsonMediaType = "application/json"
+	sszMediaType  = "application/octet-stream"
+)
+
+// Descriptors of the Ethereum API services which can be served by Server.
+var (
+	BeaconChainService = ethpbservice.File_proto_eth_service_beacon_chain_service_proto.Services().ByName("BeaconChain")
+	BeaconDebugService = ethpbservice.File_proto_eth_service_beacon_debug_service_proto.Services().ByName("BeaconDebug")
+	NodeService        = ethpbservice.File_proto_eth_service_node_service_proto.Services().ByName("BeaconNode")
+	ValidatorService   = ethpbservice.File_proto_eth_service_validator_service_proto.Services().ByName("BeaconValidator")
+)
+
+// Server serves the HTTP-annotated methods of Ethereum API gRPC services. Routes are registered up front,
+// while the implementations of the services are set once they are constructed; until then, requests to
+// a service's routes are answered with 503 Service Unavailable.
+type Server struct {
+	lock     sync.RWMutex
+	services map[protoreflect.FullName]interface{}
+}
+
+// NewServer creates a server without any service implementations.
+func NewServer() *Server {
+	return &Server{services: make(map[protoreflect.FullName]interface{})}
+}
+
+// SetService sets the implementation of the service with the given descriptor. The implementation
+// must be a value of the service's generated server interface, e.g. ethpbservice.BeaconChainServer.
+func (s *Server) SetService(sd protoreflect.ServiceDescriptor, impl interface{}) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.services[sd.FullName()] = impl
+}
+
+func (s *Server) service(name protoreflect.FullName) interface{} {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.services[name]
+}
+
+// method is a unary service method exposed under an HTTP route.
+type method struct {
+	service protoreflect.FullName
+	desc    protoreflect.MethodDescriptor
+	request protoreflect.MessageType
+	body    string
+}
+
+// route is an HTTP route with an optional SSZ variant, served from the same path when the client
+// negotiates SSZ through the Accept or Content-Type header.
+type route struct {
+	path string
+	verb string
+	json *method
+	ssz  *method
+}
+
+// RegisterRoutes adds routes for the HTTP-annotated unary methods of the given services to the router.
+// Methods annotated with an /ssz path are served as the SSZ variant of the route without the suffix.
+func (s *Server) RegisterRoutes(r *mux.Router, services ...protoreflect.ServiceDescriptor) error {
+	for _, sd := range services {
+		routes, err := serviceRoutes(sd)
+		if err != nil {
+			return errors.Wrapf(err, "could not read routes of %s", sd.FullName())
+		}
+		for _, rt := range routes {
+			r.HandleFunc(rt.path, s.handler(rt)).Methods(rt.verb)
+		}
+	}
+	return nil
+}
+
+func serviceRoutes(sd protoreflect.ServiceDescriptor) ([]*route, error) {
+	var routes []*route
+	byKey := make(map[string]*route)
+	methods := sd.Methods()
+	for i := 0; i < methods.Len(); i++ {
+		md := methods.Get(i)
+		if md.IsStreamingClient() || md.IsStreamingServer() {
+			continue
+		}
+		rule, ok := proto.GetExtension(md.Options(), annotations.E_Http).(*annotations.HttpRule)
+		if !ok || rule == nil {
+			continue
+		}
+		var verb, path string
+		switch {
+		case rule.GetGet() != "":
+			verb, path = http.MethodGet, rule.GetGet()
+		case rule.GetPost() != "":
+			verb, path = http.MethodPost, rule.GetPost()
+		default:
+			continue
+		}
+		mt, err := protoregistry.GlobalTypes.FindMessageByName(md.Input().FullName())
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not find request type of %s", md.FullName())
+		}
+		m := &method{service: sd.FullName(), desc: md, request: mt, body: rule.GetBody()}
+
+		path = strings.TrimPrefix(path, internalPath)
+		isSSZ := strings.HasSuffix(path, sszPathSuffix)
+		path = strings.TrimSuffix(path, sszPathSuffix)
+		key := verb + " " + path
+		rt, ok := byKey[key]
+		if !ok {
+			rt = &route{path: path, verb: verb}
+			byKey[key] = rt
+			routes = append(routes, rt)
+		}
+		if isSSZ {
+			rt.ssz = m
+		} else {
+			rt.json = m
+		}
+	}
+	return routes, nil
+}
+
+func (s *Server) handler(rt *route) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		m := rt.json
+		if rt.ssz != nil && (m == nil || (rt.verb == http.MethodGet && sszRequested(r)) || (rt.verb == http.MethodPost && sszPosted(r))) {
+			m = rt.ssz
+		}
+		if m == nil {
+			writeError(w, http.StatusNotAcceptable, "Only SSZ is supported by this endpoint", nil)
+			return
+		}
+		impl := s.service(m.service)
+		if impl == nil {
+			writeError(w, http.StatusServiceUnavailable, fmt.Sprintf("Service %s is not ready", m.service.Name()), nil)
+			return
+		}
+		call := reflect.ValueOf(impl).MethodByName(string(m.desc.Name()))
+		if !call.IsValid() {
+			writeError(w, http.StatusNotImplemented, fmt.Sprintf("Method %s is not implemented", m.desc.Name()), nil)
+			return
+		}
+
+		req := m.request.New()
+		if err := bindRequest(r, req, m, m == rt.ssz); err != nil {
+			writeError(w, http.StatusBadRequest, err.Error(), nil)
+			return
+		}
+
+		stream := &headerStream{method: fmt.Sprintf("/%s/%s", m.service, m.desc.Name())}
+		ctx := metadata.NewIncomingContext(r.Context(), incomingMetadata(r.Header))
+		ctx = grpc.NewContextWithServerTransportStream(ctx, stream)
+		results := call.Call([]reflect.Value{reflect.ValueOf(ctx), reflect.ValueOf(req.Interface())})
+		if err, ok := results[1].Interface().(error); ok && err != nil {
+			st := status.Convert(err)
+			writeError(w, gwruntime.HTTPStatusFromCode(st.Code()), st.Message(), stream.header)
+			return
+		}
+
+		code := http.StatusOK
+		if c := stream.header.Get(grpcutil.HttpCodeMetadataKey); len(c) > 0 {
+			parsed, err := strconv.Atoi(c[0])
+			if err != nil {
+				writeError(w, http.StatusInternalServerError, "Could not parse status code", nil)
+				return
+			}
+			code = parsed
+		}
+		resp, ok := results[0].Interface().(proto.Message)
+		if !ok || reflect.ValueOf(resp).IsNil() || resp.ProtoReflect().Descriptor().Fields().Len() == 0 {
+			w.WriteHeader(code)
+			return
+		}
+		if m == rt.ssz {
+			writeSSZ(w, code, resp.ProtoReflect())
+			return
+		}
+		writeJSON(w, code, resp.ProtoReflect())
+	}
+}
+
+// bindRequest fills the request message from the path and query parameters and the body of the HTTP request.
+func bindRequest(r *http.Request, req protoreflect.Message, m *method, isSSZ bool) error {
+	fields := req.Descriptor().Fields()
+	bound := make(map[protoreflect.Name]bool)
+	for name, value := range mux.Vars(r) {
+		fd := fields.ByName(protoreflect.Name(name))
+		if fd == nil {
+			continue
+		}
+		if err := setParam(req, fd, []string{value}); err != nil {
+			return errors.Wrapf(err, "invalid path parameter %s", name)
+		}
+		bound[fd.Name()] = true
+	}
+	for name, values := range r.URL.Query() {
+		fd := fields.ByName(protoreflect.Name(name))
+		if fd == nil || bound[fd.Name()] {
+			continue
+		}
+		if err := setParam(req, fd, values); err != nil {
+			return errors.Wrapf(err, "invalid query parameter %s", name)
+		}
+	}
+	if r.Method != http.MethodPost || m.body == "" {
+		return nil
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return errors.Wrap(err, "could not read body")
+	}
+	if isSSZ {
+		return bindSSZ(r, req, body)
+	}
+	if len(strings.TrimSpace(string(body))) == 0 {
+		return nil
+	}
+	target := req
+	if m.body != "*" {
+		fd := fields.ByName(protoreflect.Name(m.body))
+		if fd == nil || fd.Message() == nil {
+			return fmt.Errorf("unsupported body field %s", m.body)
+		}
+		target = req.Mutable(fd).Message()
+	}
+	return decodeJSON(body, target, r.Header.Get(versionHeader))
+}
+
+// bindSSZ sets the data and, if present, the version of an SSZ container request.
+func bindSSZ(r *http.Request, req protoreflect.Message, body []byte) error {
+	fields := req.Descriptor().Fields()
+	data := fields.ByName("data")
+	if data == nil || data.Kind() != protoreflect.BytesKind {
+		return fmt.Errorf("%s does not accept SSZ data", req.Descriptor().FullName())
+	}
+	req.Set(data, protoreflect.ValueOfBytes(body))
+	if ver := r.Header.Get(versionHeader); ver != "" {
+		if fd := fields.ByName("version"); fd != nil && fd.Kind() == protoreflect.EnumKind {
+			v, err := scalar(ver, fd)
+			if err != nil {
+				return errors.Wrapf(err, "invalid %s header", versionHeader)
+			}
+			req.Set(fd, v)
+		}
+	}
+	return nil
+}
+
+// setParam sets a field from the values of a parameter. Repeated fields accept both repeated parameters
+// and comma-separated values.
+func setParam(m protoreflect.Message, fd protoreflect.FieldDescriptor, values []string) error {
+	if fd.IsMap() || fd.Message() != nil {
+		return fmt.Errorf("unsupported parameter type %s", fd.Kind())
+	}
+	if !fd.IsList() {
+		if len(values) == 0 {
+			return nil
+		}
+		v, err := param(values[0], fd)
+		if err != nil {
+			return err
+		}
+		m.Set(fd, v)
+		return nil
+	}
+	l := m.Mutable(fd).List()
+	for _, value := range values {
+		for _, s := range strings.Split(value, ",") {
+			if s = strings.TrimSpace(s); s == "" {
+				continue
+			}
+			v, err := param(s, fd)
+			if err != nil {
+				return err
+			}
+			l.Append(v)
+		}
+	}
+	return nil
+}
+
+func writeJSON(w http.ResponseWriter, code int, resp protoreflect.Message) {
+	w.Header().Set("Content-Type", jsonMediaType)
+	if ver, ok := responseVersion(resp); ok {
+		w.Header().Set(versionHeader, ver)
+	}
+	w.WriteHeader(code)
+	buf := bufio.NewWriter(w)
+	enc := &encoder{w: buf}
+	if err := enc.message(resp); err != nil {
+		// The status code has already been sent, so the error can only be logged.
+		log.WithError(err).WithField("type", resp.Descriptor().FullName()).Error("Could not encode response")
+		return
+	}
+	if err := buf.Flush(); err != nil {
+		log.WithError(err).Debug("Could not write response")
+	}
+}
+
+func writeSSZ(w http.ResponseWriter, code int, resp protoreflect.Message) {
+	fd := resp.Descriptor().Fields().ByName("data")
+	if fd == nil || fd.Kind() != protoreflect.BytesKind {
+		writeError(w, http.StatusInternalServerError, "Response does not contain SSZ data", nil)
+		return
+	}
+	data := resp.Get(fd).Bytes()
+	w.Header().Set("Content-Type", sszMediaType)
+	w.Header().Set("Content-Length", strconv.Itoa(len(data)))
+	if ver, ok := responseVersion(resp); ok {
+		w.Header().Set(versionHeader, ver)
+	}
+	w.WriteHeader(code)
+	if _, err := w.Write(data); err != nil {
+		log.WithError(err).Debug("Could not write response")
+	}
+}
+
+// writeError writes an error in the format of the API. Additional error information set by the server
+// through the custom error gRPC header, such as individual failures of batch submissions, is merged in.
+func writeError(w http.ResponseWriter, code int, message string, header metadata.MD) {
+	body := map[string]interface{}{
+		"message": message,
+		"code":    code,
+	}
+	if custom := header.Get(grpcutil.CustomErrorMetadataKey); len(custom) > 0 {
+		if err := json.Unmarshal([]byte(custom[0]), &body); err != nil {
+			log.WithError(err).Error("Could not unmarshal custom error message")
+		}
+	}
+	j, err := json.Marshal(body)
+	if err != nil {
+		log.WithError(err).Error("Could not marshal error message")
+		return
+	}
+	w.Header().Set("Content-Type", jsonMediaType)
+	w.Header().Set("Content-Length", strconv.Itoa(len(j)))
+	w.WriteHeader(code)
+	if _, err := w.Write(j); err != nil {
+		log.WithError(err).Debug("Could not write error message")
+	}
+}
+
+// responseVersion returns the lowercase consensus version of a response with a top-level version field.
+func responseVersion(m protoreflect.Message) (string, bool) {
+	fd := m.Descriptor().Fields().ByName("version")
+	if fd == nil || fd.Kind() != protoreflect.EnumKind || fd.IsList() {
+		return "", false
+	}
+	ev := fd.Enum().Values().ByNumber(m.Get(fd).Enum())
+	if ev == nil {
+		return "", false
+	}
+	return strings.ToLower(string(ev.Name())), true
+}
+
+// sszRequested reports whether the client prefers SSZ over JSON in its Accept header.
+func sszRequested(r *http.Request) bool {
+	for _, accept := range r.Header.Values("Accept") {
+		for _, mediaType := range strings.Split(accept, ",") {
+			mediaType = strings.TrimSpace(strings.Split(mediaType, ";")[0])
+			switch mediaType {
+			case sszMediaType:
+				return true
+			case jsonMediaType, "*/*":
+				return false
+			}
+		}
+	}
+	return false
+}
+
+func sszPosted(r *http.Request) bool {
+	return strings.TrimSpace(strings.Split(r.Header.Get("Content-Type"), ";")[0]) == sszMediaType
+}
+
+// incomingMetadata passes HTTP request headers to the services as incoming gRPC metadata,
+// the way grpc-gateway does for the Eth-Consensus-Version header of SSZ submissions.
+func incomingMetadata(h http.Header) metadata.MD {
+	md := metadata.MD{}
+	for k, vs := range h {
+		md.Append(strings.ToLower(k), vs...)
+	}
+	return md
+}
+
+// headerStream collects the headers which services set with grpc.SetHeader.
+type headerStream struct {
+	method string
+	lock   sync.Mutex
+	header metadata.MD
+}
+
+// Method --
+func (s *headerStream) Method() string {
+	return s.method
+}
+
+// SetHeader --
+func (s *headerStream) SetHeader(md metadata.MD) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.header = metadata.Join(s.header, md)
+	return nil
+}
+
+// SendHeader --
+func (s *headerStream) SendHeader(md metadata.MD) error {
+	return s.SetHeader(md)
+}
+
+// SetTrailer --
+func (*headerStream) SetTrailer(metadata.MD) error {
+	return nil
+}
+
+var _ grpc.ServerTransportStream = (*headerStream)(nil)
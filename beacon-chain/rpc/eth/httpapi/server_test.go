@@ -0,0 +1,168 @@
+package httpapi
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+	grpcutil "github.com/prysmaticlabs/prysm/api/grpc"
+	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/eth/helpers"
+	ethpbservice "github.com/prysmaticlabs/prysm/proto/eth/service"
+	ethpbv1 "github.com/prysmaticlabs/prysm/proto/eth/v1"
+	ethpbv2 "github.com/prysmaticlabs/prysm/proto/eth/v2"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type fakeBeaconChainServer struct {
+	ethpbservice.BeaconChainServer
+	submitted   *ethpbv1.SubmitAttestationsRequest
+	sszVersion  ethpbv2.Version
+	sszMetadata metadata.MD
+}
+
+func (*fakeBeaconChainServer) GetStateRoot(_ context.Context, req *ethpbv1.StateRequest) (*ethpbv1.StateRootResponse, error) {
+	if string(req.StateId) != "head" {
+		return nil, status.Errorf(codes.NotFound, "Could not find state %s", req.StateId)
+	}
+	return &ethpbv1.StateRootResponse{Data: &ethpbv1.StateRootResponse_StateRoot{Root: []byte{0xaa}}}, nil
+}
+
+func (*fakeBeaconChainServer) GetBlockV2(_ context.Context, _ *ethpbv2.BlockRequestV2) (*ethpbv2.BlockResponseV2, error) {
+	return &ethpbv2.BlockResponseV2{Version: ethpbv2.Version_ALTAIR, Data: altairBlockContainer()}, nil
+}
+
+func (*fakeBeaconChainServer) GetBlockSSZV2(_ context.Context, _ *ethpbv2.BlockRequestV2) (*ethpbv2.SSZContainer, error) {
+	return &ethpbv2.SSZContainer{Version: ethpbv2.Version_ALTAIR, Data: []byte{1, 2, 3}}, nil
+}
+
+func (s *fakeBeaconChainServer) SubmitBlockSSZ(ctx context.Context, req *ethpbv2.SSZContainer) (*emptypb.Empty, error) {
+	s.sszVersion = req.Version
+	s.sszMetadata, _ = metadata.FromIncomingContext(ctx)
+	return &emptypb.Empty{}, nil
+}
+
+func (s *fakeBeaconChainServer) SubmitAttestations(ctx context.Context, req *ethpbv1.SubmitAttestationsRequest) (*emptypb.Empty, error) {
+	s.submitted = req
+	if len(req.Data) > 1 {
+		if err := grpcutil.AppendCustomErrorHeader(ctx, &helpers.IndexedVerificationFailure{
+			Failures: []*helpers.SingleIndexedVerificationFailure{{Index: 1, Message: "invalid"}},
+		}); err != nil {
+			return nil, err
+		}
+		return nil, status.Error(codes.InvalidArgument, "One or more attestations failed validation")
+	}
+	return &emptypb.Empty{}, nil
+}
+
+type fakeNodeServer struct {
+	ethpbservice.BeaconNodeServer
+}
+
+func (*fakeNodeServer) GetHealth(ctx context.Context, _ *emptypb.Empty) (*emptypb.Empty, error) {
+	if err := grpc.SetHeader(ctx, metadata.Pairs(grpcutil.HttpCodeMetadataKey, "206")); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func setupServer(t *testing.T) (*mux.Router, *fakeBeaconChainServer) {
+	router := mux.NewRouter()
+	s := NewServer()
+	require.NoError(t, s.RegisterRoutes(router, BeaconChainService, NodeService))
+	bs := &fakeBeaconChainServer{}
+	s.SetService(BeaconChainService, bs)
+	s.SetService(NodeService, &fakeNodeServer{})
+	return router, bs
+}
+
+func serve(router *mux.Router, method, path, body string, header http.Header) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	for k, vs := range header {
+		for _, v := range vs {
+			req.Header.Add(k, v)
+		}
+	}
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	return w
+}
+
+func TestServer_JSON(t *testing.T) {
+	router, _ := setupServer(t)
+
+	w := serve(router, http.MethodGet, "/eth/v1/beacon/states/head/root", "", nil)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "application/json", w.Header().Get("Content-Type"))
+	assert.Equal(t, `{"data":{"root":"0xaa"},"execution_optimistic":false}`, w.Body.String())
+
+	w = serve(router, http.MethodGet, "/eth/v2/beacon/blocks/head", "", nil)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "altair", w.Header().Get(versionHeader))
+	assert.Equal(t, true, strings.HasPrefix(w.Body.String(), `{"version":"altair","data":{"message":{"slot":"5"`))
+}
+
+func TestServer_SSZ(t *testing.T) {
+	router, bs := setupServer(t)
+
+	w := serve(router, http.MethodGet, "/eth/v2/beacon/blocks/head", "", http.Header{"Accept": {"application/octet-stream;q=1, application/json;q=0.9"}})
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "application/octet-stream", w.Header().Get("Content-Type"))
+	assert.Equal(t, "altair", w.Header().Get(versionHeader))
+	assert.DeepEqual(t, []byte{1, 2, 3}, w.Body.Bytes())
+
+	w = serve(router, http.MethodPost, "/eth/v1/beacon/blocks", "\x01\x02", http.Header{
+		"Content-Type": {"application/octet-stream"},
+		versionHeader:  {"bellatrix"},
+	})
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, 0, w.Body.Len())
+	assert.Equal(t, ethpbv2.Version_BELLATRIX, bs.sszVersion)
+	assert.DeepEqual(t, []string{"bellatrix"}, bs.sszMetadata.Get("eth-consensus-version"))
+}
+
+func TestServer_Errors(t *testing.T) {
+	router, bs := setupServer(t)
+
+	w := serve(router, http.MethodGet, "/eth/v1/beacon/states/finalized/root", "", nil)
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	assert.Equal(t, `{"code":404,"message":"Could not find state finalized"}`, w.Body.String())
+
+	w = serve(router, http.MethodPost, "/eth/v1/beacon/pool/attestations", `[{"aggregation_bits":"0x01"},{"aggregation_bits":"0x03"}]`, nil)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Equal(t, 2, len(bs.submitted.Data))
+	assert.Equal(t, `{"code":400,"failures":[{"index":1,"message":"invalid"}],"message":"One or more attestations failed validation"}`, w.Body.String())
+
+	w = serve(router, http.MethodPost, "/eth/v1/beacon/pool/attestations", `[{"aggregation_bits":"0xzz"}]`, nil)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Equal(t, true, strings.Contains(w.Body.String(), "invalid hex string"))
+}
+
+func TestServer_CustomStatusCode(t *testing.T) {
+	router, _ := setupServer(t)
+
+	w := serve(router, http.MethodGet, "/eth/v1/node/health", "", nil)
+	assert.Equal(t, http.StatusPartialContent, w.Code)
+	assert.Equal(t, 0, w.Body.Len())
+}
+
+func TestServer_ServiceNotReady(t *testing.T) {
+	router := mux.NewRouter()
+	s := NewServer()
+	require.NoError(t, s.RegisterRoutes(router, BeaconChainService))
+
+	w := serve(router, http.MethodGet, "/eth/v1/beacon/states/head/root", "", nil)
+	assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+
+	s.SetService(BeaconChainService, &fakeBeaconChainServer{})
+	w = serve(router, http.MethodGet, "/eth/v1/beacon/states/head/root", "", nil)
+	assert.Equal(t, http.StatusOK, w.Code)
+}
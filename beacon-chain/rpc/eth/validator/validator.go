@@ -575,6 +575,9 @@ func (vs *Server) PrepareBeaconProposer(
 	if err := vs.V1Alpha1Server.BeaconDB.SaveFeeRecipientsByValidatorIDs(ctx, validatorIndices, feeRecipients); err != nil {
 		return nil, status.Errorf(codes.Internal, "Could not save fee recipients: %v", err)
 	}
+	if vs.V1Alpha1Server.ValidatorMonitor != nil {
+		vs.V1Alpha1Server.ValidatorMonitor.TrackValidators(validatorIndices)
+	}
 	log.WithFields(log.Fields{
 		"validatorIndices": validatorIndices,
 	}).Info("Updated fee recipient addresses for validator indices")
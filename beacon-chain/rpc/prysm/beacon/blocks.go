@@ -0,0 +1,76 @@
+package beacon
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/encoder"
+	"github.com/prysmaticlabs/prysm/cmd"
+	"github.com/prysmaticlabs/prysm/consensus-types/interfaces"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/network/forks"
+	"github.com/prysmaticlabs/prysm/time/slots"
+	"github.com/sirupsen/logrus"
+)
+
+// BlocksPath is the path of the endpoint streaming canonical blocks for a slot range.
+const BlocksPath = "/prysm/v1/beacon/blocks"
+
+// Blocks streams the canonical signed blocks from the start slot to the end slot inclusive, given by
+// the start_slot and end_slot query parameters. Blocks are framed the same way as in the responses of
+// the p2p blocks by range protocol, without the result byte:
+//   chunk ::= <fork-digest> | <uvarint-ssz-length> | <snappy-framed-ssz>
+// The 4 byte fork digest identifies the fork of the block, and therefore its SSZ schema. The range
+// may not span more slots than the maximum RPC page size.
+func (s *Server) Blocks(w http.ResponseWriter, req *http.Request) {
+	query := req.URL.Query()
+	start, err := strconv.ParseUint(query.Get("start_slot"), 10, 64)
+	if err != nil {
+		writeBadRequest(w, "Invalid start_slot: "+query.Get("start_slot"))
+		return
+	}
+	end, err := strconv.ParseUint(query.Get("end_slot"), 10, 64)
+	if err != nil {
+		writeBadRequest(w, "Invalid end_slot: "+query.Get("end_slot"))
+		return
+	}
+	if end < start {
+		writeBadRequest(w, fmt.Sprintf("end_slot %d is lower than start_slot %d", end, start))
+		return
+	}
+	if maxRange := uint64(cmd.Get().MaxRPCPageSize); end-start >= maxRange {
+		writeBadRequest(w, fmt.Sprintf("Requested range exceeds the maximum of %d slots", maxRange))
+		return
+	}
+
+	// Chunks are streamed as they are read, so errors past this point can only be logged.
+	w.Header().Set("Content-Type", "application/octet-stream")
+	w.WriteHeader(http.StatusOK)
+	valRoot := s.GenesisFetcher.GenesisValidatorsRoot()
+	enc := encoder.SszNetworkEncoder{}
+	err = s.Exporter.Blocks(req.Context(), types.Slot(start), types.Slot(end), func(_ [32]byte, blk interfaces.SignedBeaconBlock) error {
+		digest, err := forks.ForkDigestFromEpoch(slots.ToEpoch(blk.Block().Slot()), valRoot[:])
+		if err != nil {
+			return err
+		}
+		if blk.Block().IsBlinded() {
+			blk, err = s.ExecutionPayloadReconstructor.ReconstructFullBellatrixBlock(req.Context(), blk)
+			if err != nil {
+				return errors.Wrap(err, "could not reconstruct full execution payload")
+			}
+		}
+		if _, err := w.Write(digest[:]); err != nil {
+			return err
+		}
+		_, err = enc.EncodeWithMaxLength(w, blk)
+		return err
+	})
+	if err != nil {
+		log.WithError(err).WithFields(logrus.Fields{
+			"startSlot": start,
+			"endSlot":   end,
+		}).Error("Could not stream blocks")
+	}
+}
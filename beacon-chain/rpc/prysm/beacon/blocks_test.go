@@ -0,0 +1,94 @@
+package beacon
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/export"
+	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/encoder"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	"github.com/prysmaticlabs/prysm/cmd"
+	"github.com/prysmaticlabs/prysm/config/params"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/consensus-types/wrapper"
+	"github.com/prysmaticlabs/prysm/network/forks"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	"github.com/prysmaticlabs/prysm/testing/util"
+)
+
+func TestBlocks(t *testing.T) {
+	beaconDB := testDB.SetupDB(t)
+	var want []*ethpb.SignedBeaconBlock
+	for _, slot := range []types.Slot{1, 2, 4} {
+		b := util.NewBeaconBlock()
+		b.Block.Slot = slot
+		b.Block.ProposerIndex = types.ValidatorIndex(slot)
+		wsb, err := wrapper.WrappedSignedBeaconBlock(b)
+		require.NoError(t, err)
+		require.NoError(t, beaconDB.SaveBlock(context.Background(), wsb))
+		want = append(want, b)
+	}
+	chain := &mock.ChainService{ValidatorsRoot: [32]byte{'a'}}
+	s := &Server{
+		Exporter:       export.New(beaconDB, chain, stategen.NewCanonicalHistory(beaconDB, chain, chain)),
+		GenesisFetcher: chain,
+	}
+	router := mux.NewRouter()
+	s.RegisterRoutes(router)
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/prysm/v1/beacon/blocks?start_slot=2&end_slot=10", nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "application/octet-stream", w.Header().Get("Content-Type"))
+
+	wantDigest, err := forks.ForkDigestFromEpoch(params.BeaconConfig().GenesisEpoch, chain.ValidatorsRoot[:])
+	require.NoError(t, err)
+	r := bytes.NewReader(w.Body.Bytes())
+	for _, b := range want[1:] {
+		digest := make([]byte, 4)
+		_, err := io.ReadFull(r, digest)
+		require.NoError(t, err)
+		assert.DeepEqual(t, wantDigest[:], digest)
+		blk := &ethpb.SignedBeaconBlock{}
+		require.NoError(t, encoder.SszNetworkEncoder{}.DecodeWithMaxLength(r, blk))
+		assert.DeepSSZEqual(t, b, blk)
+	}
+	assert.Equal(t, 0, r.Len())
+}
+
+func TestBlocks_BadRequest(t *testing.T) {
+	s := &Server{}
+	router := mux.NewRouter()
+	s.RegisterRoutes(router)
+
+	tests := []struct {
+		path    string
+		wantErr string
+	}{
+		{path: "/prysm/v1/beacon/blocks?start_slot=a&end_slot=1", wantErr: "Invalid start_slot: a"},
+		{path: "/prysm/v1/beacon/blocks?start_slot=1", wantErr: "Invalid end_slot: "},
+		{path: "/prysm/v1/beacon/blocks?start_slot=2&end_slot=1", wantErr: "end_slot 1 is lower than start_slot 2"},
+		{
+			path:    fmt.Sprintf("/prysm/v1/beacon/blocks?start_slot=1&end_slot=%d", cmd.Get().MaxRPCPageSize+1),
+			wantErr: fmt.Sprintf("Requested range exceeds the maximum of %d slots", cmd.Get().MaxRPCPageSize),
+		},
+		{path: "/prysm/v1/beacon/blocks?start_slot=0&end_slot=18446744073709551615", wantErr: "Requested range exceeds the maximum"},
+	}
+	for _, tt := range tests {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, tt.path, nil))
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Equal(t, true, strings.Contains(w.Body.String(), tt.wantErr), w.Body.String())
+	}
+}
@@ -0,0 +1,98 @@
+package beacon
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/prysmaticlabs/prysm/api/gateway/apimiddleware"
+	"github.com/prysmaticlabs/prysm/container/trie"
+)
+
+// DepositSnapshotPath is the path of the endpoint serving the finalized deposit tree snapshot.
+const DepositSnapshotPath = "/eth/v1/beacon/deposit_snapshot"
+
+// DepositSnapshotFetcher retrieves the latest finalized deposit tree snapshot.
+type DepositSnapshotFetcher interface {
+	DepositSnapshot(ctx context.Context) (*trie.DepositTreeSnapshot, error)
+}
+
+// DepositSnapshotResponse is the JSON response of the deposit snapshot endpoint.
+type DepositSnapshotResponse struct {
+	Data *DepositSnapshotJson `json:"data"`
+}
+
+// DepositSnapshotJson is the JSON representation of an EIP-4881 deposit tree snapshot.
+type DepositSnapshotJson struct {
+	Finalized            []string `json:"finalized"`
+	DepositRoot          string   `json:"deposit_root"`
+	DepositCount         string   `json:"deposit_count"`
+	ExecutionBlockHash   string   `json:"execution_block_hash"`
+	ExecutionBlockHeight string   `json:"execution_block_height"`
+}
+
+// DepositSnapshot serves the latest EIP-4881 deposit tree snapshot, either as JSON or
+// ssz-encoded when requested through the Accept header.
+func (s *Server) DepositSnapshot(w http.ResponseWriter, req *http.Request) {
+	snapshot, err := s.DepositSnapshotFetcher.DepositSnapshot(req.Context())
+	if err != nil {
+		apimiddleware.WriteError(w, apimiddleware.InternalServerErrorWithMessage(err, "could not retrieve deposit snapshot"), nil)
+		return
+	}
+	if snapshot == nil {
+		apimiddleware.WriteError(w, &apimiddleware.DefaultErrorJson{
+			Message: "No finalized deposit snapshot available",
+			Code:    http.StatusNotFound,
+		}, nil)
+		return
+	}
+
+	if sszRequested(req) {
+		enc, err := snapshot.MarshalSSZ()
+		if err != nil {
+			apimiddleware.WriteError(w, apimiddleware.InternalServerErrorWithMessage(err, "could not marshal deposit snapshot"), nil)
+			return
+		}
+		w.Header().Set("Content-Length", strconv.Itoa(len(enc)))
+		w.Header().Set("Content-Type", "application/octet-stream")
+		w.WriteHeader(http.StatusOK)
+		if _, err := w.Write(enc); err != nil {
+			log.WithError(err).Error("Could not write deposit snapshot")
+		}
+		return
+	}
+
+	finalized := make([]string, len(snapshot.Finalized))
+	for i := range snapshot.Finalized {
+		finalized[i] = hexutil.Encode(snapshot.Finalized[i][:])
+	}
+	resp := &DepositSnapshotResponse{Data: &DepositSnapshotJson{
+		Finalized:            finalized,
+		DepositRoot:          hexutil.Encode(snapshot.DepositRoot[:]),
+		DepositCount:         strconv.FormatUint(snapshot.DepositCount, 10),
+		ExecutionBlockHash:   hexutil.Encode(snapshot.ExecutionBlockHash[:]),
+		ExecutionBlockHeight: strconv.FormatUint(snapshot.ExecutionBlockHeight, 10),
+	}}
+	j, err := json.Marshal(resp)
+	if err != nil {
+		apimiddleware.WriteError(w, apimiddleware.InternalServerErrorWithMessage(err, "could not marshal deposit snapshot"), nil)
+		return
+	}
+	w.Header().Set("Content-Length", strconv.Itoa(len(j)))
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if _, err := w.Write(j); err != nil {
+		log.WithError(err).Error("Could not write deposit snapshot")
+	}
+}
+
+func sszRequested(req *http.Request) bool {
+	for _, v := range req.Header["Accept"] {
+		if v == "application/octet-stream" {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,66 @@
+package beacon
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/prysmaticlabs/prysm/config/params"
+	"github.com/prysmaticlabs/prysm/container/trie"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+)
+
+type mockDepositSnapshotFetcher struct {
+	snapshot *trie.DepositTreeSnapshot
+}
+
+func (m *mockDepositSnapshotFetcher) DepositSnapshot(_ context.Context) (*trie.DepositTreeSnapshot, error) {
+	return m.snapshot, nil
+}
+
+func TestDepositSnapshot(t *testing.T) {
+	depositTrie, err := trie.NewTrie(params.BeaconConfig().DepositContractTreeDepth)
+	require.NoError(t, err)
+	for i := 0; i < 3; i++ {
+		require.NoError(t, depositTrie.Insert([]byte{byte(i)}, i))
+	}
+	snapshot, err := depositTrie.Snapshot([32]byte{'a'}, 10)
+	require.NoError(t, err)
+	s := &Server{DepositSnapshotFetcher: &mockDepositSnapshotFetcher{snapshot: snapshot}}
+
+	t.Run("JSON", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, DepositSnapshotPath, nil)
+		writer := httptest.NewRecorder()
+		s.DepositSnapshot(writer, req)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &DepositSnapshotResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		require.Equal(t, 2, len(resp.Data.Finalized))
+		assert.Equal(t, hexutil.Encode(snapshot.Finalized[0][:]), resp.Data.Finalized[0])
+		assert.Equal(t, hexutil.Encode(snapshot.DepositRoot[:]), resp.Data.DepositRoot)
+		assert.Equal(t, "3", resp.Data.DepositCount)
+		assert.Equal(t, hexutil.Encode(snapshot.ExecutionBlockHash[:]), resp.Data.ExecutionBlockHash)
+		assert.Equal(t, "10", resp.Data.ExecutionBlockHeight)
+	})
+	t.Run("SSZ", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, DepositSnapshotPath, nil)
+		req.Header.Set("Accept", "application/octet-stream")
+		writer := httptest.NewRecorder()
+		s.DepositSnapshot(writer, req)
+		require.Equal(t, http.StatusOK, writer.Code)
+		assert.Equal(t, "application/octet-stream", writer.Header().Get("Content-Type"))
+		decoded := &trie.DepositTreeSnapshot{}
+		require.NoError(t, decoded.UnmarshalSSZ(writer.Body.Bytes()))
+		assert.DeepEqual(t, snapshot, decoded)
+	})
+	t.Run("not found", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, DepositSnapshotPath, nil)
+		writer := httptest.NewRecorder()
+		(&Server{DepositSnapshotFetcher: &mockDepositSnapshotFetcher{}}).DepositSnapshot(writer, req)
+		assert.Equal(t, http.StatusNotFound, writer.Code)
+	})
+}
@@ -0,0 +1,60 @@
+package beacon
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"github.com/prysmaticlabs/prysm/api/gateway/apimiddleware"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/export"
+	"github.com/prysmaticlabs/prysm/cmd"
+)
+
+// ExportPath is the path of the endpoint streaming historical chain data.
+const ExportPath = "/prysm/v1/beacon/export/{dataset}"
+
+// Export streams a dataset for the slot or epoch range given by the start and end query
+// parameters, in the format given by the format query parameter (json by default). The range
+// of a request is capped by the maximum RPC page size, use `prysmctl export` for larger ranges.
+func (s *Server) Export(w http.ResponseWriter, req *http.Request) {
+	exportReq := &export.Request{
+		Dataset: export.Dataset(mux.Vars(req)["dataset"]),
+		Format:  export.JSON,
+	}
+	query := req.URL.Query()
+	if f := query.Get("format"); f != "" {
+		exportReq.Format = export.Format(f)
+	}
+	var err error
+	if exportReq.Start, err = strconv.ParseUint(query.Get("start"), 10, 64); err != nil {
+		writeBadRequest(w, "Invalid start: "+query.Get("start"))
+		return
+	}
+	if exportReq.End, err = strconv.ParseUint(query.Get("end"), 10, 64); err != nil {
+		writeBadRequest(w, "Invalid end: "+query.Get("end"))
+		return
+	}
+	if err := exportReq.Validate(); err != nil {
+		writeBadRequest(w, err.Error())
+		return
+	}
+	if maxRange := uint64(cmd.Get().MaxRPCPageSize); exportReq.End-exportReq.Start >= maxRange {
+		writeBadRequest(w, fmt.Sprintf("Requested range exceeds the maximum of %d slots or epochs", maxRange))
+		return
+	}
+
+	// Rows are streamed as they are read, so errors past this point can only be logged.
+	w.Header().Set("Content-Type", exportReq.Format.ContentType())
+	w.WriteHeader(http.StatusOK)
+	if err := s.Exporter.Export(req.Context(), exportReq, w); err != nil {
+		log.WithError(err).WithField("dataset", exportReq.Dataset).Error("Could not export chain data")
+	}
+}
+
+func writeBadRequest(w http.ResponseWriter, msg string) {
+	apimiddleware.WriteError(w, &apimiddleware.DefaultErrorJson{
+		Message: msg,
+		Code:    http.StatusBadRequest,
+	}, nil)
+}
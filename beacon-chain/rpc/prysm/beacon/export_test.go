@@ -0,0 +1,80 @@
+package beacon
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/export"
+	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	"github.com/prysmaticlabs/prysm/cmd"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/consensus-types/wrapper"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	"github.com/prysmaticlabs/prysm/testing/util"
+)
+
+func TestExport(t *testing.T) {
+	beaconDB := testDB.SetupDB(t)
+	for _, slot := range []types.Slot{1, 2} {
+		b := util.NewBeaconBlock()
+		b.Block.Slot = slot
+		b.Block.ProposerIndex = 5
+		wsb, err := wrapper.WrappedSignedBeaconBlock(b)
+		require.NoError(t, err)
+		require.NoError(t, beaconDB.SaveBlock(context.Background(), wsb))
+	}
+	chain := &mock.ChainService{}
+	s := &Server{Exporter: export.New(beaconDB, chain, stategen.NewCanonicalHistory(beaconDB, chain, chain))}
+	router := mux.NewRouter()
+	s.RegisterRoutes(router)
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/prysm/v1/beacon/export/blocks?start=0&end=5&format=csv", nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "text/csv", w.Header().Get("Content-Type"))
+	lines := strings.Split(strings.TrimSpace(w.Body.String()), "\n")
+	require.Equal(t, 3, len(lines))
+	assert.Equal(t, true, strings.HasPrefix(lines[0], "slot,block_root,"))
+	assert.Equal(t, true, strings.HasPrefix(lines[2], "2,0x"))
+
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/prysm/v1/beacon/export/blocks?start=0&end=0", nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "application/x-ndjson", w.Header().Get("Content-Type"))
+	assert.Equal(t, 0, w.Body.Len())
+}
+
+func TestExport_BadRequest(t *testing.T) {
+	s := &Server{}
+	router := mux.NewRouter()
+	s.RegisterRoutes(router)
+
+	tests := []struct {
+		path    string
+		wantErr string
+	}{
+		{path: "/prysm/v1/beacon/export/blocks?start=a&end=1", wantErr: "Invalid start: a"},
+		{path: "/prysm/v1/beacon/export/blocks?start=1", wantErr: "Invalid end: "},
+		{path: "/prysm/v1/beacon/export/sidecars?start=1&end=1", wantErr: `unknown dataset \"sidecars\"`},
+		{path: "/prysm/v1/beacon/export/blocks?start=1&end=1&format=xml", wantErr: `unknown format \"xml\"`},
+		{
+			path:    fmt.Sprintf("/prysm/v1/beacon/export/blocks?start=1&end=%d", cmd.Get().MaxRPCPageSize+1),
+			wantErr: fmt.Sprintf("Requested range exceeds the maximum of %d slots or epochs", cmd.Get().MaxRPCPageSize),
+		},
+		{path: "/prysm/v1/beacon/export/blocks?start=0&end=18446744073709551615", wantErr: "Requested range exceeds the maximum"},
+	}
+	for _, tt := range tests {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, tt.path, nil))
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Equal(t, true, strings.Contains(w.Body.String(), tt.wantErr), w.Body.String())
+	}
+}
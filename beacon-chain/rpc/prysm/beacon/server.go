@@ -0,0 +1,31 @@
+// Package beacon defines the HTTP handlers of the Prysm beacon chain endpoints
+// which are not backed by gRPC services.
+package beacon
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/export"
+	"github.com/prysmaticlabs/prysm/beacon-chain/powchain"
+)
+
+// Server defines the HTTP handlers of the Prysm beacon chain endpoints.
+type Server struct {
+	Exporter                      *export.Exporter
+	GenesisFetcher                blockchain.GenesisFetcher
+	ExecutionPayloadReconstructor powchain.ExecutionPayloadReconstructor
+	DepositSnapshotFetcher        DepositSnapshotFetcher
+}
+
+// RegisterRoutes registers the endpoints on the router.
+func (s *Server) RegisterRoutes(r *mux.Router) {
+	r.HandleFunc(ExportPath, s.Export).Methods(http.MethodGet)
+	r.HandleFunc(BlocksPath, s.Blocks).Methods(http.MethodGet)
+}
+
+// RegisterEthRoutes registers the endpoints of the Ethereum API namespace on the router.
+func (s *Server) RegisterEthRoutes(r *mux.Router) {
+	r.HandleFunc(DepositSnapshotPath, s.DepositSnapshot).Methods(http.MethodGet)
+}
@@ -0,0 +1,25 @@
+// Package debug defines the HTTP handlers of the Prysm debug endpoints
+// which are not backed by gRPC services.
+package debug
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/synccommittee"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+)
+
+// Server defines the HTTP handlers of the Prysm debug endpoints.
+type Server struct {
+	BeaconDB          db.ReadOnlyDatabase
+	StateGen          stategen.StateManager
+	SyncCommitteePool synccommittee.Pool
+}
+
+// RegisterRoutes registers the endpoints on the router.
+func (s *Server) RegisterRoutes(r *mux.Router) {
+	r.HandleFunc(SimulateBlockPath, s.SimulateBlock).Methods(http.MethodPost)
+	r.HandleFunc(SyncCommitteePoolPath, s.SyncCommitteePoolContents).Methods(http.MethodGet)
+}
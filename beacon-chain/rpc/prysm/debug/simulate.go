@@ -0,0 +1,332 @@
+package debug
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	fastssz "github.com/ferranbt/fastssz"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/api/gateway/apimiddleware"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/transition"
+	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/eth/httpapi"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/config/params"
+	"github.com/prysmaticlabs/prysm/consensus-types/interfaces"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/consensus-types/wrapper"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	ethpbv1 "github.com/prysmaticlabs/prysm/proto/eth/v1"
+	ethpbv2 "github.com/prysmaticlabs/prysm/proto/eth/v2"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/runtime/version"
+	"github.com/prysmaticlabs/prysm/time/slots"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// SimulateBlockPath is the path of the endpoint processing a block against the state of its parent
+	// without importing it.
+	SimulateBlockPath = "/prysm/v1/debug/simulate_block"
+
+	versionHeader = "Eth-Consensus-Version"
+	sszMediaType  = "application/octet-stream"
+	// maxBlockSize bounds the size of the request body.
+	maxBlockSize = 10 * 1024 * 1024
+	// The slot is the first field of a block, and follows the offset of the block and the signature in
+	// a signed block.
+	signedBlockSlotOffset = 4 + fieldparams.BLSSignatureLength
+)
+
+// SimulateBlock runs the state transition of the block in the request body against a copy of the
+// state of its parent, and reports whether the block is valid, the outcome of each of its operations,
+// the post state root and the balance changes. The block is neither saved nor given to fork choice.
+//
+// The block is in the JSON format of the Beacon API, or SSZ encoded if the content type is
+// application/octet-stream, and may be signed or unsigned. Unsigned JSON blocks are detected by the
+// absence of the message field, while unsigned SSZ blocks need the unsigned=true query parameter.
+// The fork of the block is given by the Eth-Consensus-Version header, or derived from its slot.
+// The parent_root query parameter overrides the parent of the block, and verify_signatures=false
+// skips the verification of the block, randao, attestation and sync aggregate signatures, which are
+// never verified for unsigned blocks.
+func (s *Server) SimulateBlock(w http.ResponseWriter, req *http.Request) {
+	query := req.URL.Query()
+	verifySignatures := true
+	if v := query.Get("verify_signatures"); v != "" {
+		var err error
+		if verifySignatures, err = strconv.ParseBool(v); err != nil {
+			writeError(w, http.StatusBadRequest, "Invalid verify_signatures: "+v)
+			return
+		}
+	}
+	body, err := io.ReadAll(io.LimitReader(req.Body, maxBlockSize))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "Could not read request body: "+err.Error())
+		return
+	}
+	signed, unsigned, err := decodeBlock(req, body)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "Could not decode block: "+err.Error())
+		return
+	}
+	if unsigned {
+		verifySignatures = false
+	}
+
+	parentRoot := bytesutil.ToBytes32(signed.Block().ParentRoot())
+	if r := query.Get("parent_root"); r != "" {
+		root, err := hexutil.Decode(r)
+		if err != nil || len(root) != fieldparams.RootLength {
+			writeError(w, http.StatusBadRequest, "Invalid parent_root: "+r)
+			return
+		}
+		parentRoot = bytesutil.ToBytes32(root)
+	}
+	ctx := req.Context()
+	if !s.BeaconDB.HasBlock(ctx, parentRoot) {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("Unknown parent block %#x", parentRoot))
+		return
+	}
+	parentState, err := s.StateGen.StateByRoot(ctx, parentRoot)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "Could not get parent state: "+err.Error())
+		return
+	}
+	if parentState == nil || parentState.IsNil() {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("No state for parent block %#x", parentRoot))
+		return
+	}
+	// The state may be shared with the state caches, so the transition runs on a copy.
+	preState := parentState.Copy()
+	sim, err := transition.SimulateBlock(ctx, preState.Copy(), signed, verifySignatures)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "Could not simulate block: "+err.Error())
+		return
+	}
+	blockRoot, err := signed.Block().HashTreeRoot()
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "Could not hash block: "+err.Error())
+		return
+	}
+
+	data := &SimulationJson{
+		Valid:          sim.Err == nil,
+		BlockRoot:      hexutil.Encode(blockRoot[:]),
+		ParentRoot:     hexutil.Encode(parentRoot[:]),
+		Operations:     make([]*OperationResultJson, len(sim.Operations)),
+		BalanceChanges: []*BalanceChangeJson{},
+	}
+	if sim.Err != nil {
+		data.Error = sim.Err.Error()
+	}
+	for i, op := range sim.Operations {
+		data.Operations[i] = &OperationResultJson{Type: op.Operation, Valid: op.Err == nil}
+		if op.Index >= 0 {
+			data.Operations[i].Index = strconv.Itoa(op.Index)
+		}
+		if op.Err != nil {
+			data.Operations[i].Error = op.Err.Error()
+		}
+	}
+	if sim.PostState != nil {
+		data.PostStateRoot = hexutil.Encode(sim.PostStateRoot[:])
+		data.BalanceChanges = balanceChanges(preState, sim.PostState)
+	}
+	writeJson(w, &SimulateBlockResponse{Data: data})
+}
+
+// decodeBlock decodes the block of the request body, wrapping an unsigned block with an empty signature.
+func decodeBlock(req *http.Request, body []byte) (interfaces.SignedBeaconBlock, bool, error) {
+	if strings.TrimSpace(strings.Split(req.Header.Get("Content-Type"), ";")[0]) == sszMediaType {
+		unsigned := req.URL.Query().Get("unsigned") == "true"
+		blk, err := decodeSSZBlock(req, body, unsigned)
+		return blk, unsigned, err
+	}
+	return decodeJSONBlock(req, body)
+}
+
+func decodeSSZBlock(req *http.Request, body []byte, unsigned bool) (interfaces.SignedBeaconBlock, error) {
+	offset := signedBlockSlotOffset
+	if unsigned {
+		offset = 0
+	}
+	if len(body) < offset+8 {
+		return nil, errors.New("block is too short")
+	}
+	v, err := requestVersion(req, types.Slot(binary.LittleEndian.Uint64(body[offset:offset+8])))
+	if err != nil {
+		return nil, err
+	}
+	if unsigned {
+		return unmarshalBlock(v, body, make([]byte, fieldparams.BLSSignatureLength))
+	}
+	var blk fastssz.Unmarshaler
+	switch v {
+	case version.Phase0:
+		blk = &ethpb.SignedBeaconBlock{}
+	case version.Altair:
+		blk = &ethpb.SignedBeaconBlockAltair{}
+	default:
+		blk = &ethpb.SignedBeaconBlockBellatrix{}
+	}
+	if err := blk.UnmarshalSSZ(body); err != nil {
+		return nil, errors.Wrap(err, "could not decode SSZ")
+	}
+	return wrapper.WrappedSignedBeaconBlock(blk)
+}
+
+// decodeJSONBlock decodes a block in the JSON format of the Beacon API, which is signed if it is
+// wrapped in an object with the message and signature fields.
+func decodeJSONBlock(req *http.Request, body []byte) (interfaces.SignedBeaconBlock, bool, error) {
+	var signed struct {
+		Message   json.RawMessage `json:"message"`
+		Signature string          `json:"signature"`
+	}
+	if err := json.Unmarshal(body, &signed); err != nil {
+		return nil, false, errors.Wrap(err, "could not decode JSON")
+	}
+	unsigned := signed.Message == nil
+	sig := make([]byte, fieldparams.BLSSignatureLength)
+	if !unsigned {
+		var err error
+		if sig, err = hexutil.Decode(signed.Signature); err != nil {
+			return nil, false, errors.Wrap(err, "invalid signature")
+		}
+		body = signed.Message
+	}
+	var header struct {
+		Slot string `json:"slot"`
+	}
+	if err := json.Unmarshal(body, &header); err != nil {
+		return nil, false, errors.Wrap(err, "could not decode JSON")
+	}
+	slot, err := strconv.ParseUint(header.Slot, 10, 64)
+	if err != nil {
+		return nil, false, fmt.Errorf("invalid slot %q", header.Slot)
+	}
+	v, err := requestVersion(req, types.Slot(slot))
+	if err != nil {
+		return nil, false, err
+	}
+
+	// The Beacon API types have the same SSZ encoding as the types of the state transition.
+	var apiBlock proto.Message
+	switch v {
+	case version.Phase0:
+		apiBlock = &ethpbv1.BeaconBlock{}
+	case version.Altair:
+		apiBlock = &ethpbv2.BeaconBlockAltair{}
+	default:
+		apiBlock = &ethpbv2.BeaconBlockBellatrix{}
+	}
+	if err := httpapi.DecodeJSON(body, apiBlock, ""); err != nil {
+		return nil, false, err
+	}
+	enc, err := apiBlock.(fastssz.Marshaler).MarshalSSZ()
+	if err != nil {
+		return nil, false, errors.Wrap(err, "could not encode block")
+	}
+	blk, err := unmarshalBlock(v, enc, sig)
+	return blk, unsigned, err
+}
+
+// unmarshalBlock decodes an SSZ encoded unsigned block of the fork and signs it with the signature.
+func unmarshalBlock(v int, enc []byte, sig []byte) (interfaces.SignedBeaconBlock, error) {
+	var signed interface{}
+	var err error
+	switch v {
+	case version.Phase0:
+		blk := &ethpb.BeaconBlock{}
+		err = blk.UnmarshalSSZ(enc)
+		signed = &ethpb.SignedBeaconBlock{Block: blk, Signature: sig}
+	case version.Altair:
+		blk := &ethpb.BeaconBlockAltair{}
+		err = blk.UnmarshalSSZ(enc)
+		signed = &ethpb.SignedBeaconBlockAltair{Block: blk, Signature: sig}
+	default:
+		blk := &ethpb.BeaconBlockBellatrix{}
+		err = blk.UnmarshalSSZ(enc)
+		signed = &ethpb.SignedBeaconBlockBellatrix{Block: blk, Signature: sig}
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "could not decode SSZ")
+	}
+	return wrapper.WrappedSignedBeaconBlock(signed)
+}
+
+// requestVersion returns the fork given by the Eth-Consensus-Version header, or else the fork of the slot.
+func requestVersion(req *http.Request, slot types.Slot) (int, error) {
+	h := req.Header.Get(versionHeader)
+	if h == "" {
+		return blockVersion(slot), nil
+	}
+	for _, v := range []int{version.Phase0, version.Altair, version.Bellatrix} {
+		if strings.EqualFold(h, version.String(v)) {
+			return v, nil
+		}
+	}
+	return 0, fmt.Errorf("unsupported consensus version %s", h)
+}
+
+// blockVersion returns the fork of a block at the slot.
+func blockVersion(slot types.Slot) int {
+	epoch := slots.ToEpoch(slot)
+	switch {
+	case epoch >= params.BeaconConfig().BellatrixForkEpoch:
+		return version.Bellatrix
+	case epoch >= params.BeaconConfig().AltairForkEpoch:
+		return version.Altair
+	default:
+		return version.Phase0
+	}
+}
+
+// balanceChanges lists the validators whose balance differs between the states, including the
+// validators added by deposits.
+func balanceChanges(pre, post state.ReadOnlyBeaconState) []*BalanceChangeJson {
+	preBalances, postBalances := pre.Balances(), post.Balances()
+	changes := make([]*BalanceChangeJson, 0)
+	for i, postBal := range postBalances {
+		var preBal uint64
+		if i < len(preBalances) {
+			if preBalances[i] == postBal {
+				continue
+			}
+			preBal = preBalances[i]
+		}
+		changes = append(changes, &BalanceChangeJson{
+			Index:       strconv.Itoa(i),
+			PreBalance:  strconv.FormatUint(preBal, 10),
+			PostBalance: strconv.FormatUint(postBal, 10),
+			Delta:       strconv.FormatInt(int64(postBal)-int64(preBal), 10),
+		})
+	}
+	return changes
+}
+
+func writeError(w http.ResponseWriter, code int, msg string) {
+	apimiddleware.WriteError(w, &apimiddleware.DefaultErrorJson{
+		Message: msg,
+		Code:    code,
+	}, nil)
+}
+
+func writeJson(w http.ResponseWriter, v interface{}) {
+	j, err := json.Marshal(v)
+	if err != nil {
+		apimiddleware.WriteError(w, apimiddleware.InternalServerErrorWithMessage(err, "could not marshal response"), nil)
+		return
+	}
+	w.Header().Set("Content-Length", strconv.Itoa(len(j)))
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if _, err := w.Write(j); err != nil {
+		log.WithError(err).Error("Could not write response")
+	}
+}
@@ -0,0 +1,199 @@
+package debug
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/gorilla/mux"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/transition"
+	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/eth/httpapi"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen/mock"
+	"github.com/prysmaticlabs/prysm/config/params"
+	"github.com/prysmaticlabs/prysm/consensus-types/wrapper"
+	"github.com/prysmaticlabs/prysm/proto/migration"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	"github.com/prysmaticlabs/prysm/testing/util"
+)
+
+// setupSimulation returns a router serving the parent state of a valid block, the block and its
+// expected post state root.
+func setupSimulation(t *testing.T) (*mux.Router, *ethpb.SignedBeaconBlock, [32]byte) {
+	ctx := context.Background()
+	st, privs := util.DeterministicGenesisState(t, params.MinimalSpecConfig().MinGenesisActiveValidatorCount)
+	cfg := util.DefaultBlockGenConfig()
+	cfg.NumAttestations = 1
+	blk, err := util.GenerateFullBlock(st.Copy(), privs, cfg, 1)
+	require.NoError(t, err)
+	wsb, err := wrapper.WrappedSignedBeaconBlock(blk)
+	require.NoError(t, err)
+	post, err := transition.ExecuteStateTransition(ctx, st.Copy(), wsb)
+	require.NoError(t, err)
+	postRoot, err := post.HashTreeRoot(ctx)
+	require.NoError(t, err)
+
+	// The parent of the block is the genesis block.
+	stRoot, err := st.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	genesis := blocks.NewGenesisBlock(stRoot[:])
+	genesisRoot, err := genesis.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.DeepEqual(t, genesisRoot[:], blk.Block.ParentRoot)
+	beaconDB := testDB.SetupDB(t)
+	wsbGenesis, err := wrapper.WrappedSignedBeaconBlock(genesis)
+	require.NoError(t, err)
+	require.NoError(t, beaconDB.SaveBlock(ctx, wsbGenesis))
+	sg := mock.NewMockService()
+	sg.StatesByRoot[genesisRoot] = st
+
+	s := &Server{BeaconDB: beaconDB, StateGen: sg}
+	router := mux.NewRouter()
+	s.RegisterRoutes(router)
+	return router, blk, postRoot
+}
+
+func simulate(t *testing.T, router *mux.Router, query string, contentType string, body []byte) (*httptest.ResponseRecorder, *SimulationJson) {
+	req := httptest.NewRequest(http.MethodPost, SimulateBlockPath+query, bytes.NewReader(body))
+	req.Header.Set("Content-Type", contentType)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	if w.Code != http.StatusOK {
+		return w, nil
+	}
+	resp := &SimulateBlockResponse{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), resp))
+	return w, resp.Data
+}
+
+func TestSimulateBlock_SSZ(t *testing.T) {
+	router, blk, postRoot := setupSimulation(t)
+	enc, err := blk.MarshalSSZ()
+	require.NoError(t, err)
+
+	w, data := simulate(t, router, "", sszMediaType, enc)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	assert.Equal(t, true, data.Valid, data.Error)
+	assert.Equal(t, hexutil.Encode(postRoot[:]), data.PostStateRoot)
+	wantRoot, err := blk.Block.HashTreeRoot()
+	require.NoError(t, err)
+	assert.Equal(t, hexutil.Encode(wantRoot[:]), data.BlockRoot)
+	var sawAttestation bool
+	for _, op := range data.Operations {
+		assert.Equal(t, true, op.Valid, op.Type)
+		if op.Type == "attestation" {
+			sawAttestation = true
+			assert.Equal(t, "0", op.Index)
+		}
+	}
+	assert.Equal(t, true, sawAttestation)
+
+	// A signature over another message fails the block signature check unless signatures are skipped.
+	bad := ethpb.CopySignedBeaconBlock(blk)
+	bad.Signature = blk.Block.Body.Attestations[0].Signature
+	enc, err = bad.MarshalSSZ()
+	require.NoError(t, err)
+	_, data = simulate(t, router, "", sszMediaType, enc)
+	require.NotNil(t, data)
+	assert.Equal(t, false, data.Valid)
+	assert.Equal(t, "", data.PostStateRoot)
+	last := data.Operations[len(data.Operations)-1]
+	assert.Equal(t, "block_signature", last.Type)
+	assert.Equal(t, false, last.Valid)
+	_, data = simulate(t, router, "?verify_signatures=false", sszMediaType, enc)
+	require.NotNil(t, data)
+	assert.Equal(t, true, data.Valid, data.Error)
+
+	// Unsigned blocks are decoded without the signature and never have it verified.
+	enc, err = blk.Block.MarshalSSZ()
+	require.NoError(t, err)
+	_, data = simulate(t, router, "?unsigned=true", sszMediaType, enc)
+	require.NotNil(t, data)
+	assert.Equal(t, true, data.Valid, data.Error)
+	assert.Equal(t, hexutil.Encode(postRoot[:]), data.PostStateRoot)
+}
+
+func TestSimulateBlock_JSON(t *testing.T) {
+	router, blk, postRoot := setupSimulation(t)
+	v1Blk, err := migration.V1Alpha1ToV1SignedBlock(blk)
+	require.NoError(t, err)
+	enc, err := httpapi.EncodeJSON(v1Blk.Block)
+	require.NoError(t, err)
+	signed := fmt.Sprintf(`{"message":%s,"signature":"%s"}`, enc, hexutil.Encode(v1Blk.Signature))
+
+	w, data := simulate(t, router, "", "application/json", []byte(signed))
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	assert.Equal(t, true, data.Valid, data.Error)
+	assert.Equal(t, hexutil.Encode(postRoot[:]), data.PostStateRoot)
+
+	_, data = simulate(t, router, "", "application/json", enc)
+	require.NotNil(t, data)
+	assert.Equal(t, true, data.Valid, data.Error)
+	for _, op := range data.Operations {
+		assert.NotEqual(t, "block_signature", op.Type)
+	}
+}
+
+func TestSimulateBlock_StateRootMismatch(t *testing.T) {
+	router, blk, postRoot := setupSimulation(t)
+	blk.Block.StateRoot = make([]byte, 32)
+	enc, err := blk.Block.MarshalSSZ()
+	require.NoError(t, err)
+
+	_, data := simulate(t, router, "?unsigned=true", sszMediaType, enc)
+	require.NotNil(t, data)
+	assert.Equal(t, false, data.Valid)
+	assert.Equal(t, true, strings.Contains(data.Error, "could not validate state root"), data.Error)
+	assert.Equal(t, hexutil.Encode(postRoot[:]), data.PostStateRoot)
+	assert.Equal(t, "state_root", data.Operations[len(data.Operations)-1].Type)
+}
+
+func TestSimulateBlock_Errors(t *testing.T) {
+	router, blk, _ := setupSimulation(t)
+	enc, err := blk.MarshalSSZ()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name        string
+		query       string
+		contentType string
+		body        []byte
+		code        int
+	}{
+		{name: "unknown parent", query: "?parent_root=" + hexutil.Encode(make([]byte, 32)), contentType: sszMediaType, body: enc, code: http.StatusNotFound},
+		{name: "invalid parent root", query: "?parent_root=0x01", contentType: sszMediaType, body: enc, code: http.StatusBadRequest},
+		{name: "invalid verify_signatures", query: "?verify_signatures=maybe", contentType: sszMediaType, body: enc, code: http.StatusBadRequest},
+		{name: "truncated SSZ", contentType: sszMediaType, body: enc[:50], code: http.StatusBadRequest},
+		{name: "invalid JSON", contentType: "application/json", body: []byte(`{"message":`), code: http.StatusBadRequest},
+		{name: "invalid slot", contentType: "application/json", body: []byte(`{"slot":"x"}`), code: http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w, _ := simulate(t, router, tt.query, tt.contentType, tt.body)
+			assert.Equal(t, tt.code, w.Code, fmt.Sprintf("body: %s", w.Body.String()))
+		})
+	}
+}
+
+func TestBalanceChanges(t *testing.T) {
+	pre, err := util.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, pre.SetBalances([]uint64{10, 20, 30}))
+	post := pre.Copy()
+	require.NoError(t, post.SetBalances([]uint64{10, 25, 28, 40}))
+
+	changes := balanceChanges(pre, post)
+	require.Equal(t, 3, len(changes))
+	assert.DeepEqual(t, &BalanceChangeJson{Index: "1", PreBalance: "20", PostBalance: "25", Delta: "5"}, changes[0])
+	assert.DeepEqual(t, &BalanceChangeJson{Index: "2", PreBalance: "30", PostBalance: "28", Delta: "-2"}, changes[1])
+	assert.DeepEqual(t, &BalanceChangeJson{Index: "3", PreBalance: "0", PostBalance: "40", Delta: "40"}, changes[2])
+}
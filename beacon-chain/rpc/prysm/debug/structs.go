@@ -0,0 +1,62 @@
+package debug
+
+// SimulateBlockResponse is the response of the block simulation endpoint.
+type SimulateBlockResponse struct {
+	Data *SimulationJson `json:"data"`
+}
+
+// SimulationJson is the outcome of processing a block against the state of its parent.
+type SimulationJson struct {
+	Valid          bool                   `json:"valid"`
+	Error          string                 `json:"error,omitempty"`
+	BlockRoot      string                 `json:"block_root"`
+	ParentRoot     string                 `json:"parent_root"`
+	PostStateRoot  string                 `json:"post_state_root,omitempty"`
+	Operations     []*OperationResultJson `json:"operations"`
+	BalanceChanges []*BalanceChangeJson   `json:"balance_changes"`
+}
+
+// OperationResultJson is the outcome of one step of the state transition. The index is only set for
+// the operations of the block body.
+type OperationResultJson struct {
+	Type  string `json:"type"`
+	Index string `json:"index,omitempty"`
+	Valid bool   `json:"valid"`
+	Error string `json:"error,omitempty"`
+}
+
+// BalanceChangeJson is the change of the balance of a validator between the parent state and the
+// post state.
+type BalanceChangeJson struct {
+	Index       string `json:"index"`
+	PreBalance  string `json:"pre_balance"`
+	PostBalance string `json:"post_balance"`
+	Delta       string `json:"delta"`
+}
+
+// SyncCommitteePoolResponse is the response of the sync committee pool endpoint.
+type SyncCommitteePoolResponse struct {
+	Data []*SyncCommitteePoolSlotJson `json:"data"`
+}
+
+// SyncCommitteePoolSlotJson is the content of the sync committee pool for a slot.
+type SyncCommitteePoolSlotJson struct {
+	Slot          string                           `json:"slot"`
+	Messages      []*SyncCommitteeMessageJson      `json:"messages"`
+	Contributions []*SyncCommitteeContributionJson `json:"contributions"`
+}
+
+// SyncCommitteeMessageJson is a sync committee message of the pool.
+type SyncCommitteeMessageJson struct {
+	ValidatorIndex  string `json:"validator_index"`
+	BeaconBlockRoot string `json:"beacon_block_root"`
+	Signature       string `json:"signature"`
+}
+
+// SyncCommitteeContributionJson is a sync committee contribution of the pool.
+type SyncCommitteeContributionJson struct {
+	SubcommitteeIndex string `json:"subcommittee_index"`
+	BeaconBlockRoot   string `json:"beacon_block_root"`
+	AggregationBits   string `json:"aggregation_bits"`
+	Signature         string `json:"signature"`
+}
@@ -0,0 +1,57 @@
+package debug
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// SyncCommitteePoolPath is the path of the endpoint listing the sync committee messages and
+// contributions of the pool.
+const SyncCommitteePoolPath = "/prysm/v1/debug/sync_committee_pool"
+
+// SyncCommitteePoolContents lists the sync committee messages and contributions of the pool, by slot in
+// ascending order.
+func (s *Server) SyncCommitteePoolContents(w http.ResponseWriter, _ *http.Request) {
+	poolSlots, err := s.SyncCommitteePool.SyncCommitteePoolSlots()
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "Could not get sync committee pool slots: "+err.Error())
+		return
+	}
+	data := make([]*SyncCommitteePoolSlotJson, len(poolSlots))
+	for i, slot := range poolSlots {
+		msgs, err := s.SyncCommitteePool.SyncCommitteeMessages(slot)
+		if err != nil {
+			writeError(w, http.StatusInternalServerError, "Could not get sync committee messages: "+err.Error())
+			return
+		}
+		contributions, err := s.SyncCommitteePool.SyncCommitteeContributions(slot)
+		if err != nil {
+			writeError(w, http.StatusInternalServerError, "Could not get sync committee contributions: "+err.Error())
+			return
+		}
+		slotJson := &SyncCommitteePoolSlotJson{
+			Slot:          strconv.FormatUint(uint64(slot), 10),
+			Messages:      make([]*SyncCommitteeMessageJson, len(msgs)),
+			Contributions: make([]*SyncCommitteeContributionJson, len(contributions)),
+		}
+		for j, msg := range msgs {
+			slotJson.Messages[j] = &SyncCommitteeMessageJson{
+				ValidatorIndex:  strconv.FormatUint(uint64(msg.ValidatorIndex), 10),
+				BeaconBlockRoot: hexutil.Encode(msg.BlockRoot),
+				Signature:       hexutil.Encode(msg.Signature),
+			}
+		}
+		for j, c := range contributions {
+			slotJson.Contributions[j] = &SyncCommitteeContributionJson{
+				SubcommitteeIndex: strconv.FormatUint(c.SubcommitteeIndex, 10),
+				BeaconBlockRoot:   hexutil.Encode(c.BlockRoot),
+				AggregationBits:   hexutil.Encode(c.AggregationBits),
+				Signature:         hexutil.Encode(c.Signature),
+			}
+		}
+		data[i] = slotJson
+	}
+	writeJson(w, &SyncCommitteePoolResponse{Data: data})
+}
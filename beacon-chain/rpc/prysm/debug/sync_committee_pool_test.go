@@ -0,0 +1,54 @@
+package debug
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/synccommittee"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+)
+
+func TestSyncCommitteePoolContents(t *testing.T) {
+	pool := synccommittee.NewStore()
+	root := bytesutil.PadTo([]byte{'a'}, 32)
+	require.NoError(t, pool.SaveSyncCommitteeMessage(&ethpb.SyncCommitteeMessage{
+		Slot: 2, ValidatorIndex: 3, BlockRoot: root, Signature: []byte{'b'},
+	}))
+	require.NoError(t, pool.SaveSyncCommitteeMessage(&ethpb.SyncCommitteeMessage{
+		Slot: 1, ValidatorIndex: 4, BlockRoot: root, Signature: []byte{'c'},
+	}))
+	require.NoError(t, pool.SaveSyncCommitteeContribution(&ethpb.SyncCommitteeContribution{
+		Slot: 2, SubcommitteeIndex: 1, BlockRoot: root, AggregationBits: []byte{0b0101}, Signature: []byte{'d'},
+	}))
+
+	s := &Server{SyncCommitteePool: pool}
+	router := mux.NewRouter()
+	s.RegisterRoutes(router)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, SyncCommitteePoolPath, nil))
+	require.Equal(t, http.StatusOK, w.Code)
+	resp := &SyncCommitteePoolResponse{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), resp))
+
+	require.Equal(t, 2, len(resp.Data))
+	assert.Equal(t, "1", resp.Data[0].Slot)
+	require.Equal(t, 1, len(resp.Data[0].Messages))
+	assert.Equal(t, "4", resp.Data[0].Messages[0].ValidatorIndex)
+	assert.Equal(t, 0, len(resp.Data[0].Contributions))
+	assert.Equal(t, "2", resp.Data[1].Slot)
+	require.Equal(t, 1, len(resp.Data[1].Messages))
+	assert.Equal(t, "0x62", resp.Data[1].Messages[0].Signature)
+	require.Equal(t, 1, len(resp.Data[1].Contributions))
+	assert.DeepEqual(t, &SyncCommitteeContributionJson{
+		SubcommitteeIndex: "1",
+		BeaconBlockRoot:   "0x6100000000000000000000000000000000000000000000000000000000000000",
+		AggregationBits:   "0x05",
+		Signature:         "0x64",
+	}, resp.Data[1].Contributions[0])
+}
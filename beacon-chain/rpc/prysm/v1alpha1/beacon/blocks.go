@@ -71,7 +71,7 @@ func (bs *Server) ListBeaconBlocks(
 	if err != nil {
 		return nil, err
 	}
-	altCtrs, err := convertFromV1Containers(ctrs)
+	altCtrs, err := bs.convertFromV1Containers(ctx, ctrs)
 	if err != nil {
 		return nil, err
 	}
@@ -102,11 +102,18 @@ func (bs *Server) listBlocks(ctx context.Context, req *ethpb.ListBlocksRequest)
 	}
 }
 
-func convertFromV1Containers(ctrs []blockContainer) ([]*ethpb.BeaconBlockContainer, error) {
+func (bs *Server) convertFromV1Containers(ctx context.Context, ctrs []blockContainer) ([]*ethpb.BeaconBlockContainer, error) {
 	protoCtrs := make([]*ethpb.BeaconBlockContainer, len(ctrs))
 	var err error
 	for i, c := range ctrs {
-		protoCtrs[i], err = convertToBlockContainer(c.blk, c.root, c.isCanonical)
+		blk := c.blk
+		if blk.Block().IsBlinded() {
+			blk, err = bs.ExecutionPayloadReconstructor.ReconstructFullBellatrixBlock(ctx, blk)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "Could not reconstruct full execution payload: %v", err)
+			}
+		}
+		protoCtrs[i], err = convertToBlockContainer(blk, c.root, c.isCanonical)
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "Could not get block container: %v", err)
 		}
@@ -74,20 +74,13 @@ func (bs *Server) retrieveCommitteesForEpoch(
 	if err != nil {
 		return nil, nil, err
 	}
-	requestedState, err := bs.ReplayerBuilder.ReplayerForSlot(startSlot).ReplayBlocks(ctx)
+	products, err := bs.HistoricalEpochFetcher.EpochProducts(ctx, epoch)
 	if err != nil {
 		return nil, nil, status.Errorf(codes.Internal, "error replaying blocks for state at slot %d: %v", startSlot, err)
 	}
-	seed, err := helpers.Seed(requestedState, epoch, params.BeaconConfig().DomainBeaconAttester)
-	if err != nil {
-		return nil, nil, status.Error(codes.Internal, "Could not get seed")
-	}
-	activeIndices, err := helpers.ActiveValidatorIndices(ctx, requestedState, epoch)
-	if err != nil {
-		return nil, nil, status.Error(codes.Internal, "Could not get active indices")
-	}
+	activeIndices := products.ActiveIndices
 
-	committeesListsBySlot, err := computeCommittees(ctx, startSlot, activeIndices, seed)
+	committeesListsBySlot, err := computeCommittees(ctx, startSlot, activeIndices, products.Seed)
 	if err != nil {
 		return nil, nil, status.Errorf(
 			codes.InvalidArgument,
@@ -25,26 +25,28 @@ import (
 // Server defines a server implementation of the gRPC Beacon Chain service,
 // providing RPC endpoints to access data relevant to the Ethereum beacon chain.
 type Server struct {
-	BeaconDB                    db.ReadOnlyDatabase
-	Ctx                         context.Context
-	ChainStartFetcher           powchain.ChainStartFetcher
-	HeadFetcher                 blockchain.HeadFetcher
-	CanonicalFetcher            blockchain.CanonicalFetcher
-	FinalizationFetcher         blockchain.FinalizationFetcher
-	DepositFetcher              depositcache.DepositFetcher
-	BlockFetcher                powchain.POWBlockFetcher
-	GenesisTimeFetcher          blockchain.TimeFetcher
-	StateNotifier               statefeed.Notifier
-	BlockNotifier               blockfeed.Notifier
-	AttestationNotifier         operation.Notifier
-	Broadcaster                 p2p.Broadcaster
-	AttestationsPool            attestations.Pool
-	SlashingsPool               slashings.PoolManager
-	ChainStartChan              chan time.Time
-	ReceivedAttestationsBuffer  chan *ethpb.Attestation
-	CollectedAttestationsBuffer chan []*ethpb.Attestation
-	StateGen                    stategen.StateManager
-	SyncChecker                 sync.Checker
-	ReplayerBuilder             stategen.ReplayerBuilder
-	HeadUpdater                 blockchain.HeadUpdater
+	BeaconDB                      db.ReadOnlyDatabase
+	Ctx                           context.Context
+	ChainStartFetcher             powchain.ChainStartFetcher
+	HeadFetcher                   blockchain.HeadFetcher
+	CanonicalFetcher              blockchain.CanonicalFetcher
+	FinalizationFetcher           blockchain.FinalizationFetcher
+	DepositFetcher                depositcache.DepositFetcher
+	BlockFetcher                  powchain.POWBlockFetcher
+	GenesisTimeFetcher            blockchain.TimeFetcher
+	StateNotifier                 statefeed.Notifier
+	BlockNotifier                 blockfeed.Notifier
+	AttestationNotifier           operation.Notifier
+	Broadcaster                   p2p.Broadcaster
+	AttestationsPool              attestations.Pool
+	SlashingsPool                 slashings.PoolManager
+	ChainStartChan                chan time.Time
+	ReceivedAttestationsBuffer    chan *ethpb.Attestation
+	CollectedAttestationsBuffer   chan []*ethpb.Attestation
+	StateGen                      stategen.StateManager
+	SyncChecker                   sync.Checker
+	ReplayerBuilder               stategen.ReplayerBuilder
+	HistoricalEpochFetcher        stategen.HistoricalEpochFetcher
+	HeadUpdater                   blockchain.HeadUpdater
+	ExecutionPayloadReconstructor powchain.ExecutionPayloadReconstructor
 }
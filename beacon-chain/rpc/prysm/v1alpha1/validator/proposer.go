@@ -127,6 +127,9 @@ func (vs *Server) PrepareBeaconProposer(
 	if err := vs.BeaconDB.SaveFeeRecipientsByValidatorIDs(ctx, validatorIndices, feeRecipients); err != nil {
 		return nil, status.Errorf(codes.Internal, "Could not save fee recipients: %v", err)
 	}
+	if vs.ValidatorMonitor != nil {
+		vs.ValidatorMonitor.TrackValidators(validatorIndices)
+	}
 	log.WithFields(logrus.Fields{
 		"validatorIndices": validatorIndices,
 	}).Info("Updated fee recipient addresses for validator indices")
@@ -1,10 +1,14 @@
 package validator
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/signing"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/transition/interop"
+	p2pType "github.com/prysmaticlabs/prysm/beacon-chain/p2p/types"
 	"github.com/prysmaticlabs/prysm/config/params"
 	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/consensus-types/wrapper"
@@ -77,8 +81,11 @@ func (vs *Server) getAltairBeaconBlock(ctx context.Context, req *ethpb.BlockRequ
 
 // getSyncAggregate retrieves the sync contributions from the pool to construct the sync aggregate object.
 // The contributions are filtered based on matching of the input root and slot then profitability.
+// The sync committee messages of the pool then fill in the participants missing from the most
+// profitable contribution of each subcommittee. Pool messages are not verified on submission, so
+// the aggregate is verified when it includes messages, falling back to the contributions only.
 func (vs *Server) getSyncAggregate(ctx context.Context, slot types.Slot, root [32]byte) (*ethpb.SyncAggregate, error) {
-	_, span := trace.StartSpan(ctx, "ProposerServer.getSyncAggregate")
+	ctx, span := trace.StartSpan(ctx, "ProposerServer.getSyncAggregate")
 	defer span.End()
 
 	// Contributions have to match the input root
@@ -87,13 +94,19 @@ func (vs *Server) getSyncAggregate(ctx context.Context, slot types.Slot, root [3
 		return nil, err
 	}
 	proposerContributions := proposerSyncContributions(contributions).filterByBlockRoot(root)
+	participants, err := vs.syncMessageParticipants(ctx, slot, root)
+	if err != nil {
+		return nil, err
+	}
 
 	// Each sync subcommittee is 128 bits and the sync committee is 512 bits for mainnet.
-	var bitsHolder [][]byte
+	var contributionBits [][]byte
+	var mergedBits [][]byte
 	for i := uint64(0); i < params.BeaconConfig().SyncCommitteeSubnetCount; i++ {
-		bitsHolder = append(bitsHolder, ethpb.NewSyncCommitteeAggregationBits())
+		contributionBits = append(contributionBits, ethpb.NewSyncCommitteeAggregationBits())
 	}
-	sigsHolder := make([]bls.Signature, 0, params.BeaconConfig().SyncCommitteeSize/params.BeaconConfig().SyncCommitteeSubnetCount)
+	contributionSigs := make([]bls.Signature, 0, params.BeaconConfig().SyncCommitteeSize/params.BeaconConfig().SyncCommitteeSubnetCount)
+	var messageSigs []bls.Signature
 
 	for i := uint64(0); i < params.BeaconConfig().SyncCommitteeSubnetCount; i++ {
 		cs := proposerContributions.filterBySubIndex(i)
@@ -108,18 +121,44 @@ func (vs *Server) getSyncAggregate(ctx context.Context, slot types.Slot, root [3
 			return nil, err
 		}
 		c := deduped.mostProfitable()
-		if c == nil {
-			continue
+		if c != nil {
+			contributionBits[i] = c.AggregationBits
+			sig, err := bls.SignatureFromBytes(c.Signature)
+			if err != nil {
+				return nil, err
+			}
+			contributionSigs = append(contributionSigs, sig)
 		}
-		bitsHolder[i] = c.AggregationBits
-		sig, err := bls.SignatureFromBytes(c.Signature)
-		if err != nil {
-			return nil, err
+
+		// Add the messages of the participants which are not covered by the contribution.
+		bits := ethpb.ConvertToSyncContributionBitVector(bytesutil.SafeCopyBytes(contributionBits[i]))
+		for _, p := range participants[i] {
+			if bits.BitAt(p.index) {
+				continue
+			}
+			sig, err := bls.SignatureFromBytes(p.signature)
+			if err != nil {
+				return nil, err
+			}
+			bits.SetBitAt(p.index, true)
+			messageSigs = append(messageSigs, sig)
+		}
+		mergedBits = append(mergedBits, bits)
+	}
+
+	if len(messageSigs) > 0 {
+		merged := newSyncAggregate(mergedBits, append(contributionSigs, messageSigs...))
+		err := vs.verifySyncAggregate(ctx, slot, root, mergedBits, merged.SyncCommitteeSignature)
+		if err == nil {
+			return merged, nil
 		}
-		sigsHolder = append(sigsHolder, sig)
+		log.WithError(err).Warn("Could not verify sync aggregate with sync committee messages, using contributions only")
 	}
+	return newSyncAggregate(contributionBits, contributionSigs), nil
+}
 
-	// Aggregate all the contribution bits and signatures.
+// newSyncAggregate aggregates the bits and signatures of the sync subcommittees.
+func newSyncAggregate(bitsHolder [][]byte, sigsHolder []bls.Signature) *ethpb.SyncAggregate {
 	var syncBits []byte
 	for _, b := range bitsHolder {
 		syncBits = append(syncBits, b...)
@@ -131,9 +170,83 @@ func (vs *Server) getSyncAggregate(ctx context.Context, slot types.Slot, root [3
 	} else {
 		syncSigBytes = bytesutil.ToBytes96(syncSig.Marshal())
 	}
-
 	return &ethpb.SyncAggregate{
 		SyncCommitteeBits:      syncBits,
 		SyncCommitteeSignature: syncSigBytes[:],
-	}, nil
+	}
+}
+
+// verifySyncAggregate verifies the sync aggregate signature against the public keys of the
+// participants set in the subcommittee bits, for the input root at the slot.
+func (vs *Server) verifySyncAggregate(ctx context.Context, slot types.Slot, root [32]byte, bitsHolder [][]byte, signature []byte) error {
+	var pubKeys []bls.PublicKey
+	for i, b := range bitsHolder {
+		committeeKeys, err := vs.HeadFetcher.HeadSyncCommitteePubKeys(ctx, slot, types.CommitteeIndex(i))
+		if err != nil {
+			return errors.Wrap(err, "could not get sync committee public keys")
+		}
+		bits := ethpb.ConvertToSyncContributionBitVector(b)
+		for _, j := range bits.BitIndices() {
+			if j >= len(committeeKeys) {
+				return fmt.Errorf("participant %d out of range of subcommittee %d", j, i)
+			}
+			pubKey, err := bls.PublicKeyFromBytes(committeeKeys[j])
+			if err != nil {
+				return err
+			}
+			pubKeys = append(pubKeys, pubKey)
+		}
+	}
+	d, err := vs.HeadFetcher.HeadSyncCommitteeDomain(ctx, slot)
+	if err != nil {
+		return errors.Wrap(err, "could not get sync committee domain")
+	}
+	rawBytes := p2pType.SSZBytes(root[:])
+	signingRoot, err := signing.ComputeSigningRoot(&rawBytes, d)
+	if err != nil {
+		return err
+	}
+	sig, err := bls.SignatureFromBytes(signature)
+	if err != nil {
+		return err
+	}
+	if !sig.Eth2FastAggregateVerify(pubKeys, signingRoot) {
+		return errors.New("invalid sync aggregate signature")
+	}
+	return nil
+}
+
+// syncMessageParticipant is the position of a sync committee message signer in its subcommittee.
+type syncMessageParticipant struct {
+	index     uint64
+	signature []byte
+}
+
+// syncMessageParticipants returns the participants of the sync committee messages of the pool which
+// vote for the input root at the slot, by subcommittee index.
+func (vs *Server) syncMessageParticipants(ctx context.Context, slot types.Slot, root [32]byte) (map[uint64][]syncMessageParticipant, error) {
+	msgs, err := vs.SyncCommitteePool.SyncCommitteeMessages(slot)
+	if err != nil {
+		return nil, err
+	}
+	subCommitteeSize := params.BeaconConfig().SyncCommitteeSize / params.BeaconConfig().SyncCommitteeSubnetCount
+	participants := make(map[uint64][]syncMessageParticipant)
+	for _, msg := range msgs {
+		if !bytes.Equal(msg.BlockRoot, root[:]) {
+			continue
+		}
+		// A validator may have several positions in the sync committee, each of them is a participant.
+		indices, err := vs.HeadFetcher.HeadSyncCommitteeIndices(ctx, msg.ValidatorIndex, slot)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not get sync committee indices")
+		}
+		for _, index := range indices {
+			i := uint64(index)
+			participants[i/subCommitteeSize] = append(participants[i/subCommitteeSize], syncMessageParticipant{
+				index:     i % subCommitteeSize,
+				signature: msg.Signature,
+			})
+		}
+	}
+	return participants, nil
 }
@@ -2,9 +2,13 @@ package validator
 
 import (
 	"context"
+	"fmt"
+	"math"
 	"sort"
 
 	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
 	"github.com/prysmaticlabs/go-bitfield"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/altair"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
@@ -13,18 +17,34 @@ import (
 	"github.com/prysmaticlabs/prysm/config/params"
 	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
 	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/attestation"
 	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/attestation/aggregation"
 	attaggregation "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/attestation/aggregation/attestations"
 	"github.com/prysmaticlabs/prysm/runtime/version"
+	"github.com/prysmaticlabs/prysm/time/slots"
 	"go.opencensus.io/trace"
 )
 
+// expectedAttestationReward tracks the proposer reward expected from the attestations packed into the last produced block.
+var expectedAttestationReward = promauto.NewGauge(prometheus.GaugeOpts{
+	Namespace: "proposer",
+	Name:      "expected_attestation_reward_gwei",
+	Help:      "The proposer reward in Gwei expected from the attestations packed into the last produced block.",
+})
+
 type proposerAtts []*ethpb.Attestation
 
 func (vs *Server) packAttestations(ctx context.Context, latestState state.BeaconState) ([]*ethpb.Attestation, error) {
 	ctx, span := trace.StartSpan(ctx, "ProposerServer.packAttestations")
 	defer span.End()
 
+	// Filtering processes the attestations on the state, setting the participation flags they are
+	// rewarded for. Rewards are computed on a copy of the state taken beforehand.
+	var preState state.BeaconState
+	if latestState.Version() != version.Phase0 {
+		preState = latestState.Copy()
+	}
+
 	atts := vs.AttPool.AggregatedAttestations()
 	atts, err := vs.validateAndDeleteAttsInPool(ctx, latestState, atts)
 	if err != nil {
@@ -69,12 +89,21 @@ func (vs *Server) packAttestations(ctx context.Context, latestState state.Beacon
 	if err != nil {
 		return nil, err
 	}
-	sorted, err := deduped.sortByProfitability()
+	if latestState.Version() == version.Phase0 {
+		sorted, err := deduped.sortByProfitability()
+		if err != nil {
+			return nil, err
+		}
+		return sorted.limitToMaxAttestations(), nil
+	}
+	// Since Altair, attestations only earn rewards for participation flags that are not set yet,
+	// so they are selected by the reward they add on top of the state and the already selected ones.
+	selected, reward, err := deduped.selectByReward(ctx, preState)
 	if err != nil {
 		return nil, err
 	}
-	atts = sorted.limitToMaxAttestations()
-	return atts, nil
+	expectedAttestationReward.Set(float64(reward))
+	return selected, nil
 }
 
 // filter separates attestation list into two groups: valid and invalid attestations.
@@ -186,6 +215,184 @@ func (a proposerAtts) sortByProfitabilityUsingMaxCover() (proposerAtts, error) {
 	return sortedAtts, nil
 }
 
+// rewardCandidate is an attestation considered for inclusion, along with the validators and
+// participation flags it would be credited for.
+type rewardCandidate struct {
+	att      *ethpb.Attestation
+	indices  []uint64
+	flags    map[uint8]bool
+	current  bool
+	selected bool
+	// reward is the proposer reward numerator the attestation would add, as of its last evaluation.
+	reward uint64
+}
+
+// selectByReward greedily selects up to the maximum attestations per block, each time picking the
+// attestation that adds the highest proposer reward given the participation flags already set in the
+// state and by previously selected attestations. Attestations that add no reward fill the remaining
+// room, most recent first. It returns the selected attestations along with the expected proposer
+// reward in Gwei.
+func (a proposerAtts) selectByReward(ctx context.Context, st state.BeaconState) (proposerAtts, uint64, error) {
+	ctx, span := trace.StartSpan(ctx, "ProposerServer.selectByReward")
+	defer span.End()
+
+	if len(a) == 0 {
+		return a, 0, nil
+	}
+	totalBalance, err := helpers.TotalActiveBalance(st)
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "could not get total active balance")
+	}
+	currParticipation, err := st.CurrentEpochParticipation()
+	if err != nil {
+		return nil, 0, err
+	}
+	prevParticipation, err := st.PreviousEpochParticipation()
+	if err != nil {
+		return nil, 0, err
+	}
+	currentEpoch := slots.ToEpoch(st.Slot())
+
+	candidates := make([]*rewardCandidate, 0, len(a))
+	for _, att := range a {
+		committee, err := helpers.BeaconCommitteeFromState(ctx, st, att.Data.Slot, att.Data.CommitteeIndex)
+		if err != nil {
+			return nil, 0, err
+		}
+		indices, err := attestation.AttestingIndices(att.AggregationBits, committee)
+		if err != nil {
+			return nil, 0, err
+		}
+		flags, err := altair.AttestationParticipationFlagIndices(st, att.Data, st.Slot()-att.Data.Slot)
+		if err != nil {
+			return nil, 0, err
+		}
+		candidates = append(candidates, &rewardCandidate{
+			att:     att,
+			indices: indices,
+			flags:   flags,
+			current: att.Data.Target.Epoch == currentEpoch,
+			reward:  math.MaxUint64,
+		})
+	}
+
+	baseRewards := make(map[uint64]uint64)
+	marginalReward := func(c *rewardCandidate, participation []byte, apply bool) (uint64, error) {
+		var numerator uint64
+		for _, idx := range c.indices {
+			if idx >= uint64(len(participation)) {
+				return 0, fmt.Errorf("index %d exceeds participation length %d", idx, len(participation))
+			}
+			br, ok := baseRewards[idx]
+			if !ok {
+				br, err = altair.BaseRewardWithTotalBalance(st, types.ValidatorIndex(idx), totalBalance)
+				if err != nil {
+					return 0, err
+				}
+				baseRewards[idx] = br
+			}
+			for _, f := range participationFlagWeights() {
+				if !c.flags[f.index] {
+					continue
+				}
+				has, err := altair.HasValidatorFlag(participation[idx], f.index)
+				if err != nil {
+					return 0, err
+				}
+				if has {
+					continue
+				}
+				numerator += br * f.weight
+				if apply {
+					participation[idx], err = altair.AddValidatorFlag(participation[idx], f.index)
+					if err != nil {
+						return 0, err
+					}
+				}
+			}
+		}
+		return numerator, nil
+	}
+
+	maxAtts := params.BeaconConfig().MaxAttestations
+	selected := make(proposerAtts, 0, maxAtts)
+	var totalNumerator uint64
+	for uint64(len(selected)) < maxAtts {
+		var best *rewardCandidate
+		for _, c := range candidates {
+			// Rewards only decrease as flags get set, so a candidate that was not better than the
+			// best one so far cannot have become better.
+			if c.reward == 0 || (best != nil && c.reward <= best.reward) {
+				continue
+			}
+			participation := prevParticipation
+			if c.current {
+				participation = currParticipation
+			}
+			if c.reward, err = marginalReward(c, participation, false); err != nil {
+				return nil, 0, err
+			}
+			if c.reward > 0 && (best == nil || c.reward > best.reward ||
+				(c.reward == best.reward && c.att.Data.Slot > best.att.Data.Slot)) {
+				best = c
+			}
+		}
+		if best == nil {
+			break
+		}
+		participation := prevParticipation
+		if best.current {
+			participation = currParticipation
+		}
+		if _, err := marginalReward(best, participation, true); err != nil {
+			return nil, 0, err
+		}
+		totalNumerator += best.reward
+		best.reward = 0
+		best.selected = true
+		selected = append(selected, best.att)
+	}
+
+	// Attestations adding no reward are still valid, they are packed with the lowest priority.
+	var fillers proposerAtts
+	for _, c := range candidates {
+		if !c.selected {
+			fillers = append(fillers, c.att)
+		}
+	}
+	sort.SliceStable(fillers, func(i, j int) bool {
+		if fillers[i].Data.Slot != fillers[j].Data.Slot {
+			return fillers[i].Data.Slot > fillers[j].Data.Slot
+		}
+		return fillers[i].AggregationBits.Count() > fillers[j].AggregationBits.Count()
+	})
+	for _, att := range fillers {
+		if uint64(len(selected)) >= maxAtts {
+			break
+		}
+		selected = append(selected, att)
+	}
+
+	cfg := params.BeaconConfig()
+	denominator := (cfg.WeightDenominator - cfg.ProposerWeight) * cfg.WeightDenominator / cfg.ProposerWeight
+	return selected, totalNumerator / denominator, nil
+}
+
+type participationFlagWeight struct {
+	index  uint8
+	weight uint64
+}
+
+// participationFlagWeights returns the timely source, target and head flag indices with their reward weights.
+func participationFlagWeights() []participationFlagWeight {
+	cfg := params.BeaconConfig()
+	return []participationFlagWeight{
+		{index: cfg.TimelySourceFlagIndex, weight: cfg.TimelySourceWeight},
+		{index: cfg.TimelyTargetFlagIndex, weight: cfg.TimelyTargetWeight},
+		{index: cfg.TimelyHeadFlagIndex, weight: cfg.TimelyHeadWeight},
+	}
+}
+
 // limitToMaxAttestations limits attestations to maximum attestations per block.
 func (a proposerAtts) limitToMaxAttestations() proposerAtts {
 	if uint64(len(a)) > params.BeaconConfig().MaxAttestations {
@@ -2,10 +2,16 @@ package validator
 
 import (
 	"bytes"
+	"context"
 	"sort"
 	"testing"
 
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/altair"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/attestations"
+	"github.com/prysmaticlabs/prysm/config/params"
 	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
 	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/testing/assert"
@@ -188,6 +194,77 @@ func TestProposer_ProposerAtts_sortByProfitabilityUsingMaxCover(t *testing.T) {
 	})
 }
 
+func TestProposer_ProposerAtts_selectByReward(t *testing.T) {
+	ctx := context.Background()
+	st, _ := util.DeterministicGenesisStateAltair(t, 256)
+	require.NoError(t, st.SetSlot(1))
+	committee, err := helpers.BeaconCommitteeFromState(ctx, st, 0, 0)
+	require.NoError(t, err)
+	require.Equal(t, 8, len(committee))
+
+	// The last validator of the committee already had its attestation included.
+	participation, err := st.CurrentEpochParticipation()
+	require.NoError(t, err)
+	for _, f := range participationFlagWeights() {
+		participation[committee[7]], err = altair.AddValidatorFlag(participation[committee[7]], f.index)
+		require.NoError(t, err)
+	}
+	require.NoError(t, st.SetCurrentParticipationBits(participation))
+
+	newAtt := func(positions ...uint64) *ethpb.Attestation {
+		bits := bitfield.NewBitlist(uint64(len(committee)))
+		for _, p := range positions {
+			bits.SetBitAt(p, true)
+		}
+		return util.HydrateAttestation(&ethpb.Attestation{AggregationBits: bits})
+	}
+	superset := newAtt(0, 1, 2)
+	subset := newAtt(0, 1)
+	alreadyIncluded := newAtt(7)
+	disjoint := newAtt(3, 4)
+
+	selected, reward, err := proposerAtts{subset, alreadyIncluded, disjoint, superset}.selectByReward(ctx, st)
+	require.NoError(t, err)
+	// Attestations adding no reward come last.
+	require.DeepEqual(t, proposerAtts{superset, disjoint, subset, alreadyIncluded}, selected)
+
+	totalBalance, err := helpers.TotalActiveBalance(st)
+	require.NoError(t, err)
+	cfg := params.BeaconConfig()
+	var numerator uint64
+	for _, idx := range committee[:5] {
+		br, err := altair.BaseRewardWithTotalBalance(st, idx, totalBalance)
+		require.NoError(t, err)
+		numerator += br * (cfg.TimelySourceWeight + cfg.TimelyTargetWeight + cfg.TimelyHeadWeight)
+	}
+	assert.Equal(t, numerator/((cfg.WeightDenominator-cfg.ProposerWeight)*cfg.WeightDenominator/cfg.ProposerWeight), reward)
+
+	selected, reward, err = proposerAtts{alreadyIncluded}.selectByReward(ctx, st)
+	require.NoError(t, err)
+	assert.DeepEqual(t, proposerAtts{alreadyIncluded}, selected)
+	assert.Equal(t, uint64(0), reward)
+}
+
+func TestProposer_PackAttestations_Altair(t *testing.T) {
+	ctx := context.Background()
+	st, _ := util.DeterministicGenesisStateAltair(t, 256)
+	require.NoError(t, st.SetSlot(1))
+	committee, err := helpers.BeaconCommitteeFromState(ctx, st, 0, 0)
+	require.NoError(t, err)
+
+	bits := bitfield.NewBitlist(uint64(len(committee)))
+	bits.SetBitAt(0, true)
+	att := util.HydrateAttestation(&ethpb.Attestation{AggregationBits: bits})
+	vs := &Server{AttPool: attestations.NewPool()}
+	require.NoError(t, vs.AttPool.SaveUnaggregatedAttestation(att))
+
+	atts, err := vs.packAttestations(ctx, st)
+	require.NoError(t, err)
+	require.DeepEqual(t, []*ethpb.Attestation{att}, atts)
+	// The reward is computed on the state before the attestation was processed.
+	assert.Equal(t, true, testutil.ToFloat64(expectedAttestationReward) > 0)
+}
+
 func TestProposer_ProposerAtts_dedup(t *testing.T) {
 	data1 := util.HydrateAttestationData(&ethpb.AttestationData{
 		Slot: 4,
@@ -16,11 +16,13 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/signing"
 	coretime "github.com/prysmaticlabs/prysm/beacon-chain/core/time"
 	dbutil "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/monitor"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/attestations"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/slashings"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/synccommittee"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/voluntaryexits"
 	mockp2p "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
+	p2ptypes "github.com/prysmaticlabs/prysm/beacon-chain/p2p/types"
 	mockPOW "github.com/prysmaticlabs/prysm/beacon-chain/powchain/testing"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
@@ -2491,6 +2493,103 @@ func TestProposer_GetSyncAggregate_OK(t *testing.T) {
 	require.DeepEqual(t, bitfield.NewBitvector512(), aggregate.SyncCommitteeBits)
 }
 
+// setupSyncAggregateMessages saves a contribution of the first member of subcommittee 0 to the pool of the
+// server, and returns the signing root and keys of the subcommittee. The members at position 1 of
+// subcommittees 0 and 1 belong to the same validator.
+func setupSyncAggregateMessages(t *testing.T) (*Server, [32]byte, []bls.SecretKey) {
+	subCommitteeSize := params.BeaconConfig().SyncCommitteeSize / params.BeaconConfig().SyncCommitteeSubnetCount
+	keys := make([]bls.SecretKey, subCommitteeSize)
+	pubKeys := make([][]byte, subCommitteeSize)
+	for i := range keys {
+		key, err := bls.RandKey()
+		require.NoError(t, err)
+		keys[i] = key
+		pubKeys[i] = key.PublicKey().Marshal()
+	}
+	domain := make([]byte, 32)
+	proposerServer := &Server{
+		HeadFetcher: &mock.ChainService{
+			SyncCommitteeIndices: []types.CommitteeIndex{1, types.CommitteeIndex(subCommitteeSize + 1)},
+			SyncCommitteePubkeys: pubKeys,
+			SyncCommitteeDomain:  domain,
+		},
+		SyncCommitteePool: synccommittee.NewStore(),
+	}
+
+	r := params.BeaconConfig().ZeroHash
+	rawBytes := p2ptypes.SSZBytes(r[:])
+	signingRoot, err := signing.ComputeSigningRoot(&rawBytes, domain)
+	require.NoError(t, err)
+	bits := ethpb.NewSyncCommitteeAggregationBits()
+	bits.SetBitAt(0, true)
+	require.NoError(t, proposerServer.SyncCommitteePool.SaveSyncCommitteeContribution(&ethpb.SyncCommitteeContribution{
+		Slot: 1, SubcommitteeIndex: 0, Signature: keys[0].Sign(signingRoot[:]).Marshal(), AggregationBits: bits, BlockRoot: r[:],
+	}))
+	return proposerServer, signingRoot, keys
+}
+
+func TestProposer_GetSyncAggregate_MergesMessages(t *testing.T) {
+	proposerServer, signingRoot, keys := setupSyncAggregateMessages(t)
+	r := params.BeaconConfig().ZeroHash
+	require.NoError(t, proposerServer.SyncCommitteePool.SaveSyncCommitteeMessage(&ethpb.SyncCommitteeMessage{
+		Slot: 1, ValidatorIndex: 1, Signature: keys[1].Sign(signingRoot[:]).Marshal(), BlockRoot: r[:],
+	}))
+	// Messages voting for another root are not included.
+	require.NoError(t, proposerServer.SyncCommitteePool.SaveSyncCommitteeMessage(&ethpb.SyncCommitteeMessage{
+		Slot: 1, ValidatorIndex: 2, Signature: keys[2].Sign(signingRoot[:]).Marshal(), BlockRoot: bytesutil.PadTo([]byte{'a'}, 32),
+	}))
+
+	aggregate, err := proposerServer.getSyncAggregate(context.Background(), 1, r)
+	require.NoError(t, err)
+	var wanted []byte
+	for i := uint64(0); i < params.BeaconConfig().SyncCommitteeSubnetCount; i++ {
+		subBits := ethpb.NewSyncCommitteeAggregationBits()
+		switch i {
+		case 0:
+			subBits.SetBitAt(0, true)
+			subBits.SetBitAt(1, true)
+		case 1:
+			subBits.SetBitAt(1, true)
+		}
+		wanted = append(wanted, subBits...)
+	}
+	require.DeepEqual(t, bitfield.Bitvector512(wanted), aggregate.SyncCommitteeBits)
+	wantedSig := bls.AggregateSignatures([]bls.Signature{
+		keys[0].Sign(signingRoot[:]), keys[1].Sign(signingRoot[:]), keys[1].Sign(signingRoot[:]),
+	})
+	require.DeepEqual(t, wantedSig.Marshal(), aggregate.SyncCommitteeSignature)
+
+	// The contribution in the pool is left untouched.
+	conts, err := proposerServer.SyncCommitteePool.SyncCommitteeContributions(1)
+	require.NoError(t, err)
+	bits := ethpb.NewSyncCommitteeAggregationBits()
+	bits.SetBitAt(0, true)
+	require.DeepEqual(t, bits, conts[0].AggregationBits)
+}
+
+func TestProposer_GetSyncAggregate_InvalidMessage(t *testing.T) {
+	proposerServer, signingRoot, keys := setupSyncAggregateMessages(t)
+	r := params.BeaconConfig().ZeroHash
+	// The message is signed with the key of another member of the subcommittee.
+	require.NoError(t, proposerServer.SyncCommitteePool.SaveSyncCommitteeMessage(&ethpb.SyncCommitteeMessage{
+		Slot: 1, ValidatorIndex: 1, Signature: keys[2].Sign(signingRoot[:]).Marshal(), BlockRoot: r[:],
+	}))
+
+	aggregate, err := proposerServer.getSyncAggregate(context.Background(), 1, r)
+	require.NoError(t, err)
+	// Only the contribution is included.
+	var wanted []byte
+	for i := uint64(0); i < params.BeaconConfig().SyncCommitteeSubnetCount; i++ {
+		subBits := ethpb.NewSyncCommitteeAggregationBits()
+		if i == 0 {
+			subBits.SetBitAt(0, true)
+		}
+		wanted = append(wanted, subBits...)
+	}
+	require.DeepEqual(t, bitfield.Bitvector512(wanted), aggregate.SyncCommitteeBits)
+	require.DeepEqual(t, keys[0].Sign(signingRoot[:]).Marshal(), aggregate.SyncCommitteeSignature)
+}
+
 func TestProposer_PrepareBeaconProposer(t *testing.T) {
 	type args struct {
 		request *ethpb.PrepareBeaconProposerRequest
@@ -2533,8 +2632,10 @@ func TestProposer_PrepareBeaconProposer(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			db := dbutil.SetupDB(t)
 			ctx := context.Background()
-			proposerServer := &Server{BeaconDB: db}
-			_, err := proposerServer.PrepareBeaconProposer(ctx, tt.args.request)
+			validatorMonitor, err := monitor.NewService(ctx, &monitor.ValidatorMonitorConfig{}, nil)
+			require.NoError(t, err)
+			proposerServer := &Server{BeaconDB: db, ValidatorMonitor: validatorMonitor}
+			_, err = proposerServer.PrepareBeaconProposer(ctx, tt.args.request)
 			if tt.wantErr != "" {
 				require.ErrorContains(t, tt.wantErr, err)
 				return
@@ -2543,7 +2644,7 @@ func TestProposer_PrepareBeaconProposer(t *testing.T) {
 			address, err := proposerServer.BeaconDB.FeeRecipientByValidatorID(ctx, 1)
 			require.NoError(t, err)
 			require.Equal(t, common.BytesToAddress(tt.args.request.Recipients[0].FeeRecipient), address)
-
+			require.DeepEqual(t, []types.ValidatorIndex{1}, validatorMonitor.TrackedIndices())
 		})
 	}
 }
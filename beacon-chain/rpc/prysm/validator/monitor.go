@@ -0,0 +1,175 @@
+package validator
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"github.com/prysmaticlabs/prysm/api/gateway/apimiddleware"
+	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/beacon-chain/monitor"
+	"github.com/prysmaticlabs/prysm/cmd"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+)
+
+const (
+	// MonitorPath is the path of the endpoint listing and changing the validators tracked by the validator monitor.
+	MonitorPath = "/prysm/v1/validator/monitor"
+	// MonitorSummariesPath is the path of the endpoint serving the epoch summaries of a tracked validator.
+	MonitorSummariesPath = MonitorPath + "/{index}"
+
+	// maxIndexJsonLength is the length of the largest JSON encoded validator index, with its quotes and separator.
+	maxIndexJsonLength = 23
+)
+
+// ValidatorMonitor changes the validators tracked by the validator monitor and reports their performance.
+type ValidatorMonitor interface {
+	monitor.ValidatorTracker
+	Summaries(idx types.ValidatorIndex) ([]monitor.EpochSummary, bool)
+}
+
+// Server defines the HTTP handlers of the validator monitor endpoints.
+type Server struct {
+	ValidatorMonitor ValidatorMonitor
+	HeadFetcher      blockchain.HeadFetcher
+}
+
+// RegisterRoutes registers the read-only validator monitor endpoints on the router.
+func (s *Server) RegisterRoutes(r *mux.Router) {
+	r.HandleFunc(MonitorPath, s.TrackedValidators).Methods(http.MethodGet)
+	r.HandleFunc(MonitorSummariesPath, s.EpochSummaries).Methods(http.MethodGet)
+}
+
+// RegisterTrackingRoutes registers the endpoints changing the tracked validators on the router.
+func (s *Server) RegisterTrackingRoutes(r *mux.Router) {
+	r.HandleFunc(MonitorPath, s.TrackValidators).Methods(http.MethodPost)
+	r.HandleFunc(MonitorPath, s.UntrackValidators).Methods(http.MethodDelete)
+}
+
+// TrackedValidators returns the indices of the tracked validators.
+func (s *Server) TrackedValidators(w http.ResponseWriter, _ *http.Request) {
+	tracked := s.ValidatorMonitor.TrackedIndices()
+	resp := &TrackedValidatorsResponse{Data: make([]string, len(tracked))}
+	for i, idx := range tracked {
+		resp.Data[i] = strconv.FormatUint(uint64(idx), 10)
+	}
+	writeJson(w, resp)
+}
+
+// TrackValidators adds the validator indices from the request body to the tracked validators.
+// The indices must be in the validator registry of the head state.
+func (s *Server) TrackValidators(w http.ResponseWriter, req *http.Request) {
+	indices, ok := decodeIndices(w, req)
+	if !ok {
+		return
+	}
+	headState, err := s.HeadFetcher.HeadState(req.Context())
+	if err != nil {
+		apimiddleware.WriteError(w, apimiddleware.InternalServerErrorWithMessage(err, "could not get head state"), nil)
+		return
+	}
+	numValidators := uint64(headState.NumValidators())
+	for _, idx := range indices {
+		if uint64(idx) >= numValidators {
+			writeBadRequest(w, fmt.Sprintf("Validator index %d is out of range, the head state has %d validators", idx, numValidators))
+			return
+		}
+	}
+	s.ValidatorMonitor.TrackValidators(indices)
+	w.WriteHeader(http.StatusOK)
+}
+
+// UntrackValidators removes the validator indices from the request body from the tracked validators.
+func (s *Server) UntrackValidators(w http.ResponseWriter, req *http.Request) {
+	indices, ok := decodeIndices(w, req)
+	if !ok {
+		return
+	}
+	s.ValidatorMonitor.UntrackValidators(indices)
+	w.WriteHeader(http.StatusOK)
+}
+
+// EpochSummaries returns the per-epoch performance summaries of a tracked validator.
+func (s *Server) EpochSummaries(w http.ResponseWriter, req *http.Request) {
+	rawIdx := mux.Vars(req)["index"]
+	idx, err := strconv.ParseUint(rawIdx, 10, 64)
+	if err != nil {
+		writeBadRequest(w, "Invalid validator index "+rawIdx)
+		return
+	}
+	summaries, ok := s.ValidatorMonitor.Summaries(types.ValidatorIndex(idx))
+	if !ok {
+		apimiddleware.WriteError(w, &apimiddleware.DefaultErrorJson{
+			Message: "Validator " + rawIdx + " is not tracked",
+			Code:    http.StatusNotFound,
+		}, nil)
+		return
+	}
+	resp := &EpochSummariesResponse{Data: make([]*EpochSummaryJson, len(summaries))}
+	for i, summary := range summaries {
+		resp.Data[i] = &EpochSummaryJson{
+			Epoch:                     strconv.FormatUint(uint64(summary.Epoch), 10),
+			AttestationIncluded:       summary.AttestationIncluded,
+			InclusionDelay:            strconv.FormatUint(uint64(summary.InclusionDelay), 10),
+			CorrectSource:             summary.CorrectSource,
+			CorrectTarget:             summary.CorrectTarget,
+			CorrectHead:               summary.CorrectHead,
+			ProposedBlocks:            strconv.FormatUint(summary.ProposedBlocks, 10),
+			SyncContributions:         strconv.FormatUint(summary.SyncContributions, 10),
+			ExpectedSyncContributions: strconv.FormatUint(summary.ExpectedSyncContributions, 10),
+			StartBalance:              strconv.FormatUint(summary.StartBalance, 10),
+			EndBalance:                strconv.FormatUint(summary.EndBalance, 10),
+			BalanceDelta:              strconv.FormatInt(summary.BalanceDelta(), 10),
+		}
+	}
+	writeJson(w, resp)
+}
+
+// decodeIndices decodes a JSON array of validator indices from the request body, writing an error
+// response if the body is invalid. A request holds at most the maximum RPC page size of indices.
+func decodeIndices(w http.ResponseWriter, req *http.Request) ([]types.ValidatorIndex, bool) {
+	maxIndices := cmd.Get().MaxRPCPageSize
+	body := http.MaxBytesReader(w, req.Body, int64(maxIndices*maxIndexJsonLength))
+	var rawIndices []string
+	if err := json.NewDecoder(body).Decode(&rawIndices); err != nil {
+		writeBadRequest(w, "Could not decode validator indices: "+err.Error())
+		return nil, false
+	}
+	if len(rawIndices) > maxIndices {
+		writeBadRequest(w, fmt.Sprintf("Requested %d validator indices, exceeding the maximum of %d", len(rawIndices), maxIndices))
+		return nil, false
+	}
+	indices := make([]types.ValidatorIndex, len(rawIndices))
+	for i, rawIdx := range rawIndices {
+		idx, err := strconv.ParseUint(rawIdx, 10, 64)
+		if err != nil {
+			writeBadRequest(w, "Invalid validator index "+rawIdx)
+			return nil, false
+		}
+		indices[i] = types.ValidatorIndex(idx)
+	}
+	return indices, true
+}
+
+func writeBadRequest(w http.ResponseWriter, msg string) {
+	apimiddleware.WriteError(w, &apimiddleware.DefaultErrorJson{
+		Message: msg,
+		Code:    http.StatusBadRequest,
+	}, nil)
+}
+
+func writeJson(w http.ResponseWriter, v interface{}) {
+	j, err := json.Marshal(v)
+	if err != nil {
+		apimiddleware.WriteError(w, apimiddleware.InternalServerErrorWithMessage(err, "could not marshal response"), nil)
+		return
+	}
+	w.Header().Set("Content-Length", strconv.Itoa(len(j)))
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if _, err := w.Write(j); err != nil {
+		log.WithError(err).Error("Could not write response")
+	}
+}
@@ -0,0 +1,128 @@
+package validator
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/monitor"
+	"github.com/prysmaticlabs/prysm/cmd"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/util"
+)
+
+type mockMonitor struct {
+	tracked map[types.ValidatorIndex][]monitor.EpochSummary
+}
+
+func (m *mockMonitor) TrackValidators(indices []types.ValidatorIndex) {
+	for _, idx := range indices {
+		if _, ok := m.tracked[idx]; !ok {
+			m.tracked[idx] = nil
+		}
+	}
+}
+
+func (m *mockMonitor) UntrackValidators(indices []types.ValidatorIndex) {
+	for _, idx := range indices {
+		delete(m.tracked, idx)
+	}
+}
+
+func (m *mockMonitor) TrackedIndices() []types.ValidatorIndex {
+	indices := make([]types.ValidatorIndex, 0, len(m.tracked))
+	for idx := range m.tracked {
+		indices = append(indices, idx)
+	}
+	sort.Slice(indices, func(i, j int) bool { return indices[i] < indices[j] })
+	return indices
+}
+
+func (m *mockMonitor) Summaries(idx types.ValidatorIndex) ([]monitor.EpochSummary, bool) {
+	summaries, ok := m.tracked[idx]
+	return summaries, ok
+}
+
+func setupRouter(t *testing.T) (*mux.Router, *mockMonitor) {
+	m := &mockMonitor{tracked: map[types.ValidatorIndex][]monitor.EpochSummary{
+		3: {{Epoch: 10, AttestationIncluded: true, InclusionDelay: 1, CorrectHead: true, ProposedBlocks: 1, StartBalance: 32000000000, EndBalance: 31999000000}},
+	}}
+	st, _ := util.DeterministicGenesisState(t, 8)
+	s := &Server{ValidatorMonitor: m, HeadFetcher: &mock.ChainService{State: st}}
+	r := mux.NewRouter()
+	s.RegisterRoutes(r)
+	s.RegisterTrackingRoutes(r)
+	return r, m
+}
+
+func serve(r *mux.Router, method, path, body string) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(method, path, strings.NewReader(body)))
+	return w
+}
+
+func TestTrackValidators(t *testing.T) {
+	r, m := setupRouter(t)
+
+	w := serve(r, http.MethodPost, MonitorPath, `["5"]`)
+	assert.Equal(t, http.StatusOK, w.Code)
+	w = serve(r, http.MethodGet, MonitorPath, "")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, `{"data":["3","5"]}`, w.Body.String())
+
+	w = serve(r, http.MethodDelete, MonitorPath, `["3"]`)
+	assert.Equal(t, http.StatusOK, w.Code)
+	_, ok := m.tracked[3]
+	assert.Equal(t, false, ok)
+
+	w = serve(r, http.MethodPost, MonitorPath, `["foo"]`)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Equal(t, `{"message":"Invalid validator index foo","code":400}`, w.Body.String())
+
+	w = serve(r, http.MethodPost, MonitorPath, `["7","8"]`)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Equal(t, `{"message":"Validator index 8 is out of range, the head state has 8 validators","code":400}`, w.Body.String())
+	_, ok = m.tracked[7]
+	assert.Equal(t, false, ok)
+
+	maxIndices := cmd.Get().MaxRPCPageSize
+	rawIndices := make([]string, maxIndices+1)
+	for i := range rawIndices {
+		rawIndices[i] = `"1"`
+	}
+	w = serve(r, http.MethodPost, MonitorPath, "["+strings.Join(rawIndices, ",")+"]")
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Equal(t, fmt.Sprintf(`{"message":"Requested %d validator indices, exceeding the maximum of %d","code":400}`, maxIndices+1, maxIndices), w.Body.String())
+}
+
+func TestTrackingRoutes_NotRegistered(t *testing.T) {
+	r := mux.NewRouter()
+	(&Server{ValidatorMonitor: &mockMonitor{}}).RegisterRoutes(r)
+
+	w := serve(r, http.MethodPost, MonitorPath, `["1"]`)
+	assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+	w = serve(r, http.MethodDelete, MonitorPath, `["1"]`)
+	assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+}
+
+func TestEpochSummaries(t *testing.T) {
+	r, _ := setupRouter(t)
+
+	w := serve(r, http.MethodGet, MonitorPath+"/3", "")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "application/json", w.Header().Get("Content-Type"))
+	assert.Equal(t,
+		`{"data":[{"epoch":"10","attestation_included":true,"inclusion_delay":"1","correct_source":false,"correct_target":false,"correct_head":true,"proposed_blocks":"1","sync_contributions":"0","expected_sync_contributions":"0","start_balance":"32000000000","end_balance":"31999000000","balance_delta":"-1000000"}]}`,
+		w.Body.String(),
+	)
+
+	w = serve(r, http.MethodGet, MonitorPath+"/4", "")
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	assert.Equal(t, `{"message":"Validator 4 is not tracked","code":404}`, w.Body.String())
+}
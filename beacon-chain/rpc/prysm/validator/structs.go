@@ -0,0 +1,27 @@
+package validator
+
+// TrackedValidatorsResponse lists the validator indices tracked by the validator monitor.
+type TrackedValidatorsResponse struct {
+	Data []string `json:"data"`
+}
+
+// EpochSummariesResponse contains the epoch summaries of a tracked validator.
+type EpochSummariesResponse struct {
+	Data []*EpochSummaryJson `json:"data"`
+}
+
+// EpochSummaryJson is the JSON representation of a validator's performance during an epoch.
+type EpochSummaryJson struct {
+	Epoch                     string `json:"epoch"`
+	AttestationIncluded       bool   `json:"attestation_included"`
+	InclusionDelay            string `json:"inclusion_delay"`
+	CorrectSource             bool   `json:"correct_source"`
+	CorrectTarget             bool   `json:"correct_target"`
+	CorrectHead               bool   `json:"correct_head"`
+	ProposedBlocks            string `json:"proposed_blocks"`
+	SyncContributions         string `json:"sync_contributions"`
+	ExpectedSyncContributions string `json:"expected_sync_contributions"`
+	StartBalance              string `json:"start_balance"`
+	EndBalance                string `json:"end_balance"`
+	BalanceDelta              string `json:"balance_delta"`
+}
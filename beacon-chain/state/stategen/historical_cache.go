@@ -0,0 +1,249 @@
+package stategen
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	lruwrpr "github.com/prysmaticlabs/prysm/cache/lru"
+	"github.com/prysmaticlabs/prysm/config/params"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/time/slots"
+	"go.opencensus.io/trace"
+	"golang.org/x/sync/singleflight"
+)
+
+var (
+	// historicalEpochCacheSize defines the max number of epoch products this can cache.
+	historicalEpochCacheSize = 32
+	// historicalReplayTimeout bounds the duration of a replay shared by concurrent requests.
+	historicalReplayTimeout = 5 * time.Minute
+	// Metrics
+	historicalStateCacheHit = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "historical_state_cache_hit",
+		Help: "The total number of cache hits on the historical state cache.",
+	})
+	historicalStateCacheMiss = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "historical_state_cache_miss",
+		Help: "The total number of cache misses on the historical state cache.",
+	})
+	historicalEpochCacheHit = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "historical_epoch_cache_hit",
+		Help: "The total number of cache hits on the historical epoch products cache.",
+	})
+	historicalEpochCacheMiss = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "historical_epoch_cache_miss",
+		Help: "The total number of cache misses on the historical epoch products cache.",
+	})
+	historicalReplaySharedCount = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "historical_replay_shared_count",
+		Help: "The total number of historical state requests served by a replay already in progress.",
+	})
+	historicalReplayTime = promauto.NewHistogram(prometheus.HistogramOpts{
+		Name:    "historical_replay_milliseconds",
+		Help:    "Time to replay a historical state which was not found in the historical state cache.",
+		Buckets: []float64{10, 50, 100, 500, 1000, 5000, 10000, 30000},
+	})
+)
+
+// HistoricalEpochFetcher retrieves the products of a past epoch which are commonly derived from its
+// replayed state.
+type HistoricalEpochFetcher interface {
+	EpochProducts(ctx context.Context, epoch types.Epoch) (*EpochProducts, error)
+}
+
+// EpochProducts holds the values derived from the state at the start of an epoch.
+type EpochProducts struct {
+	Epoch types.Epoch
+	// Root is the canonical block root the start of epoch state was replayed from.
+	Root [32]byte
+	// Seed is the attester seed of the epoch, which together with the active indices
+	// determines the committee shuffling.
+	Seed          [32]byte
+	ActiveIndices []types.ValidatorIndex
+	Balances      []uint64
+}
+
+// historicalKey identifies a historical state by the slot it was advanced to and the
+// canonical block root it was built from.
+type historicalKey struct {
+	slot types.Slot
+	root [32]byte
+}
+
+func (k historicalKey) String() string {
+	return fmt.Sprintf("%d-%#x", k.slot, k.root)
+}
+
+// HistoricalCache holds recently replayed historical states, and the epoch products derived from them,
+// so that API queries targeting the same point in history only pay for a single replay. Concurrent
+// requests for a state which is not cached share a replay, which runs on the context of the cache so
+// that it is not cancelled when the request which started it is. Replayed states are only retained
+// when the cache is created with a positive state cache size.
+type HistoricalCache struct {
+	ctx     context.Context
+	states  *lru.Cache
+	epochs  *lru.Cache
+	replays singleflight.Group
+}
+
+// NewHistoricalCache initializes the underlying caches, retaining up to stateCacheSize replayed states.
+// Replays are cancelled once ctx is done.
+func NewHistoricalCache(ctx context.Context, stateCacheSize int) *HistoricalCache {
+	c := &HistoricalCache{
+		ctx:    ctx,
+		epochs: lruwrpr.New(historicalEpochCacheSize),
+	}
+	if stateCacheSize > 0 {
+		c.states = lruwrpr.New(stateCacheSize)
+	}
+	return c
+}
+
+// state returns a copy of the cached state for the key, running replay to generate it otherwise.
+func (c *HistoricalCache) state(
+	ctx context.Context,
+	key historicalKey,
+	replay func(context.Context) (state.BeaconState, error),
+) (state.BeaconState, error) {
+	ctx, span := trace.StartSpan(ctx, "historicalCache.state")
+	defer span.End()
+
+	if c.states != nil {
+		if item, ok := c.states.Get(key); ok && item != nil {
+			historicalStateCacheHit.Inc()
+			span.AddAttributes(trace.BoolAttribute("hit", true))
+			return item.(state.BeaconState).Copy(), nil
+		}
+		historicalStateCacheMiss.Inc()
+	}
+	span.AddAttributes(trace.BoolAttribute("hit", false))
+
+	replayed := c.replays.DoChan(key.String(), func() (interface{}, error) {
+		replayCtx, cancel := context.WithTimeout(c.ctx, historicalReplayTimeout)
+		defer cancel()
+		start := time.Now()
+		st, err := replay(replayCtx)
+		if err != nil {
+			return nil, err
+		}
+		historicalReplayTime.Observe(float64(time.Since(start).Milliseconds()))
+		if c.states != nil {
+			c.states.Add(key, st)
+		}
+		return st, nil
+	})
+	// Each request waits for the replay until its own context is done.
+	select {
+	case res := <-replayed:
+		if res.Err != nil {
+			return nil, res.Err
+		}
+		if res.Shared {
+			historicalReplaySharedCount.Inc()
+		}
+		// Copy state so cached value is not mutated.
+		return res.Val.(state.BeaconState).Copy(), nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// epochProducts returns the cached products of the epoch for the key, computing them from the state
+// returned by replay otherwise.
+func (c *HistoricalCache) epochProducts(
+	ctx context.Context,
+	epoch types.Epoch,
+	key historicalKey,
+	replay func(context.Context) (state.BeaconState, error),
+) (*EpochProducts, error) {
+	if item, ok := c.epochs.Get(key); ok && item != nil {
+		historicalEpochCacheHit.Inc()
+		return item.(*EpochProducts), nil
+	}
+	historicalEpochCacheMiss.Inc()
+
+	st, err := c.state(ctx, key, replay)
+	if err != nil {
+		return nil, err
+	}
+	seed, err := helpers.Seed(st, epoch, params.BeaconConfig().DomainBeaconAttester)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get seed")
+	}
+	activeIndices, err := helpers.ActiveValidatorIndices(ctx, st, epoch)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get active indices")
+	}
+	p := &EpochProducts{
+		Epoch:         epoch,
+		Root:          key.root,
+		Seed:          seed,
+		ActiveIndices: activeIndices,
+		Balances:      st.Balances(),
+	}
+	c.epochs.Add(key, p)
+	return p, nil
+}
+
+// cachedReplayer serves the states of a stateReplayer through the historical cache.
+type cachedReplayer struct {
+	replayer *stateReplayer
+	history  *CanonicalHistory
+}
+
+var _ Replayer = &cachedReplayer{}
+
+// ReplayBlocks returns the cached state for the target slot, replaying the canonical blocks only
+// when the state is not cached yet.
+func (r *cachedReplayer) ReplayBlocks(ctx context.Context) (state.BeaconState, error) {
+	ctx, span := trace.StartSpan(ctx, "stateGen.cachedReplayer.ReplayBlocks")
+	defer span.End()
+
+	root, _, err := r.history.BlockForSlot(ctx, r.replayer.target)
+	if err != nil {
+		return nil, errors.Wrap(err, fmt.Sprintf("unable to find replay data for slot=%d", r.replayer.target))
+	}
+	return r.history.historical.state(ctx, historicalKey{slot: r.replayer.target, root: root}, r.replayer.ReplayBlocks)
+}
+
+// ReplayToSlot advances the cached state returned by ReplayBlocks to the requested slot.
+func (r *cachedReplayer) ReplayToSlot(ctx context.Context, replayTo types.Slot) (state.BeaconState, error) {
+	ctx, span := trace.StartSpan(ctx, "stateGen.cachedReplayer.ReplayToSlot")
+	defer span.End()
+
+	s, err := r.ReplayBlocks(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to ReplayBlocks")
+	}
+	return replayToSlot(ctx, s, replayTo)
+}
+
+// EpochProducts returns the products of the start of epoch state for the given epoch. The products
+// are served from the historical cache when it is configured.
+func (c *CanonicalHistory) EpochProducts(ctx context.Context, epoch types.Epoch) (*EpochProducts, error) {
+	ctx, span := trace.StartSpan(ctx, "canonicalHistory.EpochProducts")
+	defer span.End()
+
+	startSlot, err := slots.EpochStart(epoch)
+	if err != nil {
+		return nil, err
+	}
+	root, _, err := c.BlockForSlot(ctx, startSlot)
+	if err != nil {
+		return nil, errors.Wrap(err, fmt.Sprintf("unable to find replay data for slot=%d", startSlot))
+	}
+	replayer := &stateReplayer{chainer: c, method: forSlot, target: startSlot}
+	cache := c.historical
+	if cache == nil {
+		// Compute the products without retaining them.
+		cache = NewHistoricalCache(ctx, 0)
+	}
+	return cache.epochProducts(ctx, epoch, historicalKey{slot: startSlot, root: root}, replayer.ReplayBlocks)
+}
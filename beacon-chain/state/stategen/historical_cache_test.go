@@ -0,0 +1,188 @@
+package stategen
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/config/params"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	"github.com/prysmaticlabs/prysm/testing/util"
+)
+
+func TestHistoricalCache_ReplayBlocks(t *testing.T) {
+	ctx := context.Background()
+	var zero, one, two types.Slot = 50, 51, 150
+	specs := []mockHistorySpec{
+		{slot: zero},
+		{slot: one, savedState: true},
+		{slot: two, canonicalBlock: true},
+	}
+	hist := newMockHistory(t, specs, two+1)
+	c := NewHistoricalCache(ctx, 8)
+	ch := NewCanonicalHistory(hist, hist, hist, WithHistoricalCache(c))
+
+	st, err := ch.ReplayerForSlot(two).ReplayBlocks(ctx)
+	require.NoError(t, err)
+	expectedHTR, err := hist.hiddenStates[hist.slotMap[two]].HashTreeRoot(ctx)
+	require.NoError(t, err)
+	actualHTR, err := st.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	require.Equal(t, expectedHTR, actualHTR)
+	assert.Equal(t, 1, c.states.Len())
+
+	// Mutating the returned state must not affect the cached state.
+	require.NoError(t, st.SetSlot(two+10))
+	st, err = ch.ReplayerForSlot(two).ReplayBlocks(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, two, st.Slot())
+	actualHTR, err = st.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	require.Equal(t, expectedHTR, actualHTR)
+	assert.Equal(t, 1, c.states.Len())
+
+	st, err = ch.ReplayerForSlot(two).ReplayToSlot(ctx, two+5)
+	require.NoError(t, err)
+	assert.Equal(t, two+5, st.Slot())
+	assert.Equal(t, 1, c.states.Len())
+}
+
+func TestHistoricalCache_StatesDisabled(t *testing.T) {
+	ctx := context.Background()
+	c := NewHistoricalCache(ctx, 0)
+	st, err := util.NewBeaconState()
+	require.NoError(t, err)
+	replays := 0
+	replay := func(context.Context) (state.BeaconState, error) {
+		replays++
+		return st, nil
+	}
+
+	key := historicalKey{slot: 1, root: [32]byte{'a'}}
+	for i := 0; i < 2; i++ {
+		got, err := c.state(ctx, key, replay)
+		require.NoError(t, err)
+		assert.NotNil(t, got)
+	}
+	assert.Equal(t, 2, replays, "Replayed state was retained")
+}
+
+func TestHistoricalCache_SharesReplay(t *testing.T) {
+	ctx := context.Background()
+	c := NewHistoricalCache(ctx, 8)
+	st, err := util.NewBeaconState()
+	require.NoError(t, err)
+
+	var mu sync.Mutex
+	replays := 0
+	started := make(chan struct{})
+	release := make(chan struct{})
+	replay := func(context.Context) (state.BeaconState, error) {
+		mu.Lock()
+		replays++
+		mu.Unlock()
+		close(started)
+		<-release
+		return st, nil
+	}
+
+	key := historicalKey{slot: 1, root: [32]byte{'a'}}
+	var wg sync.WaitGroup
+	get := func() {
+		defer wg.Done()
+		got, err := c.state(ctx, key, replay)
+		require.NoError(t, err)
+		assert.NotNil(t, got)
+	}
+	wg.Add(1)
+	go get()
+	<-started
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go get()
+	}
+	// Give the concurrent requests a chance to wait on the replay in progress. The ones which
+	// do not will be served from the cache, which does not replay either.
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+	assert.Equal(t, 1, replays)
+}
+
+func TestHistoricalCache_RequestCancellation(t *testing.T) {
+	c := NewHistoricalCache(context.Background(), 8)
+	st, err := util.NewBeaconState()
+	require.NoError(t, err)
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+	replayErr := make(chan error, 1)
+	replay := func(ctx context.Context) (state.BeaconState, error) {
+		close(started)
+		select {
+		case <-release:
+		case <-ctx.Done():
+		}
+		replayErr <- ctx.Err()
+		return st, nil
+	}
+
+	// The request which started the replay gives up, while another request waits for it.
+	key := historicalKey{slot: 1, root: [32]byte{'a'}}
+	firstCtx, cancel := context.WithCancel(context.Background())
+	firstErr := make(chan error, 1)
+	go func() {
+		_, err := c.state(firstCtx, key, replay)
+		firstErr <- err
+	}()
+	<-started
+	secondErr := make(chan error, 1)
+	go func() {
+		_, err := c.state(context.Background(), key, replay)
+		secondErr <- err
+	}()
+	cancel()
+	assert.Equal(t, context.Canceled, <-firstErr)
+
+	close(release)
+	require.NoError(t, <-replayErr, "Replay was cancelled with the request which started it")
+	require.NoError(t, <-secondErr)
+	assert.Equal(t, 1, c.states.Len())
+}
+
+func TestCanonicalHistory_EpochProducts(t *testing.T) {
+	ctx := context.Background()
+	start, err := params.BeaconConfig().SlotsPerEpoch.SafeMul(2)
+	require.NoError(t, err)
+	specs := []mockHistorySpec{
+		{slot: start - 1, savedState: true},
+		{slot: start, canonicalBlock: true},
+	}
+	hist := newMockHistory(t, specs, start+1)
+	c := NewHistoricalCache(ctx, 8)
+	ch := NewCanonicalHistory(hist, hist, hist, WithHistoricalCache(c))
+
+	p, err := ch.EpochProducts(ctx, 2)
+	require.NoError(t, err)
+	st, err := NewCanonicalHistory(hist, hist, hist).ReplayerForSlot(start).ReplayBlocks(ctx)
+	require.NoError(t, err)
+	seed, err := helpers.Seed(st, 2, params.BeaconConfig().DomainBeaconAttester)
+	require.NoError(t, err)
+	activeIndices, err := helpers.ActiveValidatorIndices(ctx, st, 2)
+	require.NoError(t, err)
+	assert.Equal(t, types.Epoch(2), p.Epoch)
+	assert.Equal(t, hist.slotMap[start], p.Root)
+	assert.Equal(t, seed, p.Seed)
+	assert.DeepEqual(t, activeIndices, p.ActiveIndices)
+	assert.DeepEqual(t, st.Balances(), p.Balances)
+
+	cached, err := ch.EpochProducts(ctx, 2)
+	require.NoError(t, err)
+	assert.Equal(t, p, cached)
+	assert.Equal(t, 1, c.epochs.Len())
+}
@@ -21,6 +21,14 @@ func WithCache(c CachedGetter) CanonicalHistoryOption {
 	}
 }
 
+// WithHistoricalCache serves the states of the replayers created by the CanonicalHistory
+// through the given cache of historical states.
+func WithHistoricalCache(c *HistoricalCache) CanonicalHistoryOption {
+	return func(h *CanonicalHistory) {
+		h.historical = c
+	}
+}
+
 type CanonicalHistoryOption func(*CanonicalHistory)
 
 func NewCanonicalHistory(h HistoryAccessor, cc CanonicalChecker, cs CurrentSlotter, opts ...CanonicalHistoryOption) *CanonicalHistory {
@@ -36,14 +44,19 @@ func NewCanonicalHistory(h HistoryAccessor, cc CanonicalChecker, cs CurrentSlott
 }
 
 type CanonicalHistory struct {
-	h     HistoryAccessor
-	cc    CanonicalChecker
-	cs    CurrentSlotter
-	cache CachedGetter
+	h          HistoryAccessor
+	cc         CanonicalChecker
+	cs         CurrentSlotter
+	cache      CachedGetter
+	historical *HistoricalCache
 }
 
 func (c *CanonicalHistory) ReplayerForSlot(target types.Slot) Replayer {
-	return &stateReplayer{chainer: c, method: forSlot, target: target}
+	r := &stateReplayer{chainer: c, method: forSlot, target: target}
+	if c.historical != nil {
+		return &cachedReplayer{replayer: r, history: c}
+	}
+	return r
 }
 
 func (c *CanonicalHistory) BlockForSlot(ctx context.Context, target types.Slot) ([32]byte, interfaces.SignedBeaconBlock, error) {
@@ -136,6 +136,12 @@ func (rs *stateReplayer) ReplayToSlot(ctx context.Context, replayTo types.Slot)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to ReplayBlocks")
 	}
+	return replayToSlot(ctx, s, replayTo)
+}
+
+// replayToSlot runs process_slots to advance the replayed state to the given slot.
+func replayToSlot(ctx context.Context, s state.BeaconState, replayTo types.Slot) (state.BeaconState, error) {
+	var err error
 	if replayTo < s.Slot() {
 		return nil, errors.Wrapf(ErrReplayTargetSlotExceeded, "slot desired=%d, state.slot=%d", replayTo, s.Slot())
 	}
@@ -17,7 +17,13 @@ const signatureVerificationInterval = 50 * time.Millisecond
 const verifierLimit = 50
 
 type signatureVerifier struct {
-	set     *bls.SignatureBatch
+	set *bls.SignatureBatch
+	// topic is the kind of message the set belongs to, used as a metrics label.
+	topic string
+	// group identifies sets which are likely valid or invalid together, such as the attestations
+	// of a committee. When a batch fails, the sets of a group are verified together before the
+	// callers fall back to individual verification. Sets without a group fall back directly.
+	group   string
 	resChan chan error
 }
 
@@ -32,10 +38,12 @@ func (s *Service) verifierRoutine() {
 			// Clean up currently utilised resources.
 			ticker.Stop()
 			for i := 0; i < len(verifierBatch); i++ {
+				signatureVerifierQueueDepth.WithLabelValues(verifierBatch[i].topic).Dec()
 				verifierBatch[i].resChan <- s.ctx.Err()
 			}
 			return
 		case sig := <-s.signatureChan:
+			signatureVerifierQueueDepth.WithLabelValues(sig.topic).Inc()
 			verifierBatch = append(verifierBatch, sig)
 			if len(verifierBatch) >= verifierLimit {
 				verifyBatch(verifierBatch)
@@ -51,30 +59,38 @@ func (s *Service) verifierRoutine() {
 }
 
 func (s *Service) validateWithBatchVerifier(ctx context.Context, message string, set *bls.SignatureBatch) (pubsub.ValidationResult, error) {
+	return s.validateGroupWithBatchVerifier(ctx, message, "", set)
+}
+
+// validateGroupWithBatchVerifier verifies the signature set in the next batch of the verifier routine,
+// as part of the given group of sets.
+func (s *Service) validateGroupWithBatchVerifier(ctx context.Context, message, group string, set *bls.SignatureBatch) (pubsub.ValidationResult, error) {
 	_, span := trace.StartSpan(ctx, "sync.validateWithBatchVerifier")
 	defer span.End()
 
+	start := time.Now()
 	resChan := make(chan error)
-	verificationSet := &signatureVerifier{set: set.Copy(), resChan: resChan}
+	verificationSet := &signatureVerifier{set: set.Copy(), topic: message, group: group, resChan: resChan}
 	s.signatureChan <- verificationSet
 
 	resErr := <-resChan
 	close(resChan)
+	defer func() {
+		signatureVerificationLatency.WithLabelValues(message).Observe(float64(time.Since(start).Milliseconds()))
+	}()
 	// If verification fails we fallback to individual verification
-	// of each signature set.
+	// of the signature set.
 	if resErr != nil {
+		if s.ctx.Err() != nil {
+			// The verifier routine stopped before verifying the set.
+			return pubsub.ValidationIgnore, resErr
+		}
 		log.WithError(resErr).Tracef("Could not perform batch verification of %s", message)
-		verified, err := set.Verify()
-		if err != nil {
+		if err := verifySet(set); err != nil {
 			verErr := errors.Wrapf(err, "Could not verify %s", message)
 			tracing.AnnotateError(span, verErr)
 			return pubsub.ValidationReject, verErr
 		}
-		if !verified {
-			verErr := errors.Errorf("Verification of %s failed", message)
-			tracing.AnnotateError(span, verErr)
-			return pubsub.ValidationReject, verErr
-		}
 	}
 	return pubsub.ValidationAccept, nil
 }
@@ -83,9 +99,14 @@ func verifyBatch(verifierBatch []*signatureVerifier) {
 	if len(verifierBatch) == 0 {
 		return
 	}
-	aggSet := verifierBatch[0].set
-
-	for i := 1; i < len(verifierBatch); i++ {
+	defer func() {
+		for i := 0; i < len(verifierBatch); i++ {
+			signatureVerifierQueueDepth.WithLabelValues(verifierBatch[i].topic).Dec()
+		}
+	}()
+	// Join into a new set, as the sets of the batch are verified again if the batch fails.
+	aggSet := bls.NewSet()
+	for i := 0; i < len(verifierBatch); i++ {
 		aggSet = aggSet.Join(verifierBatch[i].set)
 	}
 	var verificationErr error
@@ -94,17 +115,70 @@ func verifyBatch(verifierBatch []*signatureVerifier) {
 		aggSet, verificationErr = performBatchAggregation(aggSet)
 	}
 	if verificationErr == nil {
-		verified, err := aggSet.Verify()
-		switch {
-		case err != nil:
-			verificationErr = err
-		case !verified:
-			verificationErr = errors.New("batch signature verification failed")
+		verificationErr = verifySet(aggSet)
+	}
+	if verificationErr == nil {
+		for i := 0; i < len(verifierBatch); i++ {
+			verifierBatch[i].resChan <- nil
 		}
+		return
 	}
-	for i := 0; i < len(verifierBatch); i++ {
-		verifierBatch[i].resChan <- verificationErr
+
+	// The batch contains an invalid signature. The sets of each group are verified together
+	// in the background, so that the routine can carry on with the next batch. Callers whose
+	// group fails, or whose set has no group, verify their own set.
+	signatureBatchFailedCounter.Inc()
+	for _, group := range groupVerifiers(verifierBatch) {
+		if len(group) == 1 {
+			group[0].resChan <- verificationErr
+			continue
+		}
+		go verifyGroup(group)
+	}
+}
+
+// verifyGroup verifies the sets of a group together and sends the result to each verifier of the group.
+func verifyGroup(group []*signatureVerifier) {
+	groupSet := bls.NewSet()
+	for _, v := range group {
+		groupSet = groupSet.Join(v.set)
+	}
+	err := verifySet(groupSet)
+	for _, v := range group {
+		v.resChan <- err
+	}
+}
+
+// groupVerifiers splits the verifiers of a batch by group, keeping the order of the batch.
+// Verifiers without a group form a group of their own.
+func groupVerifiers(verifierBatch []*signatureVerifier) [][]*signatureVerifier {
+	groups := make([][]*signatureVerifier, 0, len(verifierBatch))
+	groupIndices := make(map[string]int)
+	for _, v := range verifierBatch {
+		if v.group == "" {
+			groups = append(groups, []*signatureVerifier{v})
+			continue
+		}
+		i, ok := groupIndices[v.group]
+		if !ok {
+			groupIndices[v.group] = len(groups)
+			groups = append(groups, []*signatureVerifier{v})
+			continue
+		}
+		groups[i] = append(groups[i], v)
+	}
+	return groups
+}
+
+func verifySet(set *bls.SignatureBatch) error {
+	verified, err := set.Verify()
+	if err != nil {
+		return err
+	}
+	if !verified {
+		return errors.New("batch signature verification failed")
 	}
+	return nil
 }
 
 func performBatchAggregation(aggSet *bls.SignatureBatch) (*bls.SignatureBatch, error) {
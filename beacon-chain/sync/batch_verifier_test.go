@@ -28,6 +28,7 @@ func TestValidateWithBatchVerifier(t *testing.T) {
 	tests := []struct {
 		name          string
 		message       string
+		group         string
 		set           *bls.SignatureBatch
 		preFilledSets []*bls.SignatureBatch
 		want          pubsub.ValidationResult
@@ -58,6 +59,22 @@ func TestValidateWithBatchVerifier(t *testing.T) {
 			preFilledSets: []*bls.SignatureBatch{validSet},
 			want:          pubsub.ValidationReject,
 		},
+		{
+			name:          "invalid set in group with valid set",
+			message:       "random",
+			group:         "committee",
+			set:           validSet,
+			preFilledSets: []*bls.SignatureBatch{validSet, invalidSet},
+			want:          pubsub.ValidationAccept,
+		},
+		{
+			name:          "valid sets in group with invalid set",
+			message:       "random",
+			group:         "committee",
+			set:           invalidSet,
+			preFilledSets: []*bls.SignatureBatch{validSet, validSet},
+			want:          pubsub.ValidationReject,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -69,9 +86,9 @@ func TestValidateWithBatchVerifier(t *testing.T) {
 			}
 			go svc.verifierRoutine()
 			for _, st := range tt.preFilledSets {
-				svc.signatureChan <- &signatureVerifier{set: st, resChan: make(chan error, 10)}
+				svc.signatureChan <- &signatureVerifier{set: st, group: tt.group, resChan: make(chan error, 10)}
 			}
-			got, err := svc.validateWithBatchVerifier(context.Background(), tt.message, tt.set)
+			got, err := svc.validateGroupWithBatchVerifier(context.Background(), tt.message, tt.group, tt.set)
 			if got != tt.want {
 				t.Errorf("validateWithBatchVerifier() = %v, want %v", got, tt.want)
 			}
@@ -82,3 +99,13 @@ func TestValidateWithBatchVerifier(t *testing.T) {
 		})
 	}
 }
+
+func TestGroupVerifiers(t *testing.T) {
+	a1 := &signatureVerifier{group: "a"}
+	b1 := &signatureVerifier{group: "b"}
+	none1 := &signatureVerifier{}
+	a2 := &signatureVerifier{group: "a"}
+	none2 := &signatureVerifier{}
+	groups := groupVerifiers([]*signatureVerifier{a1, b1, none1, a2, none2})
+	assert.DeepEqual(t, [][]*signatureVerifier{{a1, a2}, {b1}, {none1}, {none2}}, groups)
+}
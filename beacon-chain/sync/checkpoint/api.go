@@ -31,5 +31,18 @@ func (dl *APIInitializer) Initialize(ctx context.Context, d db.Database) error {
 	if err != nil {
 		return errors.Wrap(err, "Error retrieving checkpoint origin state and block")
 	}
-	return d.SaveOrigin(ctx, od.StateBytes(), od.BlockBytes())
+	if err := d.SaveOrigin(ctx, od.StateBytes(), od.BlockBytes()); err != nil {
+		return err
+	}
+	// The deposit snapshot spares the node from replaying all deposit logs, but not every
+	// beacon node serves it, so it is not required for checkpoint sync.
+	snapshot, err := dl.c.GetDepositSnapshot(ctx)
+	if err != nil {
+		if errors.Is(err, beacon.ErrNotFound) {
+			log.Warn("Remote beacon node does not serve a deposit snapshot, deposits will be fetched from the execution client")
+			return nil
+		}
+		return errors.Wrap(err, "error retrieving deposit snapshot")
+	}
+	return d.SaveDepositSnapshot(ctx, snapshot)
 }
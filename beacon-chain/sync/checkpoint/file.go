@@ -9,6 +9,7 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/container/trie"
 )
 
 // Initializer describes a type that is able to obtain the checkpoint sync data (BeaconState and SignedBeaconBlock)
@@ -19,8 +20,9 @@ type Initializer interface {
 }
 
 // NewFileInitializer validates the given path information and creates an Initializer which will
-// use the provided state and block files to prepare the node for checkpoint sync.
-func NewFileInitializer(blockPath string, statePath string) (*FileInitializer, error) {
+// use the provided state and block files to prepare the node for checkpoint sync. The deposit snapshot
+// file is optional and ignored when depositSnapshotPath is empty.
+func NewFileInitializer(blockPath string, statePath string, depositSnapshotPath string) (*FileInitializer, error) {
 	var err error
 	if err = existsAndIsFile(blockPath); err != nil {
 		return nil, err
@@ -28,15 +30,21 @@ func NewFileInitializer(blockPath string, statePath string) (*FileInitializer, e
 	if err = existsAndIsFile(statePath); err != nil {
 		return nil, err
 	}
+	if depositSnapshotPath != "" {
+		if err = existsAndIsFile(depositSnapshotPath); err != nil {
+			return nil, err
+		}
+	}
 	// stat just to make sure it actually exists and is a file
-	return &FileInitializer{blockPath: blockPath, statePath: statePath}, nil
+	return &FileInitializer{blockPath: blockPath, statePath: statePath, depositSnapshotPath: depositSnapshotPath}, nil
 }
 
 // FileInitializer initializes a beacon-node database to use checkpoint sync,
 // using ssz-encoded block and state data stored in files on the local filesystem.
 type FileInitializer struct {
-	blockPath string
-	statePath string
+	blockPath           string
+	statePath           string
+	depositSnapshotPath string
 }
 
 // Initialize is called in the BeaconNode db startup code if an Initializer is present.
@@ -50,7 +58,24 @@ func (fi *FileInitializer) Initialize(ctx context.Context, d db.Database) error
 	if err != nil {
 		return errors.Wrapf(err, "error reading state file %s for checkpoint sync init", fi.blockPath)
 	}
-	return d.SaveOrigin(ctx, serState, serBlock)
+	if err := d.SaveOrigin(ctx, serState, serBlock); err != nil {
+		return err
+	}
+	if fi.depositSnapshotPath == "" {
+		return nil
+	}
+	serSnapshot, err := file.ReadFileAsBytes(fi.depositSnapshotPath)
+	if err != nil {
+		return errors.Wrapf(err, "error reading deposit snapshot file %s for checkpoint sync init", fi.depositSnapshotPath)
+	}
+	snapshot := &trie.DepositTreeSnapshot{}
+	if err := snapshot.UnmarshalSSZ(serSnapshot); err != nil {
+		return errors.Wrapf(err, "could not unmarshal deposit snapshot file %s", fi.depositSnapshotPath)
+	}
+	if err := snapshot.Verify(); err != nil {
+		return errors.Wrapf(err, "invalid deposit snapshot file %s", fi.depositSnapshotPath)
+	}
+	return d.SaveDepositSnapshot(ctx, snapshot)
 }
 
 var _ Initializer = &FileInitializer{}
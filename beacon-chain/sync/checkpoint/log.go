@@ -0,0 +1,5 @@
+package checkpoint
+
+import "github.com/sirupsen/logrus"
+
+var log = logrus.WithField("prefix", "checkpoint-sync")
@@ -75,6 +75,27 @@ var (
 			Buckets: []float64{10, 50, 100, 200, 400, 800, 1600, 3200},
 		},
 	)
+	signatureVerifierQueueDepth = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "signature_verifier_queue_depth",
+			Help: "The number of signature sets waiting for batch verification.",
+		},
+		[]string{"topic"},
+	)
+	signatureVerificationLatency = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "signature_verification_latency_milliseconds",
+			Help:    "Time from queuing a signature set for batch verification to receiving its result.",
+			Buckets: []float64{5, 10, 25, 50, 100, 250, 500, 1000},
+		},
+		[]string{"topic"},
+	)
+	signatureBatchFailedCounter = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "signature_batch_failed_total",
+			Help: "Count the number of signature batches which failed verification and were verified per group.",
+		},
+	)
 
 	arrivalBlockPropagationHistogram = promauto.NewHistogram(
 		prometheus.HistogramOpts{
@@ -162,6 +162,15 @@ func (s *Service) writeBlockRangeToStream(ctx context.Context, startSlot, endSlo
 		if b == nil || b.IsNil() || b.Block().IsNil() {
 			continue
 		}
+		if b.Block().IsBlinded() {
+			b, err = s.cfg.executionPayloadReconstructor.ReconstructFullBellatrixBlock(ctx, b)
+			if err != nil {
+				log.WithError(err).Error("Could not reconstruct full bellatrix block from blinded body")
+				s.writeErrorResponseToStream(responseCodeServerError, p2ptypes.ErrGeneric.Error(), stream)
+				tracing.AnnotateError(span, err)
+				return err
+			}
+		}
 		if chunkErr := s.chunkBlockWriter(stream, b); chunkErr != nil {
 			log.WithError(chunkErr).Debug("Could not send a chunked response")
 			s.writeErrorResponseToStream(responseCodeServerError, p2ptypes.ErrGeneric.Error(), stream)
@@ -11,6 +11,7 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
 	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
 	dbTest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/synccommittee"
 	p2ptest "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
 	v1 "github.com/prysmaticlabs/prysm/beacon-chain/state/v1"
 	mockSync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync/testing"
@@ -138,6 +139,7 @@ func TestSyncHandlers_WaitTillSynced(t *testing.T) {
 			stateNotifier: chainService.StateNotifier(),
 			blockNotifier: chainService.BlockNotifier(),
 			initialSync:   &mockSync.Sync{IsSyncing: false},
+			syncCommsPool: synccommittee.NewStore(),
 		},
 		chainStarted: abool.New(),
 		subHandler:   newSubTopicHandler(),
@@ -222,6 +224,7 @@ func TestSyncService_StopCleanly(t *testing.T) {
 			chain:         chainService,
 			stateNotifier: chainService.StateNotifier(),
 			initialSync:   &mockSync.Sync{IsSyncing: false},
+			syncCommsPool: synccommittee.NewStore(),
 		},
 		chainStarted: abool.New(),
 		subHandler:   newSubTopicHandler(),
@@ -0,0 +1,27 @@
+package sync
+
+import (
+	"github.com/prysmaticlabs/prysm/config/params"
+	"github.com/prysmaticlabs/prysm/time/slots"
+)
+
+// Is a background routine that prunes the sync committee pool at every slot. The messages of the
+// previous slot are kept, as they are included in the sync aggregate of the block of the current slot.
+func (s *Service) syncCommitteePoolPruner() {
+	slotTicker := slots.NewSlotTicker(s.cfg.chain.GenesisTime(), params.BeaconConfig().SecondsPerSlot)
+	for {
+		select {
+		case currSlot := <-slotTicker.C():
+			if currSlot == 0 {
+				continue
+			}
+			if err := s.cfg.syncCommsPool.PruneSyncCommitteePool(currSlot - 1); err != nil {
+				log.WithError(err).Error("Could not prune sync committee pool")
+			}
+		case <-s.ctx.Done():
+			log.Debug("Context closed, exiting goroutine")
+			slotTicker.Done()
+			return
+		}
+	}
+}
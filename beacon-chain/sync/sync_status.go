@@ -0,0 +1,64 @@
+package sync
+
+import (
+	"time"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	"github.com/prysmaticlabs/prysm/config/params"
+	ethpbv1 "github.com/prysmaticlabs/prysm/proto/eth/v1"
+	"github.com/prysmaticlabs/prysm/time/slots"
+)
+
+// Sync statuses sent with SyncStatusChanged events.
+const (
+	syncStatusSyncing    = "syncing"
+	syncStatusOptimistic = "optimistic"
+	syncStatusSynced     = "synced"
+)
+
+// Is a background routine that checks the sync status of the node every slot and
+// notifies the state feed whenever it transitions to a different status.
+func (s *Service) syncStatusWatcher(genesisTime time.Time) {
+	slotTicker := slots.NewSlotTicker(genesisTime, params.BeaconConfig().SecondsPerSlot)
+	status := ""
+	for {
+		select {
+		case <-slotTicker.C():
+			status = s.notifySyncStatusChange(status)
+		case <-s.ctx.Done():
+			log.Debug("Context closed, exiting goroutine")
+			slotTicker.Done()
+			return
+		}
+	}
+}
+
+// notifySyncStatusChange sends a SyncStatusChanged event if the current sync status of the node
+// differs from the previous one, and returns the current status.
+func (s *Service) notifySyncStatusChange(previous string) string {
+	status := syncStatusSynced
+	if s.cfg.initialSync.Syncing() {
+		status = syncStatusSyncing
+	} else {
+		optimistic, err := s.cfg.chain.IsOptimistic(s.ctx)
+		if err != nil {
+			log.WithError(err).Error("Could not check if the node is optimistic")
+			return previous
+		}
+		if optimistic {
+			status = syncStatusOptimistic
+		}
+	}
+	if status == previous {
+		return previous
+	}
+	s.cfg.stateNotifier.StateFeed().Send(&feed.Event{
+		Type: statefeed.SyncStatusChanged,
+		Data: &ethpbv1.EventSyncStatus{
+			Status:   status,
+			HeadSlot: s.cfg.chain.HeadSlot(),
+		},
+	})
+	return status
+}
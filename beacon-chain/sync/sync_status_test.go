@@ -0,0 +1,61 @@
+package sync
+
+import (
+	"context"
+	"testing"
+
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	mockSync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync/testing"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	ethpbv1 "github.com/prysmaticlabs/prysm/proto/eth/v1"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	"github.com/prysmaticlabs/prysm/testing/util"
+)
+
+func TestService_notifySyncStatusChange(t *testing.T) {
+	notifier := &mock.MockStateNotifier{}
+	stateChannel := make(chan *feed.Event, 3)
+	stateSub := notifier.StateFeed().Subscribe(stateChannel)
+	defer stateSub.Unsubscribe()
+	st, err := util.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, st.SetSlot(5))
+	chain := &mock.ChainService{State: st}
+	initialSync := &mockSync.Sync{IsSyncing: true}
+	s := &Service{
+		ctx: context.Background(),
+		cfg: &config{
+			chain:         chain,
+			initialSync:   initialSync,
+			stateNotifier: notifier,
+		},
+	}
+
+	status := s.notifySyncStatusChange("")
+	assert.Equal(t, syncStatusSyncing, status)
+	// Unchanged status does not notify.
+	status = s.notifySyncStatusChange(status)
+	assert.Equal(t, syncStatusSyncing, status)
+
+	initialSync.IsSyncing = false
+	chain.Optimistic = true
+	status = s.notifySyncStatusChange(status)
+	assert.Equal(t, syncStatusOptimistic, status)
+
+	chain.Optimistic = false
+	status = s.notifySyncStatusChange(status)
+	assert.Equal(t, syncStatusSynced, status)
+
+	require.Equal(t, 3, len(stateChannel))
+	for _, want := range []string{syncStatusSyncing, syncStatusOptimistic, syncStatusSynced} {
+		event := <-stateChannel
+		assert.Equal(t, feed.EventType(statefeed.SyncStatusChanged), event.Type)
+		data, ok := event.Data.(*ethpbv1.EventSyncStatus)
+		require.Equal(t, true, ok, "Entity is not of type *ethpbv1.EventSyncStatus")
+		assert.Equal(t, want, data.Status)
+		assert.Equal(t, types.Slot(5), data.HeadSlot)
+	}
+}
@@ -95,6 +95,11 @@ func (s *Service) validateCommitteeIndexBeaconAttestation(ctx context.Context, p
 	if err := helpers.ValidateSlotTargetEpoch(att.Data); err != nil {
 		return pubsub.ValidationReject, err
 	}
+	// Reject aggregated attestations before any state lookups, the full bitfield check against
+	// the committee happens once the committee is known.
+	if att.AggregationBits.Count() != 1 {
+		return pubsub.ValidationReject, errors.New("attestation bitfield is invalid")
+	}
 
 	if features.Get().EnableSlasher {
 		// Feed the indexed attestation to slasher if enabled. This action
@@ -234,7 +239,10 @@ func (s *Service) validateUnaggregatedAttWithState(ctx context.Context, a *eth.A
 		tracing.AnnotateError(span, err)
 		return pubsub.ValidationReject, err
 	}
-	return s.validateWithBatchVerifier(ctx, "attestation", set)
+	// Attestations of the same committee share their validity in practice, so they are grouped to
+	// narrow down the invalid signatures of a failed batch.
+	group := fmt.Sprintf("%d-%d", a.Data.Slot, a.Data.CommitteeIndex)
+	return s.validateGroupWithBatchVerifier(ctx, "attestation", group, set)
 }
 
 // Returns true if the attestation was already seen for the participating validator for the slot.
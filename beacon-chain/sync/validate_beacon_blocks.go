@@ -201,6 +201,16 @@ func (s *Service) validateBeaconBlockPubSub(ctx context.Context, pid peer.ID, ms
 		"proposerIndex":      blk.Block().ProposerIndex(),
 		"graffiti":           string(blk.Block().Body().Graffiti()),
 	}).Debug("Received block")
+
+	// Notify subscribers of the block's gossip arrival ahead of its import.
+	s.cfg.blockNotifier.BlockFeed().Send(&feed.Event{
+		Type: blockfeed.GossipBlockReceived,
+		Data: &blockfeed.GossipBlockReceivedData{
+			SignedBlock: blk,
+			BlockRoot:   blockRoot,
+			ArrivalTime: receivedTime,
+		},
+	})
 	return pubsub.ValidationAccept, nil
 }
 
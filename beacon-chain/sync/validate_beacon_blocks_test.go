@@ -14,6 +14,8 @@ import (
 	gcache "github.com/patrickmn/go-cache"
 	"github.com/prysmaticlabs/prysm/async/abool"
 	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	blockfeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/block"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/signing"
 	coreTime "github.com/prysmaticlabs/prysm/beacon-chain/core/time"
@@ -341,11 +343,25 @@ func TestValidateBeaconBlockPubSub_ValidProposerSignature(t *testing.T) {
 			Topic: &topic,
 		},
 	}
+	// Subscribe to block notifications, which carry both the received and the gossip arrival events.
+	blockChannel := make(chan *feed.Event, 2)
+	blockSub := r.cfg.blockNotifier.BlockFeed().Subscribe(blockChannel)
+	defer blockSub.Unsubscribe()
 	res, err := r.validateBeaconBlockPubSub(ctx, "", m)
 	assert.NoError(t, err)
 	result := res == pubsub.ValidationAccept
 	assert.Equal(t, true, result)
 	assert.NotNil(t, m.ValidatorData, "Decoded message was not set on the message validator data")
+
+	<-blockChannel
+	event := <-blockChannel
+	assert.Equal(t, feed.EventType(blockfeed.GossipBlockReceived), event.Type)
+	data, ok := event.Data.(*blockfeed.GossipBlockReceivedData)
+	require.Equal(t, true, ok, "Entity is not of type *blockfeed.GossipBlockReceivedData")
+	root, err := msg.Block.HashTreeRoot()
+	require.NoError(t, err)
+	assert.Equal(t, root, data.BlockRoot)
+	assert.Equal(t, false, data.ArrivalTime.IsZero())
 }
 
 func TestValidateBeaconBlockPubSub_WithLookahead(t *testing.T) {
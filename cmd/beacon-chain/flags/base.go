@@ -74,6 +74,17 @@ var (
 		Usage: "Comma-separated list of API module names. Possible values: `" + PrysmAPIModule + `,` + EthAPIModule + "`.",
 		Value: strings.Join([]string{PrysmAPIModule, EthAPIModule}, ","),
 	}
+	// EnableNativeHTTPAPI serves the Ethereum API by calling the gRPC services directly, ahead of the gRPC gateway.
+	// It is opt-in while the native API is validated against the API middleware, which keeps serving the
+	// Ethereum API by default. The native API becomes the default in a later release, after which the
+	// middleware endpoints it serves are removed.
+	EnableNativeHTTPAPI = &cli.BoolFlag{
+		Name: "enable-native-http-api",
+		Usage: "Serves the Ethereum Beacon API over HTTP by calling the gRPC services directly, instead of " +
+			"going through the gRPC gateway and the API middleware. Requires the " + EthAPIModule + " HTTP module. " +
+			"Opt-in for now: the API middleware remains the default until the native API replaces it in a future " +
+			"release, at which point the middleware endpoints it serves will be removed.",
+	}
 	// DisableGRPCGateway for JSON-HTTP requests to the beacon node.
 	DisableGRPCGateway = &cli.BoolFlag{
 		Name:  "disable-grpc-gateway",
@@ -137,11 +148,25 @@ var (
 		Usage: "The slot durations of when an archived state gets saved in the beaconDB.",
 		Value: 2048,
 	}
+	// DBBackend specifies the storage backend of a new beacon node database.
+	DBBackend = &cli.StringFlag{
+		Name: "db-backend",
+		Usage: "The storage backend used when creating the beacon node database, bolt, leveldb or pebble. " +
+			"An existing database keeps its backend, use `prysmctl db migrate-backend` to convert it.",
+		Value: "bolt",
+	}
 	// DisableDiscv5 disables running discv5.
 	DisableDiscv5 = &cli.BoolFlag{
 		Name:  "disable-discv5",
 		Usage: "Does not run the discoveryV5 dht.",
 	}
+	// HistoricalStateCacheSize specifies the number of replayed historical states kept in memory.
+	HistoricalStateCacheSize = &cli.IntFlag{
+		Name: "historical-state-cache-size",
+		Usage: "The number of replayed historical states kept in memory to serve API queries for past slots. " +
+			"Each state takes hundreds of megabytes on mainnet, 0 disables the cache.",
+		Value: 8,
+	}
 	// BlockBatchLimit specifies the requested block batch size.
 	BlockBatchLimit = &cli.IntFlag{
 		Name:  "block-batch-limit",
@@ -43,6 +43,7 @@ var appFlags = []cli.Flag{
 	flags.CertFlag,
 	flags.KeyFlag,
 	flags.HTTPModules,
+	flags.EnableNativeHTTPAPI,
 	flags.DisableGRPCGateway,
 	flags.GRPCGatewayHost,
 	flags.GRPCGatewayPort,
@@ -60,6 +61,8 @@ var appFlags = []cli.Flag{
 	flags.InteropNumValidatorsFlag,
 	flags.InteropGenesisTimeFlag,
 	flags.SlotsPerArchivedPoint,
+	flags.HistoricalStateCacheSize,
+	flags.DBBackend,
 	flags.EnableDebugRPCEndpoints,
 	flags.SubscribeToAllSubnets,
 	flags.HistoricalSlasherNode,
@@ -74,6 +77,12 @@ var appFlags = []cli.Flag{
 	flags.TerminalBlockHashActivationEpochOverride,
 	cmd.EnableBackupWebhookFlag,
 	cmd.BackupWebhookOutputDir,
+	cmd.DBBackupIntervalFlag,
+	cmd.DBBackupRetentionFlag,
+	cmd.DBBackupCompressFlag,
+	cmd.ClientStatsAPIURLFlag,
+	cmd.ClientStatsPushIntervalFlag,
+	cmd.ClientStatsSpoolFileFlag,
 	cmd.MinimalConfigFlag,
 	cmd.E2EConfigFlag,
 	cmd.RPCMaxPageSizeFlag,
@@ -122,9 +131,12 @@ var appFlags = []cli.Flag{
 	cmd.RestoreTargetDirFlag,
 	cmd.BoltMMapInitialSizeFlag,
 	cmd.ValidatorMonitorIndicesFlag,
+	cmd.EnableValidatorMonitorFlag,
+	cmd.ValidatorMonitorHistoryEpochsFlag,
 	cmd.ApiTimeoutFlag,
 	checkpoint.BlockPath,
 	checkpoint.StatePath,
+	checkpoint.DepositSnapshotPath,
 	checkpoint.RemoteURL,
 	genesis.StatePath,
 	genesis.BeaconAPIURL,
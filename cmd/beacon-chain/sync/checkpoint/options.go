@@ -22,6 +22,12 @@ var (
 		Usage: "Rather than syncing from genesis, you can start processing from a ssz-serialized BeaconState+Block." +
 			" This flag allows you to specify a local file containing the checkpoint Block to load.",
 	}
+	// DepositSnapshotPath optionally provides the EIP-4881 deposit tree snapshot matching the checkpoint state.
+	DepositSnapshotPath = &cli.PathFlag{
+		Name: "checkpoint-deposit-snapshot",
+		Usage: "When starting from a checkpoint BeaconState+Block, you can also specify a local file containing the " +
+			"ssz-serialized deposit tree snapshot of the finalized deposits, so that deposit logs do not need to be replayed.",
+	}
 	RemoteURL = &cli.StringFlag{
 		Name: "checkpoint-sync-url",
 		Usage: "URL of a synced beacon node to trust in obtaining checkpoint sync data. " +
@@ -36,6 +42,7 @@ var (
 func BeaconNodeOptions(c *cli.Context) (node.Option, error) {
 	blockPath := c.Path(BlockPath.Name)
 	statePath := c.Path(StatePath.Name)
+	depositSnapshotPath := c.Path(DepositSnapshotPath.Name)
 	remoteURL := c.String(RemoteURL.Name)
 	if remoteURL != "" {
 		return func(node *node.BeaconNode) error {
@@ -49,6 +56,9 @@ func BeaconNodeOptions(c *cli.Context) (node.Option, error) {
 	}
 
 	if blockPath == "" && statePath == "" {
+		if depositSnapshotPath != "" {
+			return nil, fmt.Errorf("--checkpoint-deposit-snapshot specified, but not --checkpoint-state and --checkpoint-block")
+		}
 		return nil, nil
 	}
 	if blockPath != "" && statePath == "" {
@@ -59,7 +69,7 @@ func BeaconNodeOptions(c *cli.Context) (node.Option, error) {
 	}
 
 	return func(node *node.BeaconNode) (err error) {
-		node.CheckpointInitializer, err = checkpoint.NewFileInitializer(blockPath, statePath)
+		node.CheckpointInitializer, err = checkpoint.NewFileInitializer(blockPath, statePath, depositSnapshotPath)
 		if err != nil {
 			return errors.Wrap(err, "error preparing to initialize checkpoint from local ssz files")
 		}
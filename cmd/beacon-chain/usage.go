@@ -63,6 +63,12 @@ var appHelpFlagGroups = []flagGroup{
 			cmd.MonitoringHostFlag,
 			cmd.BackupWebhookOutputDir,
 			cmd.EnableBackupWebhookFlag,
+			cmd.DBBackupIntervalFlag,
+			cmd.DBBackupRetentionFlag,
+			cmd.DBBackupCompressFlag,
+			cmd.ClientStatsAPIURLFlag,
+			cmd.ClientStatsPushIntervalFlag,
+			cmd.ClientStatsSpoolFileFlag,
 			flags.MonitoringPortFlag,
 			cmd.DisableMonitoringFlag,
 			cmd.MaxGoroutines,
@@ -76,6 +82,8 @@ var appHelpFlagGroups = []flagGroup{
 			cmd.RestoreTargetDirFlag,
 			cmd.BoltMMapInitialSizeFlag,
 			cmd.ValidatorMonitorIndicesFlag,
+			cmd.EnableValidatorMonitorFlag,
+			cmd.ValidatorMonitorHistoryEpochsFlag,
 			cmd.ApiTimeoutFlag,
 		},
 	},
@@ -104,6 +112,7 @@ var appHelpFlagGroups = []flagGroup{
 			flags.CertFlag,
 			flags.KeyFlag,
 			flags.HTTPModules,
+			flags.EnableNativeHTTPAPI,
 			flags.DisableGRPCGateway,
 			flags.GRPCGatewayHost,
 			flags.GRPCGatewayPort,
@@ -115,6 +124,8 @@ var appHelpFlagGroups = []flagGroup{
 			flags.HeadSync,
 			flags.DisableSync,
 			flags.SlotsPerArchivedPoint,
+			flags.HistoricalStateCacheSize,
+			flags.DBBackend,
 			flags.DisableDiscv5,
 			flags.BlockBatchLimit,
 			flags.BlockBatchLimitBurstFactor,
@@ -128,6 +139,7 @@ var appHelpFlagGroups = []flagGroup{
 			flags.MinPeersPerSubnet,
 			checkpoint.BlockPath,
 			checkpoint.StatePath,
+			checkpoint.DepositSnapshotPath,
 			checkpoint.RemoteURL,
 			genesis.StatePath,
 			genesis.BeaconAPIURL,
@@ -4,6 +4,7 @@ package cmd
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/prysmaticlabs/prysm/config/params"
 	"github.com/urfave/cli/v2"
@@ -49,6 +50,23 @@ var (
 		Name:  "db-backup-output-dir",
 		Usage: "Output directory for db backups",
 	}
+	// DBBackupIntervalFlag enables scheduled db snapshots.
+	DBBackupIntervalFlag = &cli.DurationFlag{
+		Name: "db-backup-interval",
+		Usage: "Interval between scheduled database snapshots, written to --db-backup-output-dir. " +
+			"The beacon node only takes a snapshot once a new checkpoint has been finalized. Disabled if 0.",
+	}
+	// DBBackupRetentionFlag specifies the number of scheduled db snapshots to keep.
+	DBBackupRetentionFlag = &cli.IntFlag{
+		Name:  "db-backup-retention",
+		Usage: "Number of scheduled database snapshots to keep, older snapshots are deleted",
+		Value: 5,
+	}
+	// DBBackupCompressFlag enables zstd compression of scheduled db snapshots.
+	DBBackupCompressFlag = &cli.BoolFlag{
+		Name:  "db-backup-compress",
+		Usage: "Compress scheduled database snapshots with zstd",
+	}
 	// EnableTracingFlag defines a flag to enable p2p message tracing.
 	EnableTracingFlag = &cli.BoolFlag{
 		Name:  "enable-tracing",
@@ -83,6 +101,24 @@ var (
 		Name:  "disable-monitoring",
 		Usage: "Disable monitoring service.",
 	}
+	// ClientStatsAPIURLFlag defines the client-stats endpoint to which the process pushes its stats.
+	ClientStatsAPIURLFlag = &cli.StringFlag{
+		Name: "clientstats-api-url",
+		Usage: "Full URL of a beaconcha.in compatible client-stats endpoint to which the process pushes its stats, " +
+			"eg https://beaconcha.in/api/v1/client/metrics?apikey=KEY&machine=NAME. Disabled if empty.",
+	}
+	// ClientStatsPushIntervalFlag defines the frequency of client-stats pushes.
+	ClientStatsPushIntervalFlag = &cli.DurationFlag{
+		Name:  "clientstats-push-interval",
+		Usage: "Frequency of client-stats pushes expressed as a duration, eg 2m or 1m5s.",
+		Value: 60 * time.Second,
+	}
+	// ClientStatsSpoolFileFlag defines the file keeping the client-stats which could not be pushed.
+	ClientStatsSpoolFileFlag = &cli.StringFlag{
+		Name: "clientstats-spool-file",
+		Usage: "File keeping the client-stats which could not be pushed while the endpoint is unreachable, " +
+			"pushed once it is reachable again. Defaults to clientstats-spool.jsonl in the data directory.",
+	}
 	// NoDiscovery specifies whether we are running a local network and have no need for connecting
 	// to the bootstrap nodes in the cloud
 	NoDiscovery = &cli.BoolFlag{
@@ -230,6 +266,19 @@ var (
 		Name:  "monitor-indices",
 		Usage: "List of validator indices to track performance",
 	}
+	// EnableValidatorMonitorFlag enables the validator monitor without validator indices to track,
+	// so that validators can be tracked at runtime through the API.
+	EnableValidatorMonitorFlag = &cli.BoolFlag{
+		Name:  "enable-validator-monitor",
+		Usage: "Enables the validator monitor and its API without validator indices to track, validators are then tracked at runtime through the API, when debug RPC endpoints are enabled, and when they register through PrepareBeaconProposer",
+	}
+	// ValidatorMonitorHistoryEpochsFlag specifies the number of epochs of performance
+	// summaries kept for each tracked validator.
+	ValidatorMonitorHistoryEpochsFlag = &cli.Uint64Flag{
+		Name:  "monitor-history-epochs",
+		Usage: "Number of epochs of performance summaries kept for each tracked validator",
+		Value: 64,
+	}
 
 	// RestoreSourceFileFlag specifies the filepath to the backed-up database file
 	// which will be used to restore the database.
@@ -243,6 +292,19 @@ var (
 		Usage: "Target directory of the restored database",
 		Value: DefaultDataDir(),
 	}
+	// RestoreAllowUnverifiedFlag allows restoring a backup which has no manifest.
+	RestoreAllowUnverifiedFlag = &cli.BoolFlag{
+		Name: "restore-allow-unverified",
+		Usage: "Restore a database backup which has no manifest, such as a backup taken by an older release. " +
+			"Its checksum and the chain it belongs to cannot be verified.",
+	}
+	// RestoreAllowStaleFlag allows restoring a validator database missing slashing protection
+	// history present in the database it replaces.
+	RestoreAllowStaleFlag = &cli.BoolFlag{
+		Name: "restore-allow-stale",
+		Usage: "Restore a validator database backup even if it is missing slashing protection history " +
+			"present in the database it replaces. This is dangerous and can lead to slashable signatures.",
+	}
 	// BoltMMapInitialSizeFlag specifies the initial size in bytes of boltdb's mmap syscall.
 	BoltMMapInitialSizeFlag = &cli.IntFlag{
 		Name:  "bolt-mmap-initial-size",
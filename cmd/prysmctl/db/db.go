@@ -0,0 +1,73 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/backend"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/kv"
+	"github.com/prysmaticlabs/prysm/cmd"
+	log "github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+var Commands = []*cli.Command{
+	{
+		Name:  "db",
+		Usage: "commands for inspecting and repairing the database of a stopped beacon node",
+		Subcommands: []*cli.Command{
+			inspectCmd,
+			verifyCmd,
+			repairCmd,
+			compactCmd,
+			migrateBackendCmd,
+		},
+	},
+}
+
+// openDB opens the beacon node database found in the data directory, without creating it.
+func openDB(ctx context.Context, cliCtx *cli.Context) (*kv.Store, func(), error) {
+	dbPath := filepath.Join(cliCtx.String(cmd.DataDirFlag.Name), kv.BeaconNodeDbDirName)
+	if _, ok := kv.ExistingBackend(dbPath); !ok {
+		return nil, nil, fmt.Errorf("no beacon node database found at %s", dbPath)
+	}
+	store, err := kv.NewKVStore(ctx, dbPath, &kv.Config{})
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "could not open beacon node database at %s", dbPath)
+	}
+	closeDB := func() {
+		if err := store.Close(); err != nil {
+			log.WithError(err).Error("Could not close beacon node database")
+		}
+	}
+	return store, closeDB, nil
+}
+
+// databaseSize returns the size in bytes of the database file or directory in the directory path.
+func databaseSize(dbPath string) (int64, error) {
+	kind, ok := kv.ExistingBackend(dbPath)
+	if !ok {
+		return 0, fmt.Errorf("no beacon node database found at %s", dbPath)
+	}
+	if kind == backend.KindBolt {
+		info, err := os.Stat(kv.KVStoreDatafilePath(dbPath))
+		if err != nil {
+			return 0, err
+		}
+		return info.Size(), nil
+	}
+	var size int64
+	err := filepath.Walk(kv.BackendPath(dbPath, kind), func(_ string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.IsDir() {
+			size += info.Size()
+		}
+		return nil
+	})
+	return size, err
+}
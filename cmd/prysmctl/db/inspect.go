@@ -0,0 +1,87 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/kv"
+	"github.com/prysmaticlabs/prysm/cmd"
+	"github.com/urfave/cli/v2"
+)
+
+var inspectCmd = &cli.Command{
+	Name:   "inspect",
+	Usage:  "Report the number of keys and the space used by every database bucket.",
+	Action: cliActionInspect,
+	Flags:  []cli.Flag{cmd.DataDirFlag},
+}
+
+var verifyCmd = &cli.Command{
+	Name: "verify",
+	Usage: "Check that blocks have a parent, that state summaries have a block, and that the slot " +
+		"and parent root indices only refer to existing blocks and states.",
+	Action: cliActionVerify,
+	Flags:  []cli.Flag{cmd.DataDirFlag},
+}
+
+func cliActionInspect(cliCtx *cli.Context) error {
+	ctx := context.Background()
+	store, closeDB, err := openDB(ctx, cliCtx)
+	if err != nil {
+		return err
+	}
+	defer closeDB()
+
+	stats, err := store.BucketStats(ctx)
+	if err != nil {
+		return err
+	}
+	sort.Slice(stats, func(i, j int) bool { return stats[i].AllocatedBytes > stats[j].AllocatedBytes })
+	fmt.Printf("%-40s %12s %16s %16s\n", "BUCKET", "KEYS", "IN USE (BYTES)", "ALLOCATED (BYTES)")
+	var keys, inUse, allocated int
+	for _, s := range stats {
+		fmt.Printf("%-40s %12d %16d %16d\n", s.Name, s.Keys, s.InUseBytes, s.AllocatedBytes)
+		keys += s.Keys
+		inUse += s.InUseBytes
+		allocated += s.AllocatedBytes
+	}
+	fmt.Printf("%-40s %12d %16d %16d\n", "TOTAL", keys, inUse, allocated)
+	return nil
+}
+
+func cliActionVerify(cliCtx *cli.Context) error {
+	ctx := context.Background()
+	store, closeDB, err := openDB(ctx, cliCtx)
+	if err != nil {
+		return err
+	}
+	defer closeDB()
+
+	report, err := store.VerifyConsistency(ctx)
+	if err != nil {
+		return err
+	}
+	printReport(report)
+	if !report.Consistent() {
+		return fmt.Errorf("database is inconsistent, run `prysmctl db repair` to rebuild its indices")
+	}
+	return nil
+}
+
+func printReport(r *kv.ConsistencyReport) {
+	fmt.Printf("Checked %d blocks and %d state summaries\n", r.Blocks, r.StateSummaries)
+	printRoots("Blocks with a missing parent", r.MissingParents)
+	printRoots("Blocks missing from the slot index", r.UnindexedBlocks)
+	printRoots("State summaries without a block", r.OrphanedSummaries)
+	fmt.Printf("Block slot index entries without a block: %d\n", r.OrphanedBlockSlotIndices)
+	fmt.Printf("Parent root index entries without a block: %d\n", r.OrphanedParentRootIndices)
+	fmt.Printf("State slot index entries without a state: %d\n", r.OrphanedStateSlotIndices)
+}
+
+func printRoots(title string, roots [][32]byte) {
+	fmt.Printf("%s: %d\n", title, len(roots))
+	for _, root := range roots {
+		fmt.Printf("  %#x\n", root)
+	}
+}
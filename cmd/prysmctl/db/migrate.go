@@ -0,0 +1,45 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/backend"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/kv"
+	"github.com/prysmaticlabs/prysm/cmd"
+	"github.com/urfave/cli/v2"
+)
+
+var migrateBackendFlags = struct {
+	To string
+}{}
+
+var migrateBackendCmd = &cli.Command{
+	Name: "migrate-backend",
+	Usage: "Copy the database into a new database using another storage backend. The previous database " +
+		"file or directory is kept with a .bak suffix, and can be deleted once the beacon node runs on the new one.",
+	Action: cliActionMigrateBackend,
+	Flags: []cli.Flag{
+		cmd.DataDirFlag,
+		&cli.StringFlag{
+			Name:        "to",
+			Usage:       fmt.Sprintf("storage backend to migrate the database to, %q, %q or %q", backend.KindBolt, backend.KindLevelDB, backend.KindPebble),
+			Destination: &migrateBackendFlags.To,
+			Required:    true,
+		},
+	},
+}
+
+func cliActionMigrateBackend(cliCtx *cli.Context) error {
+	to, err := backend.ParseKind(migrateBackendFlags.To)
+	if err != nil {
+		return err
+	}
+	dbPath := filepath.Join(cliCtx.String(cmd.DataDirFlag.Name), kv.BeaconNodeDbDirName)
+	if err := kv.MigrateBackend(context.Background(), dbPath, to); err != nil {
+		return errors.Wrap(err, "could not migrate database")
+	}
+	return nil
+}
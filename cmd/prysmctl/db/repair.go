@@ -0,0 +1,95 @@
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/cmd"
+	log "github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+var repairCmd = &cli.Command{
+	Name: "repair",
+	Usage: "Run pending migrations, then rebuild the block slot, parent root and state slot indices " +
+		"and the finalized block roots index.",
+	Action: cliActionRepair,
+	Flags:  []cli.Flag{cmd.DataDirFlag},
+}
+
+var compactFlags = struct {
+	OutputDir string
+}{}
+
+var compactCmd = &cli.Command{
+	Name: "compact",
+	Usage: "Copy the database into a new, compacted database. Replace the original beaconchain.db file " +
+		"or beaconchain.leveldb or beaconchain.pebble directory with the compacted one to reclaim space.",
+	Action: cliActionCompact,
+	Flags: []cli.Flag{
+		cmd.DataDirFlag,
+		&cli.StringFlag{
+			Name:        "output-dir",
+			Usage:       "directory to write the compacted database to",
+			Destination: &compactFlags.OutputDir,
+			Required:    true,
+		},
+	},
+}
+
+func cliActionRepair(cliCtx *cli.Context) error {
+	ctx := context.Background()
+	store, closeDB, err := openDB(ctx, cliCtx)
+	if err != nil {
+		return err
+	}
+	defer closeDB()
+
+	log.Info("Running pending database migrations")
+	if err := store.RunMigrations(ctx); err != nil {
+		return errors.Wrap(err, "could not run migrations")
+	}
+	log.Info("Rebuilding block and state indices")
+	if err := store.RebuildIndices(ctx); err != nil {
+		return errors.Wrap(err, "could not rebuild indices")
+	}
+	log.Info("Rebuilding finalized block roots index")
+	if err := store.RebuildFinalizedBlockRootsIndex(ctx); err != nil {
+		return errors.Wrap(err, "could not rebuild finalized block roots index")
+	}
+
+	report, err := store.VerifyConsistency(ctx)
+	if err != nil {
+		return err
+	}
+	printReport(report)
+	if !report.Consistent() {
+		log.Warn("Inconsistencies remain which cannot be repaired from the database content, such as missing blocks")
+	}
+	return nil
+}
+
+func cliActionCompact(cliCtx *cli.Context) error {
+	ctx := context.Background()
+	store, closeDB, err := openDB(ctx, cliCtx)
+	if err != nil {
+		return err
+	}
+	defer closeDB()
+
+	log.Infof("Compacting database into %s", compactFlags.OutputDir)
+	if err := store.Compact(ctx, compactFlags.OutputDir); err != nil {
+		return errors.Wrap(err, "could not compact database")
+	}
+	before, err := databaseSize(store.DatabasePath())
+	if err != nil {
+		return err
+	}
+	after, err := databaseSize(compactFlags.OutputDir)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Compacted database from %d to %d bytes\n", before, after)
+	return nil
+}
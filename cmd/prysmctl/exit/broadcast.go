@@ -0,0 +1,217 @@
+package exit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/api/client/beacon"
+	"github.com/prysmaticlabs/prysm/config/params"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/io/file"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/time/slots"
+	log "github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+var broadcastFlags = struct {
+	BeaconNodeHost   string
+	Timeout          time.Duration
+	ExitPaths        cli.StringSlice
+	WaitForInclusion bool
+	PollInterval     time.Duration
+}{}
+
+var broadcastCmd = &cli.Command{
+	Name:   "broadcast",
+	Usage:  "Validate pre-signed voluntary exits against the head state and submit them to a beacon node.",
+	Action: cliActionBroadcast,
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:        "beacon-node-host",
+			Usage:       "host:port for beacon node connection",
+			Destination: &broadcastFlags.BeaconNodeHost,
+			Value:       "localhost:3500",
+		},
+		&cli.DurationFlag{
+			Name:        "http-timeout",
+			Usage:       "timeout for http requests made to beacon-node-url (uses duration format, ex: 2m31s). default: 2m",
+			Destination: &broadcastFlags.Timeout,
+			Value:       time.Minute * 2,
+		},
+		&cli.StringSliceFlag{
+			Name:        "exit-path",
+			Usage:       "path to a pre-signed voluntary exit JSON file, or to a directory containing such files. May be repeated",
+			Destination: &broadcastFlags.ExitPaths,
+			Required:    true,
+		},
+		&cli.BoolFlag{
+			Name:        "wait-for-inclusion",
+			Usage:       "keep reporting the status of the submitted exits until all of them are included on chain",
+			Destination: &broadcastFlags.WaitForInclusion,
+		},
+		&cli.DurationFlag{
+			Name:        "poll-interval",
+			Usage:       "how often to check whether submitted exits were included when waiting for inclusion",
+			Destination: &broadcastFlags.PollInterval,
+			Value:       time.Minute,
+		},
+	},
+}
+
+func cliActionBroadcast(cliCtx *cli.Context) error {
+	ctx := cliCtx.Context
+	f := broadcastFlags
+
+	exits, err := readExits(f.ExitPaths.Value())
+	if err != nil {
+		return err
+	}
+	if len(exits) == 0 {
+		return errors.New("no voluntary exits found in the given paths")
+	}
+	opts := []beacon.ClientOpt{beacon.WithTimeout(f.Timeout)}
+	client, err := beacon.NewClient(f.BeaconNodeHost, opts...)
+	if err != nil {
+		return err
+	}
+	submitted, err := broadcastExits(ctx, client, exits)
+	if err != nil {
+		return err
+	}
+	log.Infof("Submitted %d of %d voluntary exits to %s", len(submitted), len(exits), client.NodeURL())
+	if !f.WaitForInclusion || len(submitted) == 0 {
+		return nil
+	}
+	return waitForInclusion(ctx, client, submitted, f.PollInterval)
+}
+
+// readExits reads pre-signed voluntary exits in the standard Beacon API JSON format. Each path
+// can either point to a single file or to a directory, in which case every .json file is read.
+func readExits(paths []string) ([]*ethpb.SignedVoluntaryExit, error) {
+	files := make([]string, 0, len(paths))
+	for _, p := range paths {
+		info, err := os.Stat(p)
+		if err != nil {
+			return nil, err
+		}
+		if !info.IsDir() {
+			files = append(files, p)
+			continue
+		}
+		dirFiles, err := file.DirFiles(p)
+		if err != nil {
+			return nil, err
+		}
+		for _, df := range dirFiles {
+			if filepath.Ext(df) == ".json" {
+				files = append(files, filepath.Join(p, df))
+			}
+		}
+	}
+	exits := make([]*ethpb.SignedVoluntaryExit, 0, len(files))
+	for _, fp := range files {
+		enc, err := file.ReadFileAsBytes(fp)
+		if err != nil {
+			return nil, err
+		}
+		exitJson := &beacon.SignedVoluntaryExitJson{}
+		if err := json.Unmarshal(enc, exitJson); err != nil {
+			return nil, errors.Wrapf(err, "could not decode voluntary exit in %s", fp)
+		}
+		e, err := exitJson.SignedVoluntaryExit()
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid voluntary exit in %s", fp)
+		}
+		exits = append(exits, e)
+	}
+	return exits, nil
+}
+
+// broadcastExits checks every exit against the validator's record in the head state, and submits
+// the ones which the beacon node should accept. It returns the indices of the submitted validators.
+func broadcastExits(ctx context.Context, client *beacon.Client, exits []*ethpb.SignedVoluntaryExit) ([]types.ValidatorIndex, error) {
+	headSlot, err := client.GetBlockHeaderSlot(ctx, beacon.IdHead)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get head slot")
+	}
+	currentEpoch := slots.ToEpoch(headSlot)
+	submitted := make([]types.ValidatorIndex, 0, len(exits))
+	for _, e := range exits {
+		idx := e.Exit.ValidatorIndex
+		logger := log.WithField("validatorIndex", idx)
+		if err := checkExit(ctx, client, e, currentEpoch); err != nil {
+			logger.WithError(err).Warn("Not submitting voluntary exit")
+			continue
+		}
+		if err := client.SubmitVoluntaryExit(ctx, e); err != nil {
+			logger.WithError(err).Error("Beacon node rejected voluntary exit")
+			continue
+		}
+		logger.Info("Submitted voluntary exit")
+		submitted = append(submitted, idx)
+	}
+	return submitted, nil
+}
+
+func checkExit(ctx context.Context, client *beacon.Client, e *ethpb.SignedVoluntaryExit, currentEpoch types.Epoch) error {
+	status, err := client.GetValidatorStatus(ctx, beacon.IdHead, e.Exit.ValidatorIndex)
+	if err != nil {
+		return err
+	}
+	if status.ExitEpoch != params.BeaconConfig().FarFutureEpoch {
+		return fmt.Errorf("validator is already exiting at epoch %d with status %s", status.ExitEpoch, status.Status)
+	}
+	if currentEpoch < e.Exit.Epoch {
+		return fmt.Errorf("exit is not valid until epoch %d, current epoch is %d", e.Exit.Epoch, currentEpoch)
+	}
+	if currentEpoch < status.ActivationEpoch+params.BeaconConfig().ShardCommitteePeriod {
+		return fmt.Errorf(
+			"validator has not been active long enough to exit, earliest exit epoch is %d",
+			status.ActivationEpoch+params.BeaconConfig().ShardCommitteePeriod,
+		)
+	}
+	return nil
+}
+
+// waitForInclusion polls the beacon node until every submitted exit was included on chain,
+// which is visible through the validator's exit epoch being set in the head state.
+func waitForInclusion(ctx context.Context, client *beacon.Client, indices []types.ValidatorIndex, interval time.Duration) error {
+	pending := make(map[types.ValidatorIndex]bool, len(indices))
+	for _, idx := range indices {
+		pending[idx] = true
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		for idx := range pending {
+			status, err := client.GetValidatorStatus(ctx, beacon.IdHead, idx)
+			if err != nil {
+				log.WithError(err).WithField("validatorIndex", idx).Error("Could not get validator status")
+				continue
+			}
+			if status.ExitEpoch != params.BeaconConfig().FarFutureEpoch {
+				log.WithFields(log.Fields{
+					"validatorIndex": idx,
+					"status":         status.Status,
+					"exitEpoch":      status.ExitEpoch,
+				}).Info("Voluntary exit included on chain")
+				delete(pending, idx)
+			}
+		}
+		if len(pending) == 0 {
+			return nil
+		}
+		log.Infof("Waiting for %d voluntary exits to be included", len(pending))
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
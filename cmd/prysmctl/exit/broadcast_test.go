@@ -0,0 +1,92 @@
+package exit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/api/client/beacon"
+	"github.com/prysmaticlabs/prysm/config/params"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/testing/require"
+)
+
+func TestReadExits(t *testing.T) {
+	dir := t.TempDir()
+	for i := 0; i < 2; i++ {
+		e := &ethpb.SignedVoluntaryExit{
+			Exit:      &ethpb.VoluntaryExit{Epoch: 10, ValidatorIndex: types.ValidatorIndex(i)},
+			Signature: make([]byte, 96),
+		}
+		enc, err := json.Marshal(beacon.NewSignedVoluntaryExitJson(e))
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(filepath.Join(dir, fmt.Sprintf("voluntary-exit-%d.json", i)), enc, 0600))
+	}
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "README"), []byte("ignored"), 0600))
+
+	exits, err := readExits([]string{dir})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(exits))
+
+	exits, err = readExits([]string{filepath.Join(dir, "voluntary-exit-1.json")})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(exits))
+	require.Equal(t, types.ValidatorIndex(1), exits[0].Exit.ValidatorIndex)
+}
+
+func TestBroadcastExits(t *testing.T) {
+	farFuture := params.BeaconConfig().FarFutureEpoch
+	period := params.BeaconConfig().ShardCommitteePeriod
+	headEpoch := period + 10
+	// Validator 0 can exit, 1 is already exiting and 2 signed an exit for a future epoch.
+	exitEpochs := map[string]types.Epoch{"0": farFuture, "1": headEpoch + 5, "2": farFuture}
+	submitted := make([]string, 0)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var resp string
+		switch {
+		case r.URL.Path == "/eth/v1/beacon/headers/head":
+			resp = fmt.Sprintf(`{"data":{"header":{"message":{"slot":"%d"}}}}`, uint64(headEpoch)*uint64(params.BeaconConfig().SlotsPerEpoch))
+		case strings.HasPrefix(r.URL.Path, "/eth/v1/beacon/states/head/validators/"):
+			idx := strings.TrimPrefix(r.URL.Path, "/eth/v1/beacon/states/head/validators/")
+			resp = fmt.Sprintf(
+				`{"data":{"index":"%s","status":"active_ongoing","validator":{"activation_epoch":"0","exit_epoch":"%d"}}}`,
+				idx, exitEpochs[idx],
+			)
+		case r.URL.Path == "/eth/v1/beacon/pool/voluntary_exits":
+			e := &beacon.SignedVoluntaryExitJson{}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(e))
+			submitted = append(submitted, e.Message.ValidatorIndex)
+			return
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, err := w.Write([]byte(resp))
+		require.NoError(t, err)
+	}))
+	defer srv.Close()
+	client, err := beacon.NewClient(srv.URL)
+	require.NoError(t, err)
+
+	exits := []*ethpb.SignedVoluntaryExit{
+		{Exit: &ethpb.VoluntaryExit{Epoch: headEpoch, ValidatorIndex: 0}, Signature: make([]byte, 96)},
+		{Exit: &ethpb.VoluntaryExit{Epoch: headEpoch, ValidatorIndex: 1}, Signature: make([]byte, 96)},
+		{Exit: &ethpb.VoluntaryExit{Epoch: headEpoch + 1, ValidatorIndex: 2}, Signature: make([]byte, 96)},
+	}
+	indices, err := broadcastExits(context.Background(), client, exits)
+	require.NoError(t, err)
+	require.DeepEqual(t, []types.ValidatorIndex{0}, indices)
+	require.DeepEqual(t, []string{"0"}, submitted)
+
+	// Once the exit is included the wait returns immediately.
+	exitEpochs["0"] = headEpoch + 5
+	require.NoError(t, waitForInclusion(context.Background(), client, indices, time.Millisecond))
+}
@@ -0,0 +1,13 @@
+package exit
+
+import "github.com/urfave/cli/v2"
+
+var Commands = []*cli.Command{
+	{
+		Name:  "voluntary-exit",
+		Usage: "commands for managing pre-signed voluntary exits",
+		Subcommands: []*cli.Command{
+			broadcastCmd,
+		},
+	},
+}
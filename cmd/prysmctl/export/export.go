@@ -0,0 +1,116 @@
+package export
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/export"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/kv"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	"github.com/prysmaticlabs/prysm/cmd"
+	log "github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+var exportFlags = struct {
+	Dataset string
+	Format  string
+	Start   uint64
+	End     uint64
+	Output  string
+}{}
+
+var Commands = []*cli.Command{
+	{
+		Name:  "export",
+		Usage: "Export historical chain data from a beacon node database. The beacon node must not be running.",
+		Description: "Streams blocks, attestations or deposits for an inclusive slot range, or validators or balances " +
+			"for an inclusive epoch range, as newline-delimited JSON, CSV or columnar row groups.",
+		Action: cliActionExport,
+		Flags: []cli.Flag{
+			cmd.DataDirFlag,
+			&cli.StringFlag{
+				Name:        "dataset",
+				Usage:       "data to export: blocks, attestations, deposits, validators or balances",
+				Destination: &exportFlags.Dataset,
+				Required:    true,
+			},
+			&cli.StringFlag{
+				Name:        "format",
+				Usage:       "output format: json (one object per line), csv or columnar (one object of column values per group of rows)",
+				Destination: &exportFlags.Format,
+				Value:       string(export.JSON),
+			},
+			&cli.Uint64Flag{
+				Name:        "start",
+				Usage:       "first slot, or first epoch for validators and balances, to export",
+				Destination: &exportFlags.Start,
+			},
+			&cli.Uint64Flag{
+				Name:        "end",
+				Usage:       "last slot, or last epoch for validators and balances, to export",
+				Destination: &exportFlags.End,
+				Required:    true,
+			},
+			&cli.StringFlag{
+				Name:        "output",
+				Usage:       "file to write the exported data to, instead of the standard output",
+				Destination: &exportFlags.Output,
+			},
+		},
+	},
+}
+
+func cliActionExport(cliCtx *cli.Context) error {
+	ctx := context.Background()
+	f := exportFlags
+	req := &export.Request{
+		Dataset: export.Dataset(f.Dataset),
+		Format:  export.Format(f.Format),
+		Start:   f.Start,
+		End:     f.End,
+	}
+	if err := req.Validate(); err != nil {
+		return err
+	}
+
+	dbPath := filepath.Join(cliCtx.String(cmd.DataDirFlag.Name), kv.BeaconNodeDbDirName)
+	beaconDB, err := db.NewDB(ctx, dbPath, &kv.Config{})
+	if err != nil {
+		return errors.Wrapf(err, "could not open beacon node database at %s", dbPath)
+	}
+	defer func() {
+		if err := beaconDB.Close(); err != nil {
+			log.WithError(err).Error("Could not close beacon node database")
+		}
+	}()
+	chain, err := export.NewDBChain(ctx, beaconDB)
+	if err != nil {
+		return errors.Wrap(err, "could not determine the canonical chain")
+	}
+	exporter := export.New(beaconDB, chain, stategen.NewCanonicalHistory(beaconDB, chain, chain))
+
+	var out io.Writer = os.Stdout
+	if f.Output != "" {
+		file, err := os.Create(f.Output)
+		if err != nil {
+			return errors.Wrapf(err, "could not create %s", f.Output)
+		}
+		defer func() {
+			if err := file.Close(); err != nil {
+				log.WithError(err).Errorf("Could not close %s", f.Output)
+			}
+		}()
+		out = file
+	}
+	w := bufio.NewWriter(out)
+	if err := exporter.Export(ctx, req, w); err != nil {
+		return err
+	}
+	return w.Flush()
+}
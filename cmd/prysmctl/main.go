@@ -4,6 +4,9 @@ import (
 	"os"
 
 	"github.com/prysmaticlabs/prysm/cmd/prysmctl/checkpoint"
+	"github.com/prysmaticlabs/prysm/cmd/prysmctl/db"
+	"github.com/prysmaticlabs/prysm/cmd/prysmctl/exit"
+	"github.com/prysmaticlabs/prysm/cmd/prysmctl/export"
 	log "github.com/sirupsen/logrus"
 	"github.com/urfave/cli/v2"
 )
@@ -22,4 +25,7 @@ func main() {
 
 func init() {
 	prysmctlCommands = append(prysmctlCommands, checkpoint.Commands...)
+	prysmctlCommands = append(prysmctlCommands, db.Commands...)
+	prysmctlCommands = append(prysmctlCommands, exit.Commands...)
+	prysmctlCommands = append(prysmctlCommands, export.Commands...)
 }
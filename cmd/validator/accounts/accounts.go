@@ -51,6 +51,61 @@ var Commands = &cli.Command{
 				return nil
 			},
 		},
+		{
+			Name: "disable",
+			Description: "disables the selected accounts without deleting them from the wallet. Disabled accounts keep " +
+				"their slashing protection history but do not perform any duties. The validator client must be stopped.",
+			Flags: cmd.WrapFlags([]cli.Flag{
+				cmd.DataDirFlag,
+				flags.DisablePublicKeysFlag,
+				features.Mainnet,
+				features.PraterTestnet,
+				features.RopstenTestnet,
+				cmd.AcceptTosFlag,
+			}),
+			Before: func(cliCtx *cli.Context) error {
+				if err := cmd.LoadFlagsFromConfig(cliCtx, cliCtx.Command.Flags); err != nil {
+					return err
+				}
+				return tos.VerifyTosAcceptedOrPrompt(cliCtx)
+			},
+			Action: func(cliCtx *cli.Context) error {
+				if err := features.ConfigureValidator(cliCtx); err != nil {
+					return err
+				}
+				if err := accountsSetDisabled(cliCtx, flags.DisablePublicKeysFlag, true); err != nil {
+					log.Fatalf("Could not disable accounts: %v", err)
+				}
+				return nil
+			},
+		},
+		{
+			Name:        "enable",
+			Description: "enables accounts which were previously disabled. The validator client must be stopped.",
+			Flags: cmd.WrapFlags([]cli.Flag{
+				cmd.DataDirFlag,
+				flags.EnablePublicKeysFlag,
+				features.Mainnet,
+				features.PraterTestnet,
+				features.RopstenTestnet,
+				cmd.AcceptTosFlag,
+			}),
+			Before: func(cliCtx *cli.Context) error {
+				if err := cmd.LoadFlagsFromConfig(cliCtx, cliCtx.Command.Flags); err != nil {
+					return err
+				}
+				return tos.VerifyTosAcceptedOrPrompt(cliCtx)
+			},
+			Action: func(cliCtx *cli.Context) error {
+				if err := features.ConfigureValidator(cliCtx); err != nil {
+					return err
+				}
+				if err := accountsSetDisabled(cliCtx, flags.EnablePublicKeysFlag, false); err != nil {
+					log.Fatalf("Could not enable accounts: %v", err)
+				}
+				return nil
+			},
+		},
 		{
 			Name:        "list",
 			Description: "Lists all validator accounts in a user's wallet directory",
@@ -189,5 +244,44 @@ var Commands = &cli.Command{
 				return nil
 			},
 		},
+		{
+			Name: "presign-exit",
+			Description: "Signs voluntary exits for selected accounts at a given epoch and writes them to disk as " +
+				"standard JSON files without broadcasting them. The files can be broadcast later with prysmctl",
+			Flags: cmd.WrapFlags([]cli.Flag{
+				flags.WalletDirFlag,
+				flags.WalletPasswordFileFlag,
+				flags.AccountPasswordFileFlag,
+				flags.VoluntaryExitPublicKeysFlag,
+				flags.ExitEpochFlag,
+				flags.ExitOutputDirFlag,
+				flags.BeaconRPCProviderFlag,
+				cmd.GrpcMaxCallRecvMsgSizeFlag,
+				flags.CertFlag,
+				flags.GrpcHeadersFlag,
+				flags.GrpcRetriesFlag,
+				flags.GrpcRetryDelayFlag,
+				flags.ExitAllFlag,
+				features.Mainnet,
+				features.PraterTestnet,
+				features.RopstenTestnet,
+				cmd.AcceptTosFlag,
+			}),
+			Before: func(cliCtx *cli.Context) error {
+				if err := cmd.LoadFlagsFromConfig(cliCtx, cliCtx.Command.Flags); err != nil {
+					return err
+				}
+				return tos.VerifyTosAcceptedOrPrompt(cliCtx)
+			},
+			Action: func(cliCtx *cli.Context) error {
+				if err := features.ConfigureValidator(cliCtx); err != nil {
+					return err
+				}
+				if err := accounts.PresignExitsCli(cliCtx, os.Stdin); err != nil {
+					log.Fatalf("Could not pre-sign voluntary exits: %v", err)
+				}
+				return nil
+			},
+		},
 	},
 }
@@ -0,0 +1,78 @@
+package accounts
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/cmd"
+	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/validator/db/kv"
+	"github.com/urfave/cli/v2"
+)
+
+// Marks the public keys given by the flag as disabled, or enabled again, in the
+// validator database located in the data directory. Disabled keys remain in the wallet
+// and keep their slashing protection history, but the validator client will not
+// perform any duties for them.
+func accountsSetDisabled(c *cli.Context, keysFlag *cli.StringFlag, disabled bool) error {
+	pubKeys, err := parsePublicKeysFlag(c.String(keysFlag.Name))
+	if err != nil {
+		return err
+	}
+	if len(pubKeys) == 0 {
+		return fmt.Errorf("no public keys specified, please use the --%s flag", keysFlag.Name)
+	}
+	dataDir := c.String(cmd.DataDirFlag.Name)
+	valDB, err := kv.NewKVStore(c.Context, dataDir, &kv.Config{})
+	if err != nil {
+		return errors.Wrapf(err, "could not access validator database at path %s", dataDir)
+	}
+	defer func() {
+		if err := valDB.Close(); err != nil {
+			log.WithError(err).Error("Could not close validator DB")
+		}
+	}()
+	if disabled {
+		err = valDB.DisablePublicKeys(c.Context, pubKeys)
+	} else {
+		err = valDB.EnablePublicKeys(c.Context, pubKeys)
+	}
+	if err != nil {
+		return err
+	}
+	formattedKeys := make([]string, len(pubKeys))
+	for i, pk := range pubKeys {
+		formattedKeys[i] = fmt.Sprintf("%#x", bytesutil.Trunc(pk[:]))
+	}
+	if disabled {
+		log.WithField("publicKeys", strings.Join(formattedKeys, ", ")).Info("Disabled accounts")
+	} else {
+		log.WithField("publicKeys", strings.Join(formattedKeys, ", ")).Info("Enabled accounts")
+	}
+	return nil
+}
+
+func parsePublicKeysFlag(value string) ([][fieldparams.BLSPubkeyLength]byte, error) {
+	pubKeys := make([][fieldparams.BLSPubkeyLength]byte, 0)
+	for _, str := range strings.Split(value, ",") {
+		str = strings.TrimSpace(str)
+		if str == "" {
+			continue
+		}
+		if !strings.HasPrefix(str, "0x") {
+			str = "0x" + str
+		}
+		pk, err := hexutil.Decode(str)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not decode public key %s", str)
+		}
+		if len(pk) != fieldparams.BLSPubkeyLength {
+			return nil, fmt.Errorf("public key %s has length %d, expected %d", str, len(pk), fieldparams.BLSPubkeyLength)
+		}
+		pubKeys = append(pubKeys, bytesutil.ToBytes48(pk))
+	}
+	return pubKeys, nil
+}
@@ -0,0 +1,55 @@
+package accounts
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/cmd"
+	"github.com/prysmaticlabs/prysm/cmd/validator/flags"
+	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	"github.com/prysmaticlabs/prysm/validator/db/kv"
+	"github.com/urfave/cli/v2"
+)
+
+func TestAccountsSetDisabled(t *testing.T) {
+	dataDir := t.TempDir()
+	pubKey := [fieldparams.BLSPubkeyLength]byte{1, 2, 3}
+	app := cli.App{}
+	set := flag.NewFlagSet("test", 0)
+	set.String(cmd.DataDirFlag.Name, dataDir, "")
+	set.String(flags.DisablePublicKeysFlag.Name, fmt.Sprintf("%#x", pubKey), "")
+	set.String(flags.EnablePublicKeysFlag.Name, fmt.Sprintf("%x", pubKey), "")
+	cliCtx := cli.NewContext(&app, set, nil)
+	cliCtx.Context = context.Background()
+
+	require.NoError(t, accountsSetDisabled(cliCtx, flags.DisablePublicKeysFlag, true))
+	valDB, err := kv.NewKVStore(cliCtx.Context, dataDir, &kv.Config{})
+	require.NoError(t, err)
+	disabled, err := valDB.DisabledPublicKeys(cliCtx.Context)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(disabled))
+	assert.Equal(t, pubKey, disabled[0])
+	require.NoError(t, valDB.Close())
+
+	require.NoError(t, accountsSetDisabled(cliCtx, flags.EnablePublicKeysFlag, false))
+	valDB, err = kv.NewKVStore(cliCtx.Context, dataDir, &kv.Config{})
+	require.NoError(t, err)
+	disabled, err = valDB.DisabledPublicKeys(cliCtx.Context)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(disabled))
+	require.NoError(t, valDB.Close())
+}
+
+func TestAccountsSetDisabled_InvalidKey(t *testing.T) {
+	app := cli.App{}
+	set := flag.NewFlagSet("test", 0)
+	set.String(cmd.DataDirFlag.Name, t.TempDir(), "")
+	set.String(flags.DisablePublicKeysFlag.Name, "0x1234", "")
+	cliCtx := cli.NewContext(&app, set, nil)
+	err := accountsSetDisabled(cliCtx, flags.DisablePublicKeysFlag, true)
+	assert.ErrorContains(t, "has length 2", err)
+}
@@ -22,6 +22,8 @@ var Commands = &cli.Command{
 			Flags: cmd.WrapFlags([]cli.Flag{
 				cmd.RestoreSourceFileFlag,
 				cmd.RestoreTargetDirFlag,
+				cmd.RestoreAllowUnverifiedFlag,
+				cmd.RestoreAllowStaleFlag,
 			}),
 			Before: tos.VerifyTosAcceptedOrPrompt,
 			Action: func(cliCtx *cli.Context) error {
@@ -192,6 +192,20 @@ var (
 		Usage: "Comma-separated list of public key hex strings to specify which validator accounts to delete",
 		Value: "",
 	}
+	// DisablePublicKeysFlag defines a comma-separated list of hex string public keys
+	// for accounts which a user desires to disable without removing them from their wallet.
+	DisablePublicKeysFlag = &cli.StringFlag{
+		Name:  "disable-public-keys",
+		Usage: "Comma-separated list of public key hex strings to specify which validator accounts to disable",
+		Value: "",
+	}
+	// EnablePublicKeysFlag defines a comma-separated list of hex string public keys
+	// for previously disabled accounts which a user desires to enable again.
+	EnablePublicKeysFlag = &cli.StringFlag{
+		Name:  "enable-public-keys",
+		Usage: "Comma-separated list of public key hex strings to specify which disabled validator accounts to enable",
+		Value: "",
+	}
 	// BackupPublicKeysFlag defines a comma-separated list of hex string public keys
 	// for accounts which a user desires to backup from their wallet.
 	BackupPublicKeysFlag = &cli.StringFlag{
@@ -207,6 +221,17 @@ var (
 			"a voluntary exit",
 		Value: "",
 	}
+	// ExitEpochFlag defines the epoch at which pre-signed voluntary exits become valid.
+	ExitEpochFlag = &cli.Uint64Flag{
+		Name:  "exit-epoch",
+		Usage: "Epoch at which the pre-signed voluntary exits become valid. Defaults to the current epoch",
+	}
+	// ExitOutputDirFlag defines the directory to which pre-signed voluntary exits are written.
+	ExitOutputDirFlag = &cli.StringFlag{
+		Name:  "exit-output-dir",
+		Usage: "Directory to which pre-signed voluntary exits are written as standard JSON files",
+		Value: "",
+	}
 	// ExitAllFlag allows stakers to select all validating keys for exit. This will still require the staker
 	// to confirm a userprompt for this action given it is a dangerous one.
 	ExitAllFlag = &cli.BoolFlag{
@@ -287,6 +312,14 @@ var (
 		Value: "",
 	}
 
+	// Web3SignerMaxConcurrentRequestsFlag defines the maximum number of signing requests sent to the web3signer at
+	// the same time when the validator client signs for many keys at once.
+	Web3SignerMaxConcurrentRequestsFlag = &cli.IntFlag{
+		Name:  "validators-external-signer-max-concurrent-requests",
+		Usage: "Maximum number of concurrent signing requests sent to the web3signer when signing for many keys at once",
+		Value: 32,
+	}
+
 	// KeymanagerKindFlag defines the kind of keymanager desired by a user during wallet creation.
 	KeymanagerKindFlag = &cli.StringFlag{
 		Name:  "keymanager-kind",
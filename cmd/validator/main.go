@@ -75,14 +75,21 @@ var appFlags = []cli.Flag{
 	// Consensys' Web3Signer flags
 	flags.Web3SignerURLFlag,
 	flags.Web3SignerPublicValidatorKeysFlag,
+	flags.Web3SignerMaxConcurrentRequestsFlag,
 	flags.FeeRecipientConfigFileFlag,
 	flags.FeeRecipientConfigURLFlag,
 	flags.SuggestedFeeRecipientFlag,
 	////////////////////
 	cmd.DisableMonitoringFlag,
+	cmd.ClientStatsAPIURLFlag,
+	cmd.ClientStatsPushIntervalFlag,
+	cmd.ClientStatsSpoolFileFlag,
 	cmd.MonitoringHostFlag,
 	cmd.BackupWebhookOutputDir,
 	cmd.EnableBackupWebhookFlag,
+	cmd.DBBackupIntervalFlag,
+	cmd.DBBackupRetentionFlag,
+	cmd.DBBackupCompressFlag,
 	cmd.MinimalConfigFlag,
 	cmd.E2EConfigFlag,
 	cmd.VerbosityFlag,
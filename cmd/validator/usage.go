@@ -52,6 +52,9 @@ var appHelpFlagGroups = []flagGroup{
 			cmd.ForceClearDB,
 			cmd.EnableBackupWebhookFlag,
 			cmd.BackupWebhookOutputDir,
+			cmd.DBBackupIntervalFlag,
+			cmd.DBBackupRetentionFlag,
+			cmd.DBBackupCompressFlag,
 			cmd.EnableTracingFlag,
 			cmd.TracingProcessNameFlag,
 			cmd.TracingEndpointFlag,
@@ -59,6 +62,9 @@ var appHelpFlagGroups = []flagGroup{
 			cmd.MonitoringHostFlag,
 			flags.MonitoringPortFlag,
 			cmd.DisableMonitoringFlag,
+			cmd.ClientStatsAPIURLFlag,
+			cmd.ClientStatsPushIntervalFlag,
+			cmd.ClientStatsSpoolFileFlag,
 			cmd.LogFormat,
 			cmd.LogFileName,
 			cmd.ConfigFileFlag,
@@ -109,6 +115,7 @@ var appHelpFlagGroups = []flagGroup{
 			flags.EnableDutyCountDown,
 			flags.Web3SignerURLFlag,
 			flags.Web3SignerPublicValidatorKeysFlag,
+			flags.Web3SignerMaxConcurrentRequestsFlag,
 			flags.FeeRecipientConfigFileFlag,
 			flags.FeeRecipientConfigURLFlag,
 			flags.SuggestedFeeRecipientFlag,
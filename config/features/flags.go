@@ -117,6 +117,18 @@ var (
 		Name:  "enable-gossip-batch-aggregation",
 		Usage: "Enables new methods to further aggregate our gossip batches before verifying them.",
 	}
+	prepareAllPayloads = &cli.BoolFlag{
+		Name: "prepare-all-payloads",
+		Usage: "Informs the execution engine to prepare a payload for every upcoming proposer, not only for the " +
+			"validators attached to this node. Useful for builders and relays running next to the node.",
+	}
+	enableOnlyBlindedBeaconBlocks = &cli.BoolFlag{
+		Name: "enable-only-blinded-beacon-blocks",
+		Usage: "Saves Bellatrix beacon blocks blinded in the database, keeping only the execution payload header. " +
+			"Full payloads are retrieved from the execution client when serving blocks to peers or through the API. " +
+			"(Warning): Once enabled, existing blocks are migrated and the database keeps saving blinded blocks " +
+			"even if the flag is removed.",
+	}
 )
 
 // devModeFlags holds list of flags that are set when development mode is on.
@@ -165,6 +177,8 @@ var BeaconChainFlags = append(deprecatedFlags, []cli.Flag{
 	enableVecHTR,
 	enableForkChoiceDoublyLinkedTree,
 	enableGossipBatchAggregation,
+	prepareAllPayloads,
+	enableOnlyBlindedBeaconBlocks,
 }...)
 
 // E2EBeaconChainFlags contains a list of the beacon chain feature flags to be tested in E2E.
@@ -1,9 +1,14 @@
 package wrapper
 
 import (
+	"fmt"
+
 	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/consensus-types/forks/bellatrix"
 	"github.com/prysmaticlabs/prysm/consensus-types/interfaces"
+	enginev1 "github.com/prysmaticlabs/prysm/proto/engine/v1"
 	eth "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/runtime/version"
 )
 
 var (
@@ -145,6 +150,109 @@ func BuildSignedBeaconBlock(blk interfaces.BeaconBlock, signature []byte) (inter
 	}
 }
 
+// BlindSignedBeaconBlock converts a full bellatrix signed beacon block into its blinded
+// counterpart, replacing the execution payload with its header. The signature and the block
+// root are unchanged.
+func BlindSignedBeaconBlock(blk interfaces.SignedBeaconBlock) (interfaces.SignedBeaconBlock, error) {
+	if err := BeaconBlockIsNil(blk); err != nil {
+		return nil, err
+	}
+	if blk.Version() != version.Bellatrix {
+		return nil, errors.Wrapf(ErrUnsupportedSignedBeaconBlock, "unable to blind block of version %s", version.String(blk.Version()))
+	}
+	pb, err := blk.PbBellatrixBlock()
+	if err != nil {
+		return nil, err
+	}
+	header, err := bellatrix.PayloadToHeader(pb.Block.Body.ExecutionPayload)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not convert execution payload to header")
+	}
+	b := pb.Block
+	return WrappedSignedBeaconBlock(&eth.SignedBlindedBeaconBlockBellatrix{
+		Block: &eth.BlindedBeaconBlockBellatrix{
+			Slot:          b.Slot,
+			ProposerIndex: b.ProposerIndex,
+			ParentRoot:    b.ParentRoot,
+			StateRoot:     b.StateRoot,
+			Body: &eth.BlindedBeaconBlockBodyBellatrix{
+				RandaoReveal:           b.Body.RandaoReveal,
+				Eth1Data:               b.Body.Eth1Data,
+				Graffiti:               b.Body.Graffiti,
+				ProposerSlashings:      b.Body.ProposerSlashings,
+				AttesterSlashings:      b.Body.AttesterSlashings,
+				Attestations:           b.Body.Attestations,
+				Deposits:               b.Body.Deposits,
+				VoluntaryExits:         b.Body.VoluntaryExits,
+				SyncAggregate:          b.Body.SyncAggregate,
+				ExecutionPayloadHeader: header,
+			},
+		},
+		Signature: pb.Signature,
+	})
+}
+
+// BuildSignedBeaconBlockFromExecutionPayload fills in the execution payload of a blinded bellatrix
+// signed beacon block, returning the corresponding full block. The payload must match the header
+// committed to in the blinded block.
+func BuildSignedBeaconBlockFromExecutionPayload(
+	blk interfaces.SignedBeaconBlock, payload *enginev1.ExecutionPayload,
+) (interfaces.SignedBeaconBlock, error) {
+	if err := BeaconBlockIsNil(blk); err != nil {
+		return nil, err
+	}
+	if blk.Version() != version.BellatrixBlind {
+		return nil, errors.Wrapf(ErrUnsupportedSignedBeaconBlock, "block of version %s is not blinded", version.String(blk.Version()))
+	}
+	if payload == nil {
+		return nil, errors.New("nil execution payload")
+	}
+	pb, err := blk.PbBlindedBellatrixBlock()
+	if err != nil {
+		return nil, err
+	}
+	b := pb.Block
+	header, err := bellatrix.PayloadToHeader(payload)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not convert execution payload to header")
+	}
+	wantRoot, err := b.Body.ExecutionPayloadHeader.HashTreeRoot()
+	if err != nil {
+		return nil, err
+	}
+	gotRoot, err := header.HashTreeRoot()
+	if err != nil {
+		return nil, err
+	}
+	if wantRoot != gotRoot {
+		return nil, fmt.Errorf(
+			"execution payload header root %#x does not match payload root %#x for block hash %#x",
+			wantRoot, gotRoot, payload.BlockHash,
+		)
+	}
+	return WrappedSignedBeaconBlock(&eth.SignedBeaconBlockBellatrix{
+		Block: &eth.BeaconBlockBellatrix{
+			Slot:          b.Slot,
+			ProposerIndex: b.ProposerIndex,
+			ParentRoot:    b.ParentRoot,
+			StateRoot:     b.StateRoot,
+			Body: &eth.BeaconBlockBodyBellatrix{
+				RandaoReveal:      b.Body.RandaoReveal,
+				Eth1Data:          b.Body.Eth1Data,
+				Graffiti:          b.Body.Graffiti,
+				ProposerSlashings: b.Body.ProposerSlashings,
+				AttesterSlashings: b.Body.AttesterSlashings,
+				Attestations:      b.Body.Attestations,
+				Deposits:          b.Body.Deposits,
+				VoluntaryExits:    b.Body.VoluntaryExits,
+				SyncAggregate:     b.Body.SyncAggregate,
+				ExecutionPayload:  payload,
+			},
+		},
+		Signature: pb.Signature,
+	})
+}
+
 func UnwrapGenericSignedBeaconBlock(gb *eth.GenericSignedBeaconBlock) (interfaces.SignedBeaconBlock, error) {
 	if gb == nil {
 		return nil, ErrNilObjectWrapped
@@ -3,7 +3,12 @@ package wrapper_test
 import (
 	"testing"
 
+	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/consensus-types/wrapper"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	enginev1 "github.com/prysmaticlabs/prysm/proto/engine/v1"
+	"github.com/prysmaticlabs/prysm/runtime/version"
+	"github.com/prysmaticlabs/prysm/testing/assert"
 	"github.com/prysmaticlabs/prysm/testing/require"
 	"github.com/prysmaticlabs/prysm/testing/util"
 )
@@ -43,3 +48,68 @@ func TestWrappedSignedBeaconBlock(t *testing.T) {
 		})
 	}
 }
+
+func TestBlindSignedBeaconBlock(t *testing.T) {
+	b := util.NewBeaconBlockBellatrix()
+	b.Block.Slot = 5
+	b.Block.Body.ExecutionPayload.BlockNumber = 10
+	b.Block.Body.ExecutionPayload.BlockHash = bytesutil.PadTo([]byte("hash"), 32)
+	b.Block.Body.ExecutionPayload.Transactions = [][]byte{[]byte("tx1"), []byte("tx2")}
+	wsb, err := wrapper.WrappedSignedBeaconBlock(b)
+	require.NoError(t, err)
+
+	blinded, err := wrapper.BlindSignedBeaconBlock(wsb)
+	require.NoError(t, err)
+	assert.Equal(t, version.BellatrixBlind, blinded.Version())
+	wantRoot, err := wsb.Block().HashTreeRoot()
+	require.NoError(t, err)
+	gotRoot, err := blinded.Block().HashTreeRoot()
+	require.NoError(t, err)
+	assert.Equal(t, wantRoot, gotRoot)
+	header, err := blinded.Block().Body().ExecutionPayloadHeader()
+	require.NoError(t, err)
+	assert.DeepEqual(t, b.Block.Body.ExecutionPayload.BlockHash, header.BlockHash)
+
+	full, err := wrapper.BuildSignedBeaconBlockFromExecutionPayload(blinded, b.Block.Body.ExecutionPayload)
+	require.NoError(t, err)
+	assert.Equal(t, version.Bellatrix, full.Version())
+	pb, err := full.PbBellatrixBlock()
+	require.NoError(t, err)
+	assert.DeepSSZEqual(t, b, pb)
+
+	_, err = wrapper.BlindSignedBeaconBlock(blinded)
+	require.ErrorIs(t, err, wrapper.ErrUnsupportedSignedBeaconBlock)
+	phase0, err := wrapper.WrappedSignedBeaconBlock(util.NewBeaconBlock())
+	require.NoError(t, err)
+	_, err = wrapper.BlindSignedBeaconBlock(phase0)
+	require.ErrorIs(t, err, wrapper.ErrUnsupportedSignedBeaconBlock)
+}
+
+func TestBuildSignedBeaconBlockFromExecutionPayload(t *testing.T) {
+	b := util.NewBeaconBlockBellatrix()
+	b.Block.Body.ExecutionPayload.Transactions = [][]byte{[]byte("tx1")}
+	wsb, err := wrapper.WrappedSignedBeaconBlock(b)
+	require.NoError(t, err)
+
+	_, err = wrapper.BuildSignedBeaconBlockFromExecutionPayload(wsb, b.Block.Body.ExecutionPayload)
+	require.ErrorIs(t, err, wrapper.ErrUnsupportedSignedBeaconBlock)
+
+	blinded, err := wrapper.BlindSignedBeaconBlock(wsb)
+	require.NoError(t, err)
+	_, err = wrapper.BuildSignedBeaconBlockFromExecutionPayload(blinded, nil)
+	require.ErrorContains(t, "nil execution payload", err)
+
+	mismatched := &enginev1.ExecutionPayload{
+		ParentHash:    make([]byte, fieldparams.RootLength),
+		FeeRecipient:  make([]byte, fieldparams.FeeRecipientLength),
+		StateRoot:     make([]byte, fieldparams.RootLength),
+		ReceiptsRoot:  make([]byte, fieldparams.RootLength),
+		LogsBloom:     make([]byte, fieldparams.LogsBloomLength),
+		PrevRandao:    make([]byte, fieldparams.RootLength),
+		BaseFeePerGas: make([]byte, fieldparams.RootLength),
+		BlockHash:     make([]byte, fieldparams.RootLength),
+		Transactions:  [][]byte{[]byte("tx2")},
+	}
+	_, err = wrapper.BuildSignedBeaconBlockFromExecutionPayload(blinded, mismatched)
+	require.ErrorContains(t, "does not match payload root", err)
+}
@@ -0,0 +1,220 @@
+package trie
+
+import (
+	"encoding/binary"
+	"math/bits"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/config/params"
+	"github.com/prysmaticlabs/prysm/crypto/hash"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+)
+
+// depositSnapshotFixedSize is the size of the fixed part of an ssz-encoded deposit tree snapshot:
+// the offset of the finalized list, the deposit root, the deposit count, the execution block hash
+// and the execution block height.
+const depositSnapshotFixedSize = 4 + 32 + 8 + 32 + 8
+
+// DepositTreeSnapshot is a compact representation of the finalized part of the deposit tree, as
+// specified in EIP-4881. Instead of all deposits, it only holds the roots of the largest complete
+// subtrees covering the finalized deposits, from left to right.
+type DepositTreeSnapshot struct {
+	Finalized            [][32]byte
+	DepositRoot          [32]byte
+	DepositCount         uint64
+	ExecutionBlockHash   [32]byte
+	ExecutionBlockHeight uint64
+}
+
+// CalculateRoot computes the deposit root, including the deposit count mix-in, from the finalized
+// subtree roots of the snapshot.
+func (s *DepositTreeSnapshot) CalculateRoot() ([32]byte, error) {
+	depth := params.BeaconConfig().DepositContractTreeDepth
+	if err := s.validateShape(depth); err != nil {
+		return [32]byte{}, err
+	}
+	size := s.DepositCount
+	index := len(s.Finalized)
+	root := ZeroHashes[0]
+	for level := uint64(0); level < depth; level++ {
+		if size&1 == 1 {
+			index--
+			root = hash.Hash(append(s.Finalized[index][:], root[:]...))
+		} else {
+			root = hash.Hash(append(root[:], ZeroHashes[level][:]...))
+		}
+		size >>= 1
+	}
+	enc := [32]byte{}
+	binary.LittleEndian.PutUint64(enc[:], s.DepositCount)
+	return hash.Hash(append(root[:], enc[:]...)), nil
+}
+
+// Verify checks that the finalized subtree roots of the snapshot match its deposit root.
+func (s *DepositTreeSnapshot) Verify() error {
+	root, err := s.CalculateRoot()
+	if err != nil {
+		return err
+	}
+	if root != s.DepositRoot {
+		return errors.Errorf("deposit snapshot root %#x does not match calculated root %#x", s.DepositRoot, root)
+	}
+	return nil
+}
+
+func (s *DepositTreeSnapshot) validateShape(depth uint64) error {
+	if depth >= 64 {
+		return errors.New("depth exceeds 64") // PowerOf2 would overflow.
+	}
+	if s.DepositCount > uint64(1)<<depth {
+		return errors.Errorf("deposit count %d exceeds the capacity of a tree of depth %d", s.DepositCount, depth)
+	}
+	if len(s.Finalized) != bits.OnesCount64(s.DepositCount) {
+		return errors.Errorf("wanted %d finalized roots for %d deposits, got %d",
+			bits.OnesCount64(s.DepositCount), s.DepositCount, len(s.Finalized))
+	}
+	return nil
+}
+
+// SizeSSZ returns the size of the ssz-encoded snapshot.
+func (s *DepositTreeSnapshot) SizeSSZ() int {
+	return depositSnapshotFixedSize + 32*len(s.Finalized)
+}
+
+// MarshalSSZ ssz-encodes the snapshot.
+func (s *DepositTreeSnapshot) MarshalSSZ() ([]byte, error) {
+	if uint64(len(s.Finalized)) > params.BeaconConfig().DepositContractTreeDepth {
+		return nil, errors.Errorf("too many finalized roots: %d", len(s.Finalized))
+	}
+	enc := make([]byte, s.SizeSSZ())
+	binary.LittleEndian.PutUint32(enc[:4], depositSnapshotFixedSize)
+	copy(enc[4:36], s.DepositRoot[:])
+	binary.LittleEndian.PutUint64(enc[36:44], s.DepositCount)
+	copy(enc[44:76], s.ExecutionBlockHash[:])
+	binary.LittleEndian.PutUint64(enc[76:84], s.ExecutionBlockHeight)
+	for i, r := range s.Finalized {
+		copy(enc[depositSnapshotFixedSize+32*i:], r[:])
+	}
+	return enc, nil
+}
+
+// UnmarshalSSZ decodes an ssz-encoded snapshot.
+func (s *DepositTreeSnapshot) UnmarshalSSZ(buf []byte) error {
+	if len(buf) < depositSnapshotFixedSize {
+		return errors.Errorf("deposit snapshot too short: %d bytes", len(buf))
+	}
+	if offset := binary.LittleEndian.Uint32(buf[:4]); offset != depositSnapshotFixedSize {
+		return errors.Errorf("invalid offset of finalized roots: %d", offset)
+	}
+	finalized := buf[depositSnapshotFixedSize:]
+	if len(finalized)%32 != 0 {
+		return errors.Errorf("finalized roots length %d is not a multiple of 32", len(finalized))
+	}
+	if uint64(len(finalized)/32) > params.BeaconConfig().DepositContractTreeDepth {
+		return errors.Errorf("too many finalized roots: %d", len(finalized)/32)
+	}
+	copy(s.DepositRoot[:], buf[4:36])
+	s.DepositCount = binary.LittleEndian.Uint64(buf[36:44])
+	copy(s.ExecutionBlockHash[:], buf[44:76])
+	s.ExecutionBlockHeight = binary.LittleEndian.Uint64(buf[76:84])
+	s.Finalized = make([][32]byte, len(finalized)/32)
+	for i := range s.Finalized {
+		copy(s.Finalized[i][:], finalized[i*32:(i+1)*32])
+	}
+	return nil
+}
+
+// Snapshot returns a deposit tree snapshot of all the items in the trie. The execution block hash and
+// height identify the execution block at which the deposit contract held exactly these deposits.
+func (m *SparseMerkleTrie) Snapshot(executionBlockHash [32]byte, executionBlockHeight uint64) (*DepositTreeSnapshot, error) {
+	count := uint64(m.NumOfItems())
+	if count > uint64(1)<<m.depth {
+		return nil, errors.Errorf("trie holds %d items, more than its capacity", count)
+	}
+	finalized := make([][32]byte, 0, bits.OnesCount64(count))
+	start := uint64(0)
+	for level := int(m.depth); level >= 0; level-- {
+		if count&(uint64(1)<<level) == 0 {
+			continue
+		}
+		finalized = append(finalized, bytesutil.ToBytes32(m.branches[level][start>>level]))
+		start += uint64(1) << level
+	}
+	root, err := m.HashTreeRoot()
+	if err != nil {
+		return nil, err
+	}
+	return &DepositTreeSnapshot{
+		Finalized:            finalized,
+		DepositRoot:          root,
+		DepositCount:         count,
+		ExecutionBlockHash:   executionBlockHash,
+		ExecutionBlockHeight: executionBlockHeight,
+	}, nil
+}
+
+// CreateTrieFromSnapshot creates a Sparse Merkle Trie holding the deposits of a deposit tree snapshot,
+// which new items can be inserted after. Leaves and inner nodes covered by the finalized subtrees are
+// not known and hold zero hashes, so Merkle proofs can only be generated for items inserted after the
+// snapshot.
+func CreateTrieFromSnapshot(snapshot *DepositTreeSnapshot, depth uint64) (*SparseMerkleTrie, error) {
+	if depth != params.BeaconConfig().DepositContractTreeDepth {
+		return nil, errors.Errorf("deposit snapshots require a trie of depth %d", params.BeaconConfig().DepositContractTreeDepth)
+	}
+	if err := snapshot.Verify(); err != nil {
+		return nil, errors.Wrap(err, "invalid deposit snapshot")
+	}
+	count := snapshot.DepositCount
+	if count == 0 {
+		return NewTrie(depth)
+	}
+
+	layers := make([][][]byte, depth+1)
+	for i := uint64(0); i <= depth; i++ {
+		size := (count + uint64(1)<<i - 1) >> i
+		layers[i] = make([][]byte, size)
+		for j := range layers[i] {
+			layers[i][j] = ZeroHashes[i][:]
+		}
+	}
+	items := make([][]byte, count)
+	for i := range items {
+		items[i] = ZeroHashes[0][:]
+	}
+	start := uint64(0)
+	idx := 0
+	for level := int(depth); level >= 0; level-- {
+		if count&(uint64(1)<<level) == 0 {
+			continue
+		}
+		root := snapshot.Finalized[idx]
+		layers[level][start>>level] = root[:]
+		if level == 0 {
+			items[start] = root[:]
+		}
+		start += uint64(1) << level
+		idx++
+	}
+	// Compute the nodes on the path of the next item, which cover both finalized deposits and
+	// empty leaves.
+	node := ZeroHashes[0]
+	index := count
+	for i := uint64(0); i < depth; i++ {
+		if index%2 == 1 {
+			node = hash.Hash(append(bytesutil.SafeCopyBytes(layers[i][index-1]), node[:]...))
+		} else {
+			node = hash.Hash(append(node[:], ZeroHashes[i][:]...))
+		}
+		index /= 2
+		if index < uint64(len(layers[i+1])) {
+			parent := node
+			layers[i+1][index] = parent[:]
+		}
+	}
+
+	return &SparseMerkleTrie{
+		branches:      layers,
+		originalItems: items,
+		depth:         uint(depth),
+	}, nil
+}
@@ -0,0 +1,100 @@
+package trie_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/config/params"
+	"github.com/prysmaticlabs/prysm/container/trie"
+	"github.com/prysmaticlabs/prysm/crypto/hash"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+)
+
+func depositLeaf(i int) []byte {
+	h := hash.Hash([]byte(fmt.Sprintf("deposit %d", i)))
+	return h[:]
+}
+
+func TestDepositTreeSnapshot_RoundTrip(t *testing.T) {
+	depth := params.BeaconConfig().DepositContractTreeDepth
+	blockHash := [32]byte{'a'}
+	for _, count := range []int{0, 1, 2, 3, 5, 8, 13, 64, 100} {
+		t.Run(fmt.Sprintf("%d deposits", count), func(t *testing.T) {
+			full, err := trie.NewTrie(depth)
+			require.NoError(t, err)
+			for i := 0; i < count; i++ {
+				require.NoError(t, full.Insert(depositLeaf(i), i))
+			}
+			snapshot, err := full.Snapshot(blockHash, 100)
+			require.NoError(t, err)
+			wantRoot, err := full.HashTreeRoot()
+			require.NoError(t, err)
+			assert.Equal(t, wantRoot, snapshot.DepositRoot)
+			assert.Equal(t, uint64(count), snapshot.DepositCount)
+			assert.Equal(t, blockHash, snapshot.ExecutionBlockHash)
+			require.NoError(t, snapshot.Verify())
+
+			enc, err := snapshot.MarshalSSZ()
+			require.NoError(t, err)
+			assert.Equal(t, snapshot.SizeSSZ(), len(enc))
+			decoded := &trie.DepositTreeSnapshot{}
+			require.NoError(t, decoded.UnmarshalSSZ(enc))
+			assert.DeepEqual(t, snapshot, decoded)
+
+			restored, err := trie.CreateTrieFromSnapshot(decoded, depth)
+			require.NoError(t, err)
+			gotRoot, err := restored.HashTreeRoot()
+			require.NoError(t, err)
+			assert.Equal(t, wantRoot, gotRoot)
+			assert.Equal(t, count, restored.NumOfItems())
+
+			// Deposits after the snapshot can be added and proven as usual.
+			for i := count; i < count+7; i++ {
+				require.NoError(t, full.Insert(depositLeaf(i), i))
+				require.NoError(t, restored.Insert(depositLeaf(i), i))
+				wantRoot, err := full.HashTreeRoot()
+				require.NoError(t, err)
+				gotRoot, err := restored.HashTreeRoot()
+				require.NoError(t, err)
+				require.Equal(t, wantRoot, gotRoot)
+				wantProof, err := full.MerkleProof(i)
+				require.NoError(t, err)
+				gotProof, err := restored.MerkleProof(i)
+				require.NoError(t, err)
+				require.DeepEqual(t, wantProof, gotProof)
+			}
+			want, err := full.Snapshot(blockHash, 200)
+			require.NoError(t, err)
+			got, err := restored.Snapshot(blockHash, 200)
+			require.NoError(t, err)
+			assert.DeepEqual(t, want, got)
+		})
+	}
+}
+
+func TestDepositTreeSnapshot_Invalid(t *testing.T) {
+	depth := params.BeaconConfig().DepositContractTreeDepth
+	full, err := trie.NewTrie(depth)
+	require.NoError(t, err)
+	for i := 0; i < 3; i++ {
+		require.NoError(t, full.Insert(depositLeaf(i), i))
+	}
+	snapshot, err := full.Snapshot([32]byte{}, 0)
+	require.NoError(t, err)
+
+	wrongRoot := *snapshot
+	wrongRoot.DepositRoot = [32]byte{'b'}
+	_, err = trie.CreateTrieFromSnapshot(&wrongRoot, depth)
+	assert.ErrorContains(t, "does not match calculated root", err)
+
+	wrongCount := *snapshot
+	wrongCount.DepositCount = 4
+	_, err = trie.CreateTrieFromSnapshot(&wrongCount, depth)
+	assert.ErrorContains(t, "wanted 1 finalized roots for 4 deposits, got 2", err)
+
+	enc, err := snapshot.MarshalSSZ()
+	require.NoError(t, err)
+	assert.ErrorContains(t, "not a multiple of 32", (&trie.DepositTreeSnapshot{}).UnmarshalSSZ(enc[:len(enc)-1]))
+	assert.ErrorContains(t, "too short", (&trie.DepositTreeSnapshot{}).UnmarshalSSZ(enc[:10]))
+}
@@ -4,8 +4,6 @@ import (
 	"context"
 	"fmt"
 	"net/http"
-
-	"github.com/sirupsen/logrus"
 )
 
 // BackupExporter defines a backup exporter methods.
@@ -15,8 +13,6 @@ type BackupExporter interface {
 
 // BackupHandler for accepting requests to initiate a new database backup.
 func BackupHandler(bk BackupExporter, outputDir string) func(http.ResponseWriter, *http.Request) {
-	log := logrus.WithField("prefix", "db")
-
 	return func(w http.ResponseWriter, r *http.Request) {
 		log.Debug("Creating database backup from HTTP webhook")
 
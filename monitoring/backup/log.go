@@ -0,0 +1,5 @@
+package backup
+
+import "github.com/sirupsen/logrus"
+
+var log = logrus.WithField("prefix", "db")
@@ -0,0 +1,132 @@
+package backup
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// SchedulerConfig defines the snapshots taken by a Scheduler.
+type SchedulerConfig struct {
+	Snapshotter Snapshotter
+	// Dir is the directory snapshots are written to.
+	Dir string
+	// Prefix is the prefix of snapshot file names, which identifies the database.
+	Prefix string
+	// Interval is the minimum time between two snapshots.
+	Interval time.Duration
+	// Retention is the number of snapshots kept, older snapshots are deleted.
+	Retention int
+	// Compress enables zstd compression of snapshots.
+	Compress bool
+	// NewCheckpointOnly skips snapshots until the finalized checkpoint of the database changed
+	// since the previous snapshot, so that every snapshot is taken at a new finalized checkpoint.
+	NewCheckpointOnly bool
+}
+
+// Scheduler is a runtime service which periodically writes snapshots of a database and deletes
+// the snapshots exceeding the retention.
+type Scheduler struct {
+	cfg    *SchedulerConfig
+	ctx    context.Context
+	cancel context.CancelFunc
+	done   chan struct{}
+
+	lock    sync.RWMutex
+	last    *Metadata
+	lastErr error
+}
+
+// NewScheduler creates a snapshot scheduler.
+func NewScheduler(ctx context.Context, cfg *SchedulerConfig) (*Scheduler, error) {
+	if cfg.Interval <= 0 {
+		return nil, errors.New("snapshot interval must be positive")
+	}
+	if cfg.Retention <= 0 {
+		return nil, errors.New("snapshot retention must be positive")
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	return &Scheduler{
+		cfg:    cfg,
+		ctx:    ctx,
+		cancel: cancel,
+		done:   make(chan struct{}),
+	}, nil
+}
+
+// Start the scheduler. The latest existing snapshot is read so that an unchanged finalized
+// checkpoint is not snapshotted again after a restart.
+func (s *Scheduler) Start() {
+	manifests, err := Manifests(s.cfg.Dir, s.cfg.Prefix)
+	if err != nil {
+		log.WithError(err).Warn("Could not read existing database snapshots")
+	} else if len(manifests) > 0 {
+		s.last = manifests[len(manifests)-1].Metadata
+	}
+	go s.run()
+}
+
+// Stop the scheduler, waiting for a snapshot in progress to be aborted.
+func (s *Scheduler) Stop() error {
+	s.cancel()
+	<-s.done
+	return nil
+}
+
+// Status returns the error of the latest snapshot attempt, if any.
+func (s *Scheduler) Status() error {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.lastErr
+}
+
+func (s *Scheduler) run() {
+	defer close(s.done)
+	ticker := time.NewTicker(s.cfg.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			err := s.snapshot(s.ctx)
+			if err != nil {
+				log.WithError(err).Error("Could not take database snapshot")
+			}
+			s.lock.Lock()
+			s.lastErr = err
+			s.lock.Unlock()
+		case <-s.ctx.Done():
+			return
+		}
+	}
+}
+
+// snapshot takes a snapshot unless the finalized checkpoint is required to change and did not,
+// then prunes old snapshots.
+func (s *Scheduler) snapshot(ctx context.Context) error {
+	if s.cfg.NewCheckpointOnly && s.last != nil {
+		meta, err := s.cfg.Snapshotter.SnapshotMetadata(ctx)
+		if err != nil {
+			return err
+		}
+		if meta.FinalizedEpoch == s.last.FinalizedEpoch && meta.FinalizedRoot == s.last.FinalizedRoot {
+			log.WithField("finalizedEpoch", meta.FinalizedEpoch).Debug("Finalized checkpoint unchanged, skipping database snapshot")
+			return nil
+		}
+	}
+	start := time.Now()
+	manifest, err := WriteSnapshot(ctx, s.cfg.Snapshotter, s.cfg.Dir, s.cfg.Prefix, s.cfg.Compress)
+	if err != nil {
+		return err
+	}
+	s.last = manifest.Metadata
+	log.WithFields(logrus.Fields{
+		"file":           manifest.File,
+		"size":           manifest.Size,
+		"finalizedEpoch": manifest.Metadata.FinalizedEpoch,
+		"duration":       time.Since(start),
+	}).Info("Wrote database snapshot")
+	return Prune(s.cfg.Dir, s.cfg.Prefix, s.cfg.Retention)
+}
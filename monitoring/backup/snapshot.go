@@ -0,0 +1,345 @@
+package backup
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"hash"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/io/file"
+)
+
+const (
+	// ManifestSuffix is appended to the name of a snapshot file to get the name of its manifest.
+	ManifestSuffix = ".manifest.json"
+	// zstdSuffix is appended to the name of compressed snapshot files.
+	zstdSuffix = ".zst"
+	// snapshotFilePermissions are the permissions of snapshot and manifest files.
+	snapshotFilePermissions = 0600
+)
+
+// Compression is the compression algorithm of a snapshot file.
+type Compression string
+
+const (
+	// CompressionNone means the snapshot file is a plain copy of the database file.
+	CompressionNone Compression = "none"
+	// CompressionZstd means the snapshot file is a zstd compressed copy of the database file.
+	CompressionZstd Compression = "zstd"
+)
+
+// Metadata identifies the content of a database snapshot.
+type Metadata struct {
+	// GenesisRoot is the genesis block root of a beacon node database, or the genesis validators
+	// root of a validator database.
+	GenesisRoot string `json:"genesis_root"`
+	// FinalizedEpoch and FinalizedRoot are the finalized checkpoint of a beacon node database.
+	FinalizedEpoch uint64 `json:"finalized_epoch,omitempty"`
+	FinalizedRoot  string `json:"finalized_root,omitempty"`
+}
+
+// Verify returns an error if the metadata read from a restored database does not match the
+// metadata recorded when the snapshot was taken.
+func (m *Metadata) Verify(restored *Metadata) error {
+	if m.GenesisRoot != restored.GenesisRoot {
+		return fmt.Errorf("genesis root %s of the restored database does not match %s recorded in the manifest",
+			restored.GenesisRoot, m.GenesisRoot)
+	}
+	if m.FinalizedEpoch != restored.FinalizedEpoch || m.FinalizedRoot != restored.FinalizedRoot {
+		return fmt.Errorf("finalized checkpoint %d/%s of the restored database does not match %d/%s recorded in the manifest",
+			restored.FinalizedEpoch, restored.FinalizedRoot, m.FinalizedEpoch, m.FinalizedRoot)
+	}
+	return nil
+}
+
+// Manifest describes a snapshot file. It is written next to the snapshot file, with the
+// same name followed by ManifestSuffix.
+type Manifest struct {
+	// File is the name of the snapshot file, relative to the manifest.
+	File        string      `json:"file"`
+	Compression Compression `json:"compression"`
+	// SHA256 is the hex encoded checksum of the snapshot file as stored, after compression.
+	SHA256    string    `json:"sha256"`
+	Size      int64     `json:"size"`
+	CreatedAt time.Time `json:"created_at"`
+	Metadata  *Metadata `json:"metadata"`
+}
+
+// Snapshotter defines a database which can write consistent snapshots of itself while in use.
+type Snapshotter interface {
+	// SnapshotMetadata returns the metadata of a snapshot taken now.
+	SnapshotMetadata(ctx context.Context) (*Metadata, error)
+	// Snapshot writes a consistent copy of the database file to w.
+	Snapshot(ctx context.Context, w io.Writer) (*Metadata, error)
+}
+
+// WriteSnapshot writes a snapshot of the database to a new file in dir, named after prefix and
+// the current time, optionally compressing it with zstd, and writes its manifest next to it.
+// Files are written under a temporary name first, so that a partial snapshot is never mistaken
+// for a complete one.
+func WriteSnapshot(ctx context.Context, s Snapshotter, dir, prefix string, compress bool) (*Manifest, error) {
+	createdAt := time.Now().UTC()
+	name := fmt.Sprintf("%s_%d.backup", prefix, createdAt.UnixMilli())
+	compression := CompressionNone
+	if compress {
+		name += zstdSuffix
+		compression = CompressionZstd
+	}
+	snapshotPath := filepath.Join(dir, name)
+
+	tmpPath := snapshotPath + ".tmp"
+	f, err := os.OpenFile(tmpPath, os.O_CREATE|os.O_EXCL|os.O_WRONLY, snapshotFilePermissions)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not create snapshot file")
+	}
+	defer func() {
+		if err := os.Remove(tmpPath); err != nil && !os.IsNotExist(err) {
+			log.WithError(err).Error("Could not remove temporary snapshot file")
+		}
+	}()
+	checksum := sha256.New()
+	size, meta, err := writeSnapshotFile(ctx, s, f, checksum, compress)
+	if err != nil {
+		if closeErr := f.Close(); closeErr != nil {
+			log.WithError(closeErr).Error("Could not close snapshot file")
+		}
+		return nil, err
+	}
+	if err := f.Close(); err != nil {
+		return nil, err
+	}
+	if err := os.Rename(tmpPath, snapshotPath); err != nil {
+		return nil, err
+	}
+
+	manifest := &Manifest{
+		File:        name,
+		Compression: compression,
+		SHA256:      hex.EncodeToString(checksum.Sum(nil)),
+		Size:        size,
+		CreatedAt:   createdAt,
+		Metadata:    meta,
+	}
+	if err := writeManifest(snapshotPath+ManifestSuffix, manifest); err != nil {
+		return nil, err
+	}
+	return manifest, nil
+}
+
+func writeSnapshotFile(ctx context.Context, s Snapshotter, f *os.File, checksum hash.Hash, compress bool) (int64, *Metadata, error) {
+	counter := &countingWriter{w: io.MultiWriter(f, checksum)}
+	buf := bufio.NewWriterSize(counter, 1<<20)
+	var meta *Metadata
+	var err error
+	if compress {
+		enc, encErr := zstd.NewWriter(buf)
+		if encErr != nil {
+			return 0, nil, encErr
+		}
+		if meta, err = s.Snapshot(ctx, enc); err != nil {
+			_ = enc.Close()
+			return 0, nil, err
+		}
+		if err := enc.Close(); err != nil {
+			return 0, nil, err
+		}
+	} else if meta, err = s.Snapshot(ctx, buf); err != nil {
+		return 0, nil, err
+	}
+	if err := buf.Flush(); err != nil {
+		return 0, nil, err
+	}
+	return counter.n, meta, f.Sync()
+}
+
+func writeManifest(manifestPath string, manifest *Manifest) error {
+	enc, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmpPath := manifestPath + ".tmp"
+	if err := os.WriteFile(tmpPath, enc, snapshotFilePermissions); err != nil {
+		return err
+	}
+	return os.Rename(tmpPath, manifestPath)
+}
+
+// ReadManifest reads the manifest of a snapshot file. It returns a nil manifest if the snapshot
+// has none, which is the case of backups taken by previous versions.
+func ReadManifest(snapshotPath string) (*Manifest, error) {
+	enc, err := os.ReadFile(snapshotPath + ManifestSuffix) // #nosec G304
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	manifest := &Manifest{}
+	if err := json.Unmarshal(enc, manifest); err != nil {
+		return nil, errors.Wrap(err, "could not decode manifest")
+	}
+	return manifest, nil
+}
+
+// Manifests returns the manifests of the snapshots in dir whose name starts with prefix,
+// from the oldest to the newest.
+func Manifests(dir, prefix string) ([]*Manifest, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	var manifests []*Manifest
+	for _, e := range entries {
+		name := e.Name()
+		if e.IsDir() || !strings.HasPrefix(name, prefix) || !strings.HasSuffix(name, ManifestSuffix) {
+			continue
+		}
+		manifest, err := ReadManifest(filepath.Join(dir, strings.TrimSuffix(name, ManifestSuffix)))
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not read manifest %s", name)
+		}
+		manifests = append(manifests, manifest)
+	}
+	sort.Slice(manifests, func(i, j int) bool {
+		return manifests[i].CreatedAt.Before(manifests[j].CreatedAt)
+	})
+	return manifests, nil
+}
+
+// Prune deletes the oldest snapshots in dir whose name starts with prefix, keeping the newest
+// retain snapshots.
+func Prune(dir, prefix string, retain int) error {
+	manifests, err := Manifests(dir, prefix)
+	if err != nil {
+		return err
+	}
+	for i := 0; i < len(manifests)-retain; i++ {
+		snapshotPath := filepath.Join(dir, manifests[i].File)
+		if err := os.Remove(snapshotPath); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		if err := os.Remove(snapshotPath + ManifestSuffix); err != nil {
+			return err
+		}
+		log.WithField("snapshot", snapshotPath).Debug("Deleted database snapshot")
+	}
+	return nil
+}
+
+// Extract writes the database contained in a snapshot file to dst, which must not exist.
+// If the snapshot has a manifest, the checksum of the snapshot file is verified before it is
+// extracted, and the manifest is returned. Snapshots without a manifest are copied as is.
+func Extract(snapshotPath, dst string) (*Manifest, error) {
+	manifest, err := ReadManifest(snapshotPath)
+	if err != nil {
+		return nil, err
+	}
+	compression := CompressionNone
+	if manifest != nil {
+		if err := verifyChecksum(snapshotPath, manifest.SHA256); err != nil {
+			return nil, err
+		}
+		compression = manifest.Compression
+	} else {
+		log.WithField("snapshot", snapshotPath).Warn("Snapshot has no manifest, its integrity cannot be verified")
+		if strings.HasSuffix(snapshotPath, zstdSuffix) {
+			compression = CompressionZstd
+		}
+	}
+
+	src, err := os.Open(snapshotPath) // #nosec G304
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := src.Close(); err != nil {
+			log.WithError(err).Error("Could not close snapshot file")
+		}
+	}()
+	var r io.Reader = src
+	switch compression {
+	case CompressionNone:
+	case CompressionZstd:
+		dec, err := zstd.NewReader(src)
+		if err != nil {
+			return nil, err
+		}
+		defer dec.Close()
+		r = dec
+	default:
+		return nil, fmt.Errorf("unsupported snapshot compression %q", compression)
+	}
+
+	out, err := os.OpenFile(dst, os.O_CREATE|os.O_EXCL|os.O_WRONLY, snapshotFilePermissions)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := io.Copy(out, r); err != nil {
+		_ = out.Close()
+		return nil, errors.Wrap(err, "could not extract snapshot")
+	}
+	if err := out.Sync(); err != nil {
+		_ = out.Close()
+		return nil, err
+	}
+	return manifest, out.Close()
+}
+
+func verifyChecksum(snapshotPath, expected string) error {
+	f, err := os.Open(snapshotPath) // #nosec G304
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := f.Close(); err != nil {
+			log.WithError(err).Error("Could not close snapshot file")
+		}
+	}()
+	checksum := sha256.New()
+	if _, err := io.Copy(checksum, f); err != nil {
+		return err
+	}
+	if actual := hex.EncodeToString(checksum.Sum(nil)); actual != expected {
+		return fmt.Errorf("checksum %s of snapshot %s does not match %s recorded in the manifest", actual, snapshotPath, expected)
+	}
+	return nil
+}
+
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	c.n += int64(n)
+	return n, err
+}
+
+// SnapshotDir returns the directory snapshots of a database are written to, which is the
+// expanded outputDir if set, or the backups directory of the database otherwise. The directory
+// is created if needed.
+func SnapshotDir(outputDir, databasePath string) (string, error) {
+	dir := filepath.Join(databasePath, "backups")
+	if outputDir != "" {
+		var err error
+		if dir, err = file.ExpandPath(outputDir); err != nil {
+			return "", err
+		}
+	}
+	if err := file.HandleBackupDir(dir, false); err != nil {
+		return "", err
+	}
+	return dir, nil
+}
@@ -0,0 +1,148 @@
+package backup
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+)
+
+type mockSnapshotter struct {
+	data []byte
+	meta *Metadata
+}
+
+func (m *mockSnapshotter) SnapshotMetadata(_ context.Context) (*Metadata, error) {
+	return m.meta, nil
+}
+
+func (m *mockSnapshotter) Snapshot(_ context.Context, w io.Writer) (*Metadata, error) {
+	_, err := w.Write(m.data)
+	return m.meta, err
+}
+
+func TestWriteSnapshot_Extract(t *testing.T) {
+	for _, compress := range []bool{false, true} {
+		dir := t.TempDir()
+		s := &mockSnapshotter{
+			data: bytes.Repeat([]byte("prysm"), 4096),
+			meta: &Metadata{GenesisRoot: "0x01", FinalizedEpoch: 3, FinalizedRoot: "0x02"},
+		}
+		manifest, err := WriteSnapshot(context.Background(), s, dir, "test", compress)
+		require.NoError(t, err)
+		assert.DeepEqual(t, s.meta, manifest.Metadata)
+		if compress {
+			assert.Equal(t, CompressionZstd, manifest.Compression)
+			assert.Equal(t, true, manifest.Size < int64(len(s.data)))
+		} else {
+			assert.Equal(t, CompressionNone, manifest.Compression)
+			assert.Equal(t, int64(len(s.data)), manifest.Size)
+		}
+
+		snapshotPath := filepath.Join(dir, manifest.File)
+		read, err := ReadManifest(snapshotPath)
+		require.NoError(t, err)
+		assert.Equal(t, manifest.SHA256, read.SHA256)
+
+		dst := filepath.Join(t.TempDir(), "restored.db")
+		_, err = Extract(snapshotPath, dst)
+		require.NoError(t, err)
+		restored, err := os.ReadFile(dst)
+		require.NoError(t, err)
+		assert.DeepEqual(t, s.data, restored)
+	}
+}
+
+func TestExtract_ChecksumMismatch(t *testing.T) {
+	dir := t.TempDir()
+	s := &mockSnapshotter{data: []byte("database"), meta: &Metadata{}}
+	manifest, err := WriteSnapshot(context.Background(), s, dir, "test", false)
+	require.NoError(t, err)
+
+	snapshotPath := filepath.Join(dir, manifest.File)
+	require.NoError(t, os.WriteFile(snapshotPath, []byte("corrupted"), 0600))
+	dst := filepath.Join(t.TempDir(), "restored.db")
+	_, err = Extract(snapshotPath, dst)
+	assert.ErrorContains(t, "does not match", err)
+	assert.Equal(t, false, fileExists(dst))
+}
+
+func TestExtract_NoManifest(t *testing.T) {
+	snapshotPath := filepath.Join(t.TempDir(), "legacy.backup")
+	require.NoError(t, os.WriteFile(snapshotPath, []byte("database"), 0600))
+	dst := filepath.Join(t.TempDir(), "restored.db")
+	manifest, err := Extract(snapshotPath, dst)
+	require.NoError(t, err)
+	assert.Equal(t, (*Manifest)(nil), manifest)
+	restored, err := os.ReadFile(dst)
+	require.NoError(t, err)
+	assert.DeepEqual(t, []byte("database"), restored)
+}
+
+func TestPrune(t *testing.T) {
+	dir := t.TempDir()
+	s := &mockSnapshotter{data: []byte("database"), meta: &Metadata{}}
+	var files []string
+	for i := 0; i < 4; i++ {
+		manifest, err := WriteSnapshot(context.Background(), s, dir, "test", false)
+		require.NoError(t, err)
+		files = append(files, manifest.File)
+		// Snapshot names are based on the creation time in milliseconds.
+		time.Sleep(2 * time.Millisecond)
+	}
+	require.NoError(t, Prune(dir, "test", 2))
+
+	manifests, err := Manifests(dir, "test")
+	require.NoError(t, err)
+	require.Equal(t, 2, len(manifests))
+	assert.Equal(t, files[2], manifests[0].File)
+	assert.Equal(t, files[3], manifests[1].File)
+	assert.Equal(t, false, fileExists(filepath.Join(dir, files[0])))
+	assert.Equal(t, false, fileExists(filepath.Join(dir, files[0]+ManifestSuffix)))
+}
+
+func TestScheduler_NewCheckpointOnly(t *testing.T) {
+	dir := t.TempDir()
+	s := &mockSnapshotter{data: []byte("database"), meta: &Metadata{FinalizedEpoch: 1, FinalizedRoot: "0x01"}}
+	scheduler, err := NewScheduler(context.Background(), &SchedulerConfig{
+		Snapshotter:       s,
+		Dir:               dir,
+		Prefix:            "test",
+		Interval:          time.Hour,
+		Retention:         5,
+		NewCheckpointOnly: true,
+	})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	require.NoError(t, scheduler.snapshot(ctx))
+	time.Sleep(2 * time.Millisecond)
+	require.NoError(t, scheduler.snapshot(ctx))
+	manifests, err := Manifests(dir, "test")
+	require.NoError(t, err)
+	assert.Equal(t, 1, len(manifests), "Snapshot taken without a new finalized checkpoint")
+
+	s.meta = &Metadata{FinalizedEpoch: 2, FinalizedRoot: "0x02"}
+	require.NoError(t, scheduler.snapshot(ctx))
+	manifests, err = Manifests(dir, "test")
+	require.NoError(t, err)
+	assert.Equal(t, 2, len(manifests))
+}
+
+func TestMetadata_Verify(t *testing.T) {
+	m := &Metadata{GenesisRoot: "0x01", FinalizedEpoch: 2, FinalizedRoot: "0x02"}
+	require.NoError(t, m.Verify(&Metadata{GenesisRoot: "0x01", FinalizedEpoch: 2, FinalizedRoot: "0x02"}))
+	assert.ErrorContains(t, "genesis root", m.Verify(&Metadata{GenesisRoot: "0x03", FinalizedEpoch: 2, FinalizedRoot: "0x02"}))
+	assert.ErrorContains(t, "finalized checkpoint", m.Verify(&Metadata{GenesisRoot: "0x01", FinalizedEpoch: 1, FinalizedRoot: "0x02"}))
+}
+
+func fileExists(path string) bool {
+	_, err := os.Stat(path)
+	return err == nil
+}
@@ -0,0 +1,86 @@
+package clientstats
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"runtime"
+
+	"github.com/prometheus/procfs"
+	"github.com/prysmaticlabs/prysm/runtime/version"
+	log "github.com/sirupsen/logrus"
+)
+
+type beaconNodeStatsScraper struct {
+	stats func() BeaconNodeStats
+}
+
+func (bc *beaconNodeStatsScraper) Scrape() (io.Reader, error) {
+	bs := bc.stats()
+	bs.CommonStats = processCommonStats()
+	bs.APIMessage = populateAPIMessage(BeaconNodeProcessName)
+
+	b, err := json.Marshal(bs)
+	return bytes.NewBuffer(b), err
+}
+
+// NewBeaconNodeStatsScraper constructs a Scraper producing the json body
+// for the beaconnode client-stats process type from the data of the running
+// process, without going through its prometheus endpoint. The stats function
+// provides the beacon-node specific metrics.
+func NewBeaconNodeStatsScraper(stats func() BeaconNodeStats) Scraper {
+	return &beaconNodeStatsScraper{stats: stats}
+}
+
+type validatorStatsScraper struct {
+	stats func() ValidatorStats
+}
+
+func (vc *validatorStatsScraper) Scrape() (io.Reader, error) {
+	vs := vc.stats()
+	vs.CommonStats = processCommonStats()
+	vs.APIMessage = populateAPIMessage(ValidatorProcessName)
+
+	b, err := json.Marshal(vs)
+	return bytes.NewBuffer(b), err
+}
+
+// NewValidatorStatsScraper constructs a Scraper producing the json body
+// for the validator client-stats process type from the data of the running
+// process, without going through its prometheus endpoint. The stats function
+// provides the validator specific metrics.
+func NewValidatorStatsScraper(stats func() ValidatorStats) Scraper {
+	return &validatorStatsScraper{stats: stats}
+}
+
+// processCommonStats reads the common stats of the running process. CPU time and
+// resident memory come from procfs, the same source as the process_cpu_seconds_total
+// and process_resident_memory_bytes metrics. Where procfs is not available the memory
+// obtained by the go runtime is reported instead.
+func processCommonStats() CommonStats {
+	cs := CommonStats{
+		ClientName:    ClientName,
+		ClientVersion: version.SemanticVersion(),
+		ClientBuild:   version.BuildTimestamp(),
+	}
+	stat, err := procSelfStat()
+	if err != nil {
+		log.WithError(err).Debug("Failed to read process stats from procfs")
+		var ms runtime.MemStats
+		runtime.ReadMemStats(&ms)
+		cs.MemoryProcessBytes = int64(ms.Sys)
+		return cs
+	}
+	// float64->int64: truncates fractional seconds
+	cs.CPUProcessSecondsTotal = int64(stat.CPUTime())
+	cs.MemoryProcessBytes = int64(stat.ResidentMemory())
+	return cs
+}
+
+func procSelfStat() (procfs.ProcStat, error) {
+	p, err := procfs.Self()
+	if err != nil {
+		return procfs.ProcStat{}, err
+	}
+	return p.Stat()
+}
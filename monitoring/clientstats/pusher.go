@@ -0,0 +1,210 @@
+package clientstats
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/io/file"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// DefaultPushRetries is the number of times a failed push is retried
+	// before its stats are spooled.
+	DefaultPushRetries = 3
+	// DefaultPushRetryDelay is the delay before the first retry of a failed
+	// push, doubled for every following retry.
+	DefaultPushRetryDelay = time.Second
+	// maxSpooledStats bounds the number of stats kept in the spool file, the
+	// oldest stats are dropped first.
+	maxSpooledStats = 1000
+)
+
+// PusherConfig configures a Pusher.
+type PusherConfig struct {
+	Scrapers []Scraper
+	Updater  Updater
+	Interval time.Duration
+	// Retries is the number of times a failed push is retried, with
+	// exponential backoff starting at RetryDelay.
+	Retries    int
+	RetryDelay time.Duration
+	// SpoolPath is the file in which stats are kept while they cannot be
+	// pushed, to be pushed along with the next stats once the collector is
+	// reachable again. Stats which cannot be pushed are dropped if it is empty.
+	SpoolPath string
+}
+
+// Pusher is a service which periodically scrapes client-stats and pushes
+// them to an Updater. Unlike the client-stats daemon, it is meant to run
+// within the beacon-node and validator processes, with scrapers reading the
+// data of the process directly.
+type Pusher struct {
+	cfg    *PusherConfig
+	ctx    context.Context
+	cancel context.CancelFunc
+}
+
+// NewPusher constructs a Pusher service from the config.
+func NewPusher(ctx context.Context, cfg *PusherConfig) *Pusher {
+	ctx, cancel := context.WithCancel(ctx)
+	return &Pusher{cfg: cfg, ctx: ctx, cancel: cancel}
+}
+
+// Start pushes the scraped stats at every interval until the service is stopped.
+func (p *Pusher) Start() {
+	go p.run()
+}
+
+// Stop the service.
+func (p *Pusher) Stop() error {
+	p.cancel()
+	return nil
+}
+
+// Status of the service. A collector being unreachable is not an error of the
+// process, so the status is always healthy.
+func (p *Pusher) Status() error {
+	return nil
+}
+
+func (p *Pusher) run() {
+	ticker := time.NewTicker(p.cfg.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			p.push(p.ctx)
+		case <-p.ctx.Done():
+			return
+		}
+	}
+}
+
+// push scrapes the stats and pushes them along with the spooled stats, spooling
+// all of them if the push fails.
+func (p *Pusher) push(ctx context.Context) {
+	stats, err := p.readSpool()
+	if err != nil {
+		log.WithError(err).Error("Could not read client-stats spool file")
+	}
+	spooled := len(stats)
+	for _, s := range p.cfg.Scrapers {
+		r, err := s.Scrape()
+		if err != nil {
+			log.WithError(err).Error("Could not scrape client-stats")
+			continue
+		}
+		b, err := io.ReadAll(r)
+		if err != nil {
+			log.WithError(err).Error("Could not read scraped client-stats")
+			continue
+		}
+		stats = append(stats, b)
+	}
+	if len(stats) == 0 {
+		return
+	}
+
+	if err := p.update(ctx, stats); err != nil {
+		log.WithError(err).WithField("stats", len(stats)).Warn("Could not push client-stats")
+		if err := p.writeSpool(stats); err != nil {
+			log.WithError(err).Error("Could not write client-stats spool file")
+		}
+		return
+	}
+	if spooled > 0 {
+		log.WithField("spooled", spooled).Info("Pushed spooled client-stats")
+		if err := os.Remove(p.cfg.SpoolPath); err != nil && !os.IsNotExist(err) {
+			log.WithError(err).Error("Could not remove client-stats spool file")
+		}
+	}
+}
+
+// update sends the stats in a single request, as a list when there is more than
+// one, retrying with exponential backoff.
+func (p *Pusher) update(ctx context.Context, stats []json.RawMessage) error {
+	body := []byte(stats[0])
+	if len(stats) > 1 {
+		var err error
+		if body, err = json.Marshal(stats); err != nil {
+			return err
+		}
+	}
+	delay := p.cfg.RetryDelay
+	var err error
+	for attempt := 0; ; attempt++ {
+		if err = p.cfg.Updater.Update(bytes.NewReader(body)); err == nil {
+			return nil
+		}
+		if attempt >= p.cfg.Retries {
+			return err
+		}
+		log.WithError(err).WithField("attempt", attempt+1).Debug("Retrying client-stats push")
+		select {
+		case <-time.After(delay):
+		case <-ctx.Done():
+			return err
+		}
+		delay *= 2
+	}
+}
+
+// readSpool returns the spooled stats, oldest first.
+func (p *Pusher) readSpool() ([]json.RawMessage, error) {
+	if p.cfg.SpoolPath == "" {
+		return nil, nil
+	}
+	f, err := os.Open(p.cfg.SpoolPath) // #nosec G304
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := f.Close(); err != nil {
+			log.WithError(err).Debug("Could not close client-stats spool file")
+		}
+	}()
+	var stats []json.RawMessage
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		if !json.Valid(line) {
+			log.Warn("Dropping invalid client-stats spool entry")
+			continue
+		}
+		stats = append(stats, append(json.RawMessage{}, line...))
+	}
+	return stats, scanner.Err()
+}
+
+// writeSpool replaces the spooled stats with the most recent of the stats, one per line.
+func (p *Pusher) writeSpool(stats []json.RawMessage) error {
+	if p.cfg.SpoolPath == "" {
+		return nil
+	}
+	if len(stats) > maxSpooledStats {
+		log.WithField("dropped", len(stats)-maxSpooledStats).Warn("Dropping oldest spooled client-stats")
+		stats = stats[len(stats)-maxSpooledStats:]
+	}
+	var buf bytes.Buffer
+	for _, s := range stats {
+		if err := json.Compact(&buf, s); err != nil {
+			return errors.Wrap(err, "could not encode client-stats")
+		}
+		buf.WriteByte('\n')
+	}
+	return file.WriteFile(p.cfg.SpoolPath, buf.Bytes())
+}
@@ -0,0 +1,132 @@
+package clientstats
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/testing/require"
+)
+
+type staticScraper struct {
+	body string
+}
+
+func (s *staticScraper) Scrape() (io.Reader, error) {
+	return bytes.NewBufferString(s.body), nil
+}
+
+// collector records the bodies of the requests it accepts, and rejects requests while down.
+type collector struct {
+	sync.Mutex
+	down   bool
+	bodies [][]byte
+	calls  int
+}
+
+func (c *collector) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	c.Lock()
+	defer c.Unlock()
+	c.calls++
+	if c.down {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		return
+	}
+	b, err := io.ReadAll(r.Body)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	c.bodies = append(c.bodies, b)
+}
+
+func TestPusher_RetriesAndSpools(t *testing.T) {
+	c := &collector{down: true}
+	srv := httptest.NewServer(c)
+	defer srv.Close()
+	spool := filepath.Join(t.TempDir(), "clientstats-spool.jsonl")
+	p := NewPusher(context.Background(), &PusherConfig{
+		Scrapers:   []Scraper{&staticScraper{body: `{"process":"beaconnode"}`}},
+		Updater:    NewClientStatsHTTPPostUpdater(srv.URL),
+		Retries:    2,
+		RetryDelay: time.Millisecond,
+		SpoolPath:  spool,
+	})
+
+	// The collector is down, so the stats are spooled after the retries.
+	p.push(context.Background())
+	p.push(context.Background())
+	require.Equal(t, 6, c.calls)
+	spooled, err := p.readSpool()
+	require.NoError(t, err)
+	require.Equal(t, 2, len(spooled))
+
+	// Once the collector is up, the spooled stats are pushed along with the new ones.
+	c.Lock()
+	c.down = false
+	c.Unlock()
+	p.push(context.Background())
+	require.Equal(t, 1, len(c.bodies))
+	var pushed []map[string]interface{}
+	require.NoError(t, json.Unmarshal(c.bodies[0], &pushed))
+	require.Equal(t, 3, len(pushed))
+	_, err = os.Stat(spool)
+	require.Equal(t, true, os.IsNotExist(err))
+
+	// Without spooled stats, a single scraper pushes a single object.
+	p.push(context.Background())
+	require.Equal(t, 2, len(c.bodies))
+	require.Equal(t, `{"process":"beaconnode"}`, string(c.bodies[1]))
+}
+
+func TestPusher_SpoolIsBounded(t *testing.T) {
+	p := NewPusher(context.Background(), &PusherConfig{SpoolPath: filepath.Join(t.TempDir(), "spool")})
+	stats := make([]json.RawMessage, maxSpooledStats+10)
+	for i := range stats {
+		stats[i] = json.RawMessage(fmt.Sprintf(`{"n":%d}`, i))
+	}
+	require.NoError(t, p.writeSpool(stats))
+	spooled, err := p.readSpool()
+	require.NoError(t, err)
+	require.Equal(t, maxSpooledStats, len(spooled))
+	require.Equal(t, string(stats[10]), string(spooled[0]))
+}
+
+func TestBeaconNodeStatsScraper(t *testing.T) {
+	s := NewBeaconNodeStatsScraper(func() BeaconNodeStats {
+		return BeaconNodeStats{SyncBeaconHeadSlot: 42, NetworkPeersConnected: 7, SyncEth2Synced: true}
+	})
+	r, err := s.Scrape()
+	require.NoError(t, err)
+	bs := &BeaconNodeStats{}
+	require.NoError(t, json.NewDecoder(r).Decode(bs))
+	require.Equal(t, int64(42), bs.SyncBeaconHeadSlot)
+	require.Equal(t, int64(7), bs.NetworkPeersConnected)
+	require.Equal(t, true, bs.SyncEth2Synced)
+	require.Equal(t, BeaconNodeProcessName, bs.ProcessName)
+	require.Equal(t, APIVersion, bs.APIVersion)
+	require.Equal(t, ClientName, bs.ClientName)
+	require.NotEqual(t, int64(0), bs.MemoryProcessBytes)
+}
+
+func TestValidatorStatsScraper(t *testing.T) {
+	s := NewValidatorStatsScraper(func() ValidatorStats {
+		return ValidatorStats{ValidatorTotal: 3, ValidatorActive: 2}
+	})
+	r, err := s.Scrape()
+	require.NoError(t, err)
+	vs := &ValidatorStats{}
+	require.NoError(t, json.NewDecoder(r).Decode(vs))
+	require.Equal(t, int64(3), vs.ValidatorTotal)
+	require.Equal(t, int64(2), vs.ValidatorActive)
+	require.Equal(t, ValidatorProcessName, vs.ProcessName)
+}
@@ -148,6 +148,36 @@ func Test_BellatrixToV1Alpha1SignedBlock(t *testing.T) {
 	assert.DeepEqual(t, v2Root, alphaRoot)
 }
 
+func Test_V1Alpha1BlindedBeaconBlockBellatrixToV2Blinded(t *testing.T) {
+	alphaBlock := util.HydrateBlindedBeaconBlockBellatrix(&ethpbalpha.BlindedBeaconBlockBellatrix{})
+	alphaBlock.Slot = slot
+	alphaBlock.ProposerIndex = validatorIndex
+	alphaBlock.ParentRoot = parentRoot
+	alphaBlock.StateRoot = stateRoot
+	alphaBlock.Body.RandaoReveal = randaoReveal
+	alphaBlock.Body.Eth1Data = &ethpbalpha.Eth1Data{
+		DepositRoot:  depositRoot,
+		DepositCount: depositCount,
+		BlockHash:    blockHash,
+	}
+	syncCommitteeBits := bitfield.NewBitvector512()
+	syncCommitteeBits.SetBitAt(100, true)
+	alphaBlock.Body.SyncAggregate = &ethpbalpha.SyncAggregate{
+		SyncCommitteeBits:      syncCommitteeBits,
+		SyncCommitteeSignature: signature,
+	}
+	alphaBlock.Body.ExecutionPayloadHeader.BlockHash = blockHash
+	alphaBlock.Body.ExecutionPayloadHeader.TransactionsRoot = transactionsRoot
+
+	v2Block, err := V1Alpha1BlindedBeaconBlockBellatrixToV2Blinded(alphaBlock)
+	require.NoError(t, err)
+	alphaRoot, err := alphaBlock.HashTreeRoot()
+	require.NoError(t, err)
+	v2Root, err := v2Block.HashTreeRoot()
+	require.NoError(t, err)
+	assert.DeepEqual(t, alphaRoot, v2Root)
+}
+
 func Test_BlindedBellatrixToV1Alpha1SignedBlock(t *testing.T) {
 	v2Block := util.HydrateV2SignedBlindedBeaconBlockBellatrix(&ethpbv2.SignedBlindedBeaconBlockBellatrix{})
 	v2Block.Message.Slot = slot
@@ -34,6 +34,15 @@ func SemanticVersion() string {
 	return gitTag
 }
 
+// BuildTimestamp returns the unix timestamp of the build date, or 0 for local builds.
+func BuildTimestamp() int64 {
+	ts, err := strconv.ParseInt(buildDateUnix, 10, 64)
+	if err != nil {
+		return 0
+	}
+	return ts
+}
+
 // BuildData returns the git tag and commit of the current build.
 func BuildData() string {
 	// if doing a local build, these values are not interpolated
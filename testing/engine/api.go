@@ -0,0 +1,226 @@
+package engine
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	gethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/config/params"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	pb "github.com/prysmaticlabs/prysm/proto/engine/v1"
+)
+
+// forkchoiceUpdatedResponse is the response of engine_forkchoiceUpdatedV1.
+type forkchoiceUpdatedResponse struct {
+	Status    *pb.PayloadStatus  `json:"payloadStatus"`
+	PayloadId *pb.PayloadIDBytes `json:"payloadId"`
+}
+
+// payloadBody is the response of engine_getPayloadBodiesByHashV1 for a single block.
+type payloadBody struct {
+	Transactions []hexutil.Bytes `json:"transactions"`
+}
+
+// engineAPI serves the engine_ namespace.
+type engineAPI struct {
+	s *Server
+}
+
+// NewPayloadV1 imports the payload if its parent is known, returning SYNCING otherwise.
+func (api *engineAPI) NewPayloadV1(ctx context.Context, payload *pb.ExecutionPayload) (*pb.PayloadStatus, error) {
+	if err := api.s.fault(ctx, NewPayloadMethod); err != nil {
+		return nil, err
+	}
+	if payload == nil {
+		return nil, errors.New("nil payload")
+	}
+	s := api.s
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	status := s.scriptedStatus(bytesutil.ToBytes32(payload.BlockHash))
+	if status != nil {
+		if status.Status == pb.PayloadStatus_VALID {
+			s.chain.importPayload(payload)
+		}
+		return status, nil
+	}
+	if !s.chain.importPayload(payload) {
+		return &pb.PayloadStatus{Status: pb.PayloadStatus_SYNCING}, nil
+	}
+	return &pb.PayloadStatus{
+		Status:          pb.PayloadStatus_VALID,
+		LatestValidHash: bytesutil.SafeCopyBytes(payload.BlockHash),
+	}, nil
+}
+
+// ForkchoiceUpdatedV1 sets the head of the chain and starts building a payload if attributes are given.
+// It returns SYNCING if the head block is unknown.
+func (api *engineAPI) ForkchoiceUpdatedV1(
+	ctx context.Context, state *pb.ForkchoiceState, attrs *pb.PayloadAttributes,
+) (*forkchoiceUpdatedResponse, error) {
+	if err := api.s.fault(ctx, ForkchoiceUpdatedMethod); err != nil {
+		return nil, err
+	}
+	if state == nil {
+		return nil, errors.New("nil forkchoice state")
+	}
+	s := api.s
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	headHash := common.BytesToHash(state.HeadBlockHash)
+	status := s.scriptedStatus(headHash)
+	head, ok := s.chain.blocks[headHash]
+	if !ok || (status != nil && status.Status != pb.PayloadStatus_VALID) {
+		if status == nil {
+			status = &pb.PayloadStatus{Status: pb.PayloadStatus_SYNCING}
+		}
+		return &forkchoiceUpdatedResponse{Status: status}, nil
+	}
+	if status == nil {
+		status = &pb.PayloadStatus{
+			Status:          pb.PayloadStatus_VALID,
+			LatestValidHash: headHash.Bytes(),
+		}
+	}
+	s.chain.setHead(headHash)
+	resp := &forkchoiceUpdatedResponse{Status: status}
+	if attrs != nil {
+		id := s.chain.buildPayload(head, attrs)
+		resp.PayloadId = &id
+	}
+	return resp, nil
+}
+
+// GetPayloadV1 returns a payload built by ForkchoiceUpdatedV1.
+func (api *engineAPI) GetPayloadV1(ctx context.Context, id pb.PayloadIDBytes) (*pb.ExecutionPayload, error) {
+	if err := api.s.fault(ctx, GetPayloadMethod); err != nil {
+		return nil, err
+	}
+	api.s.lock.RLock()
+	defer api.s.lock.RUnlock()
+	payload, ok := api.s.chain.payloads[id]
+	if !ok {
+		return nil, unknownPayloadError(id)
+	}
+	return payload, nil
+}
+
+// ExchangeTransitionConfigurationV1 returns the transition configuration of the server.
+func (api *engineAPI) ExchangeTransitionConfigurationV1(
+	ctx context.Context, _ *pb.TransitionConfiguration,
+) (*pb.TransitionConfiguration, error) {
+	if err := api.s.fault(ctx, ExchangeTransitionConfigurationMethod); err != nil {
+		return nil, err
+	}
+	return api.s.transitionCfg, nil
+}
+
+// GetPayloadBodiesByHashV1 returns the transactions of the given blocks, with nil entries for unknown blocks.
+func (api *engineAPI) GetPayloadBodiesByHashV1(ctx context.Context, hashes []common.Hash) ([]*payloadBody, error) {
+	if err := api.s.fault(ctx, GetPayloadBodiesByHashMethod); err != nil {
+		return nil, err
+	}
+	api.s.lock.RLock()
+	defer api.s.lock.RUnlock()
+	bodies := make([]*payloadBody, len(hashes))
+	for i, hash := range hashes {
+		blk, ok := api.s.chain.blocks[hash]
+		if !ok {
+			continue
+		}
+		txs := make([]hexutil.Bytes, len(blk.Transactions))
+		for j, tx := range blk.Transactions {
+			txs[j] = tx
+		}
+		bodies[i] = &payloadBody{Transactions: txs}
+	}
+	return bodies, nil
+}
+
+// ethAPI serves the eth_ namespace.
+type ethAPI struct {
+	s *Server
+}
+
+// ChainId returns the deposit chain id of the beacon chain config.
+func (api *ethAPI) ChainId(ctx context.Context) (*hexutil.Big, error) {
+	if err := api.s.fault(ctx, ChainIDMethod); err != nil {
+		return nil, err
+	}
+	return (*hexutil.Big)(new(big.Int).SetUint64(params.BeaconConfig().DepositChainID)), nil
+}
+
+// BlockNumber returns the number of the head block.
+func (api *ethAPI) BlockNumber(ctx context.Context) (hexutil.Uint64, error) {
+	if err := api.s.fault(ctx, BlockNumberMethod); err != nil {
+		return 0, err
+	}
+	api.s.lock.RLock()
+	defer api.s.lock.RUnlock()
+	return hexutil.Uint64(blockNumber(api.s.chain.blocks[api.s.chain.head])), nil
+}
+
+// GetBlockByNumber returns the canonical block at the given number, or nil if there is none.
+// Transactions are always returned as hashes.
+func (api *ethAPI) GetBlockByNumber(ctx context.Context, number rpc.BlockNumber, _ bool) (*pb.ExecutionBlock, error) {
+	if err := api.s.fault(ctx, BlockByNumberMethod); err != nil {
+		return nil, err
+	}
+	api.s.lock.RLock()
+	defer api.s.lock.RUnlock()
+	blk, ok := api.s.chain.blockByNumber(number)
+	if !ok {
+		return nil, nil
+	}
+	return withTransactionHashes(blk), nil
+}
+
+// GetBlockByHash returns the block with the given hash, or nil if it is unknown.
+// Transactions are always returned as hashes.
+func (api *ethAPI) GetBlockByHash(ctx context.Context, hash common.Hash, _ bool) (*pb.ExecutionBlock, error) {
+	if err := api.s.fault(ctx, BlockByHashMethod); err != nil {
+		return nil, err
+	}
+	api.s.lock.RLock()
+	defer api.s.lock.RUnlock()
+	blk, ok := api.s.chain.blocks[hash]
+	if !ok {
+		return nil, nil
+	}
+	return withTransactionHashes(blk), nil
+}
+
+// GetLogs returns no logs, as the server has no deposit contract.
+func (api *ethAPI) GetLogs(ctx context.Context, _ map[string]interface{}) ([]gethTypes.Log, error) {
+	if err := api.s.fault(ctx, GetLogsMethod); err != nil {
+		return nil, err
+	}
+	return []gethTypes.Log{}, nil
+}
+
+// Syncing returns false, as the server is always in sync.
+func (api *ethAPI) Syncing(ctx context.Context) (bool, error) {
+	if err := api.s.fault(ctx, SyncingMethod); err != nil {
+		return false, err
+	}
+	return false, nil
+}
+
+// netAPI serves the net_ namespace.
+type netAPI struct {
+	s *Server
+}
+
+// Version returns the deposit network id of the beacon chain config.
+func (api *netAPI) Version(ctx context.Context) (string, error) {
+	if err := api.s.fault(ctx, NetVersionMethod); err != nil {
+		return "", err
+	}
+	return new(big.Int).SetUint64(params.BeaconConfig().DepositNetworkID).String(), nil
+}
@@ -0,0 +1,208 @@
+package engine
+
+import (
+	"encoding/binary"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	gethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	pb "github.com/prysmaticlabs/prysm/proto/engine/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	genesisGasLimit = 30000000
+	genesisBaseFee  = 1000000000
+)
+
+// chain is an in-memory execution chain. Blocks are stored with their raw transactions
+// and their total difficulty. The canonical chain follows the head set by forkchoice updates.
+type chain struct {
+	genesis       common.Hash
+	head          common.Hash
+	blocks        map[common.Hash]*pb.ExecutionBlock
+	canonical     map[uint64]common.Hash
+	payloads      map[pb.PayloadIDBytes]*pb.ExecutionPayload
+	nextPayloadID uint64
+}
+
+func newChain(ttd *big.Int) *chain {
+	genesis := blockFromHeader(&gethTypes.Header{
+		UncleHash:   gethTypes.EmptyUncleHash,
+		TxHash:      gethTypes.EmptyRootHash,
+		ReceiptHash: gethTypes.EmptyRootHash,
+		Difficulty:  new(big.Int).Set(ttd),
+		Number:      big.NewInt(0),
+		GasLimit:    genesisGasLimit,
+		Extra:       []byte{},
+		BaseFee:     big.NewInt(genesisBaseFee),
+	}, ttd, [][]byte{})
+	hash := common.BytesToHash(genesis.Hash)
+	return &chain{
+		genesis:   hash,
+		head:      hash,
+		blocks:    map[common.Hash]*pb.ExecutionBlock{hash: genesis},
+		canonical: map[uint64]common.Hash{0: hash},
+		payloads:  make(map[pb.PayloadIDBytes]*pb.ExecutionPayload),
+	}
+}
+
+// importPayload stores the block of the payload. It returns false if the parent of the block is unknown.
+// The block hash of the payload is not verified.
+func (c *chain) importPayload(payload *pb.ExecutionPayload) bool {
+	parent, ok := c.blocks[common.BytesToHash(payload.ParentHash)]
+	if !ok {
+		return false
+	}
+	td, ok := new(big.Int).SetString(parent.TotalDifficulty, 0)
+	if !ok {
+		return false
+	}
+	blk := blockFromHeader(headerFromPayload(payload), td, payload.Transactions)
+	blk.Hash = bytesutil.SafeCopyBytes(payload.BlockHash)
+	c.blocks[common.BytesToHash(payload.BlockHash)] = blk
+	return true
+}
+
+// setHead makes the block with the given hash the head of the canonical chain.
+func (c *chain) setHead(hash common.Hash) {
+	blk, ok := c.blocks[hash]
+	if !ok {
+		return
+	}
+	c.head = hash
+	headNumber := blockNumber(blk)
+	for n := range c.canonical {
+		if n > headNumber {
+			delete(c.canonical, n)
+		}
+	}
+	for {
+		n := blockNumber(blk)
+		if c.canonical[n] == hash {
+			return
+		}
+		c.canonical[n] = hash
+		if n == 0 {
+			return
+		}
+		hash = common.BytesToHash(blk.ParentHash)
+		blk, ok = c.blocks[hash]
+		if !ok {
+			return
+		}
+	}
+}
+
+// buildPayload builds an empty payload on top of the parent and returns its id.
+func (c *chain) buildPayload(parent *pb.ExecutionBlock, attrs *pb.PayloadAttributes) pb.PayloadIDBytes {
+	payload := &pb.ExecutionPayload{
+		ParentHash:    bytesutil.SafeCopyBytes(parent.Hash),
+		FeeRecipient:  bytesutil.SafeCopyBytes(attrs.SuggestedFeeRecipient),
+		StateRoot:     bytesutil.SafeCopyBytes(parent.StateRoot),
+		ReceiptsRoot:  gethTypes.EmptyRootHash.Bytes(),
+		LogsBloom:     make([]byte, fieldparams.LogsBloomLength),
+		PrevRandao:    bytesutil.SafeCopyBytes(attrs.PrevRandao),
+		BlockNumber:   blockNumber(parent) + 1,
+		GasLimit:      parent.GasLimit,
+		Timestamp:     attrs.Timestamp,
+		ExtraData:     []byte{},
+		BaseFeePerGas: bytesutil.SafeCopyBytes(parent.BaseFeePerGas),
+		Transactions:  [][]byte{},
+	}
+	payload.BlockHash = headerFromPayload(payload).Hash().Bytes()
+
+	c.nextPayloadID++
+	var id pb.PayloadIDBytes
+	binary.BigEndian.PutUint64(id[:], c.nextPayloadID)
+	c.payloads[id] = payload
+	return id
+}
+
+// blockByNumber returns the canonical block at the given number.
+func (c *chain) blockByNumber(number rpc.BlockNumber) (*pb.ExecutionBlock, bool) {
+	if number == rpc.LatestBlockNumber || number == rpc.PendingBlockNumber {
+		return c.blocks[c.head], true
+	}
+	if number < 0 {
+		return nil, false
+	}
+	hash, ok := c.canonical[uint64(number)]
+	if !ok {
+		return nil, false
+	}
+	return c.blocks[hash], true
+}
+
+// headerFromPayload returns the execution block header of the payload. Transactions are
+// treated as opaque, so the transactions root is only meaningful for empty payloads.
+func headerFromPayload(payload *pb.ExecutionPayload) *gethTypes.Header {
+	txHash := gethTypes.EmptyRootHash
+	if len(payload.Transactions) > 0 {
+		txHash = crypto.Keccak256Hash(payload.Transactions...)
+	}
+	return &gethTypes.Header{
+		ParentHash:  common.BytesToHash(payload.ParentHash),
+		UncleHash:   gethTypes.EmptyUncleHash,
+		Coinbase:    common.BytesToAddress(payload.FeeRecipient),
+		Root:        common.BytesToHash(payload.StateRoot),
+		TxHash:      txHash,
+		ReceiptHash: common.BytesToHash(payload.ReceiptsRoot),
+		Bloom:       gethTypes.BytesToBloom(payload.LogsBloom),
+		Difficulty:  big.NewInt(0),
+		Number:      new(big.Int).SetUint64(payload.BlockNumber),
+		GasLimit:    payload.GasLimit,
+		GasUsed:     payload.GasUsed,
+		Time:        payload.Timestamp,
+		Extra:       payload.ExtraData,
+		MixDigest:   common.BytesToHash(payload.PrevRandao),
+		BaseFee:     new(big.Int).SetBytes(bytesutil.ReverseByteOrder(payload.BaseFeePerGas)),
+	}
+}
+
+func blockFromHeader(header *gethTypes.Header, td *big.Int, txs [][]byte) *pb.ExecutionBlock {
+	return &pb.ExecutionBlock{
+		Number:           header.Number.Bytes(),
+		Hash:             header.Hash().Bytes(),
+		ParentHash:       header.ParentHash.Bytes(),
+		Sha3Uncles:       header.UncleHash.Bytes(),
+		Miner:            header.Coinbase.Bytes(),
+		StateRoot:        header.Root.Bytes(),
+		TransactionsRoot: header.TxHash.Bytes(),
+		ReceiptsRoot:     header.ReceiptHash.Bytes(),
+		LogsBloom:        header.Bloom.Bytes(),
+		Difficulty:       header.Difficulty.Bytes(),
+		TotalDifficulty:  "0x" + td.Text(16),
+		GasLimit:         header.GasLimit,
+		GasUsed:          header.GasUsed,
+		Timestamp:        header.Time,
+		ExtraData:        header.Extra,
+		MixHash:          header.MixDigest.Bytes(),
+		Nonce:            header.Nonce[:],
+		BaseFeePerGas:    bytesutil.PadTo(bytesutil.ReverseByteOrder(header.BaseFee.Bytes()), fieldparams.RootLength),
+		Size:             new(big.Int).SetUint64(uint64(header.Size())).Bytes(),
+		Transactions:     txs,
+		Uncles:           [][]byte{},
+	}
+}
+
+// withTransactionHashes returns a copy of the block with its transactions replaced by their hashes,
+// as returned by eth_getBlockByHash and eth_getBlockByNumber.
+func withTransactionHashes(blk *pb.ExecutionBlock) *pb.ExecutionBlock {
+	cpy, ok := proto.Clone(blk).(*pb.ExecutionBlock)
+	if !ok {
+		return nil
+	}
+	for i, tx := range cpy.Transactions {
+		cpy.Transactions[i] = crypto.Keccak256(tx)
+	}
+	return cpy
+}
+
+func blockNumber(blk *pb.ExecutionBlock) uint64 {
+	return new(big.Int).SetBytes(blk.Number).Uint64()
+}
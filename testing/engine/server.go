@@ -0,0 +1,294 @@
+// Package engine provides an in-process execution client for tests. It serves the Engine API
+// methods and the eth_ methods used by the powchain service over JWT authenticated HTTP, keeps
+// a simple in-memory execution chain, and can be scripted to return specific payload statuses,
+// errors or latency for deterministic optimistic sync and invalid payload tests.
+//
+// Calls to the deposit contract (eth_call) are not served.
+package engine
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/holiman/uint256"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/config/params"
+	pb "github.com/prysmaticlabs/prysm/proto/engine/v1"
+)
+
+// JSON-RPC method names which can be given to SetLatency and SetError.
+const (
+	NewPayloadMethod                      = "engine_newPayloadV1"
+	ForkchoiceUpdatedMethod               = "engine_forkchoiceUpdatedV1"
+	GetPayloadMethod                      = "engine_getPayloadV1"
+	ExchangeTransitionConfigurationMethod = "engine_exchangeTransitionConfigurationV1"
+	GetPayloadBodiesByHashMethod          = "engine_getPayloadBodiesByHashV1"
+	ChainIDMethod                         = "eth_chainId"
+	BlockNumberMethod                     = "eth_blockNumber"
+	BlockByNumberMethod                   = "eth_getBlockByNumber"
+	BlockByHashMethod                     = "eth_getBlockByHash"
+	GetLogsMethod                         = "eth_getLogs"
+	SyncingMethod                         = "eth_syncing"
+	NetVersionMethod                      = "net_version"
+)
+
+// Engine API error code for payload ids which are not known to the server.
+const unknownPayloadCode = -38001
+
+// The allowed difference between the issued at claim of a JWT token and the time it is received.
+const jwtIssuedAtTolerance = 5 * time.Second
+
+// Option configures a Server.
+type Option func(s *Server) error
+
+// WithJWTSecret requires requests to be authenticated with JWT tokens signed with the given secret.
+func WithJWTSecret(secret []byte) Option {
+	return func(s *Server) error {
+		if len(secret) == 0 {
+			return errors.New("empty JWT secret")
+		}
+		s.jwtSecret = secret
+		return nil
+	}
+}
+
+// WithTransitionConfiguration sets the transition configuration returned by
+// engine_exchangeTransitionConfigurationV1. It defaults to the beacon chain config values.
+func WithTransitionConfiguration(cfg *pb.TransitionConfiguration) Option {
+	return func(s *Server) error {
+		if cfg == nil {
+			return errors.New("nil transition configuration")
+		}
+		s.transitionCfg = cfg
+		return nil
+	}
+}
+
+// Server is an in-process execution client served over HTTP.
+type Server struct {
+	jwtSecret     []byte
+	transitionCfg *pb.TransitionConfiguration
+	rpcServer     *rpc.Server
+	httpServer    *httptest.Server
+
+	lock          sync.RWMutex
+	chain         *chain
+	statuses      map[[32]byte]*pb.PayloadStatus
+	defaultStatus *pb.PayloadStatus
+	latencies     map[string]time.Duration
+	errs          map[string]error
+	calls         map[string]int
+}
+
+// New creates and starts a server with a chain consisting of a genesis block
+// which has reached the terminal total difficulty.
+func New(opts ...Option) (*Server, error) {
+	ttd, ok := new(big.Int).SetString(params.BeaconConfig().TerminalTotalDifficulty, 10)
+	if !ok {
+		return nil, errors.New("could not parse terminal total difficulty")
+	}
+	ttdUint, overflows := uint256.FromBig(ttd)
+	if overflows {
+		return nil, errors.New("terminal total difficulty overflows")
+	}
+	s := &Server{
+		transitionCfg: &pb.TransitionConfiguration{
+			TerminalTotalDifficulty: ttdUint.Hex(),
+			TerminalBlockHash:       params.BeaconConfig().TerminalBlockHash[:],
+			TerminalBlockNumber:     big.NewInt(0).Bytes(),
+		},
+		chain:     newChain(ttd),
+		statuses:  make(map[[32]byte]*pb.PayloadStatus),
+		latencies: make(map[string]time.Duration),
+		errs:      make(map[string]error),
+		calls:     make(map[string]int),
+	}
+	for _, opt := range opts {
+		if err := opt(s); err != nil {
+			return nil, err
+		}
+	}
+
+	s.rpcServer = rpc.NewServer()
+	if err := s.rpcServer.RegisterName("engine", &engineAPI{s: s}); err != nil {
+		return nil, err
+	}
+	if err := s.rpcServer.RegisterName("eth", &ethAPI{s: s}); err != nil {
+		return nil, err
+	}
+	if err := s.rpcServer.RegisterName("net", &netAPI{s: s}); err != nil {
+		return nil, err
+	}
+	s.httpServer = httptest.NewServer(s.authenticate(s.rpcServer))
+	return s, nil
+}
+
+// URL of the HTTP endpoint of the server.
+func (s *Server) URL() string {
+	return s.httpServer.URL
+}
+
+// Stop the server.
+func (s *Server) Stop() {
+	s.httpServer.Close()
+	s.rpcServer.Stop()
+}
+
+// GenesisHash returns the hash of the genesis block of the execution chain.
+func (s *Server) GenesisHash() common.Hash {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.chain.genesis
+}
+
+// HeadHash returns the hash of the head block of the execution chain, as last set by
+// engine_forkchoiceUpdatedV1.
+func (s *Server) HeadHash() common.Hash {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.chain.head
+}
+
+// HasBlock returns true if the block with the given hash was imported.
+func (s *Server) HasBlock(hash common.Hash) bool {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	_, ok := s.chain.blocks[hash]
+	return ok
+}
+
+// SetPayloadStatus scripts the status returned by engine_newPayloadV1 and
+// engine_forkchoiceUpdatedV1 for the block with the given hash. Blocks are only
+// imported when the scripted status is VALID and their parent is known.
+func (s *Server) SetPayloadStatus(blockHash [32]byte, status *pb.PayloadStatus) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.statuses[blockHash] = status
+}
+
+// SetDefaultPayloadStatus scripts the status returned for all blocks without a status of
+// their own. A nil status restores the default behavior of importing blocks with known
+// parents and returning SYNCING for the others.
+func (s *Server) SetDefaultPayloadStatus(status *pb.PayloadStatus) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.defaultStatus = status
+}
+
+// SetLatency delays the responses to the given method.
+func (s *Server) SetLatency(method string, latency time.Duration) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.latencies[method] = latency
+}
+
+// SetError makes the given method fail with the error. Errors implementing rpc.Error,
+// such as rpc.CustomError, are returned with their error code.
+func (s *Server) SetError(method string, err error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.errs[method] = err
+}
+
+// ClearFaults removes all scripted statuses, latencies and errors.
+func (s *Server) ClearFaults() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.statuses = make(map[[32]byte]*pb.PayloadStatus)
+	s.defaultStatus = nil
+	s.latencies = make(map[string]time.Duration)
+	s.errs = make(map[string]error)
+}
+
+// CallCount returns the number of times the given method was called.
+func (s *Server) CallCount(method string) int {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.calls[method]
+}
+
+// scriptedStatus returns the scripted status for the block, if any.
+func (s *Server) scriptedStatus(blockHash [32]byte) *pb.PayloadStatus {
+	if status, ok := s.statuses[blockHash]; ok {
+		return status
+	}
+	return s.defaultStatus
+}
+
+// fault records a call to the method and applies its scripted latency and error.
+func (s *Server) fault(ctx context.Context, method string) error {
+	s.lock.Lock()
+	s.calls[method]++
+	latency := s.latencies[method]
+	err := s.errs[method]
+	s.lock.Unlock()
+
+	if latency > 0 {
+		select {
+		case <-time.After(latency):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	return err
+}
+
+// authenticate rejects requests without a valid JWT token when a secret is configured.
+func (s *Server) authenticate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if len(s.jwtSecret) > 0 {
+			if err := s.verifyJWT(r.Header.Get("Authorization")); err != nil {
+				http.Error(w, err.Error(), http.StatusUnauthorized)
+				return
+			}
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (s *Server) verifyJWT(header string) error {
+	tokenString := strings.TrimPrefix(header, "Bearer ")
+	if tokenString == header {
+		return errors.New("missing bearer token")
+	}
+	token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+		}
+		return s.jwtSecret, nil
+	})
+	if err != nil {
+		return errors.Wrap(err, "invalid token")
+	}
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok {
+		return errors.New("invalid token claims")
+	}
+	iat, ok := claims["iat"].(float64)
+	if !ok {
+		return errors.New("missing issued at claim")
+	}
+	diff := time.Since(time.Unix(int64(iat), 0))
+	if diff > jwtIssuedAtTolerance || diff < -jwtIssuedAtTolerance {
+		return errors.New("stale token")
+	}
+	return nil
+}
+
+// unknownPayloadError is returned for payload ids which were not produced by the server.
+func unknownPayloadError(id pb.PayloadIDBytes) error {
+	return &rpc.CustomError{
+		Code:            unknownPayloadCode,
+		ValidationError: fmt.Sprintf("unknown payload %#x", hexutil.Bytes(id[:])),
+	}
+}
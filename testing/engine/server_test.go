@@ -0,0 +1,99 @@
+package engine
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	pb "github.com/prysmaticlabs/prysm/proto/engine/v1"
+	"github.com/prysmaticlabs/prysm/testing/require"
+)
+
+const rpcRequest = `{"jsonrpc":"2.0","id":1,"method":"eth_chainId","params":[]}`
+
+func TestServer_JWTAuthentication(t *testing.T) {
+	secret := bytesutil.PadTo([]byte("secret"), 32)
+	s, err := New(WithJWTSecret(secret))
+	require.NoError(t, err)
+	defer s.Stop()
+
+	token := func(key []byte, iat time.Time) string {
+		tokenString, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{"iat": iat.Unix()}).SignedString(key)
+		require.NoError(t, err)
+		return "Bearer " + tokenString
+	}
+	tests := []struct {
+		name   string
+		header string
+		want   int
+	}{
+		{name: "no token", want: http.StatusUnauthorized},
+		{name: "wrong secret", header: token([]byte("wrong"), time.Now()), want: http.StatusUnauthorized},
+		{name: "stale token", header: token(secret, time.Now().Add(-time.Minute)), want: http.StatusUnauthorized},
+		{name: "valid token", header: token(secret, time.Now()), want: http.StatusOK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodPost, s.URL(), strings.NewReader(rpcRequest))
+			require.NoError(t, err)
+			req.Header.Set("Content-Type", "application/json")
+			if tt.header != "" {
+				req.Header.Set("Authorization", tt.header)
+			}
+			resp, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			require.NoError(t, resp.Body.Close())
+			require.Equal(t, tt.want, resp.StatusCode)
+		})
+	}
+}
+
+func TestServer_HeadersMatchBlockHashes(t *testing.T) {
+	s, err := New()
+	require.NoError(t, err)
+	defer s.Stop()
+	ctx := context.Background()
+	client, err := rpc.DialHTTP(s.URL())
+	require.NoError(t, err)
+	defer client.Close()
+	api := &engineAPI{s: s}
+
+	resp, err := api.ForkchoiceUpdatedV1(ctx, &pb.ForkchoiceState{HeadBlockHash: s.GenesisHash().Bytes()}, &pb.PayloadAttributes{
+		Timestamp:             1,
+		PrevRandao:            make([]byte, 32),
+		SuggestedFeeRecipient: make([]byte, 20),
+	})
+	require.NoError(t, err)
+	require.NotNil(t, resp.PayloadId)
+	payload, err := api.GetPayloadV1(ctx, *resp.PayloadId)
+	require.NoError(t, err)
+	status, err := api.NewPayloadV1(ctx, payload)
+	require.NoError(t, err)
+	require.Equal(t, pb.PayloadStatus_VALID, status.Status)
+	_, err = api.ForkchoiceUpdatedV1(ctx, &pb.ForkchoiceState{HeadBlockHash: payload.BlockHash}, nil)
+	require.NoError(t, err)
+
+	// Headers decoded by an eth client hash to the block hashes of the server.
+	header, err := ethclient.NewClient(client).HeaderByNumber(ctx, nil)
+	require.NoError(t, err)
+	require.DeepEqual(t, payload.BlockHash, header.Hash().Bytes())
+	require.Equal(t, s.GenesisHash(), header.ParentHash)
+	require.Equal(t, 1, s.CallCount(NewPayloadMethod))
+}
+
+func TestServer_UnknownPayload(t *testing.T) {
+	s, err := New()
+	require.NoError(t, err)
+	defer s.Stop()
+
+	_, err = (&engineAPI{s: s}).GetPayloadV1(context.Background(), pb.PayloadIDBytes{1})
+	rpcErr, ok := err.(rpc.Error)
+	require.Equal(t, true, ok)
+	require.Equal(t, unknownPayloadCode, rpcErr.ErrorCode())
+}
@@ -1,5 +1,10 @@
 // Package main implements a simple, http-request-sink which writes
 // incoming http request bodies to an append-only text file at a specified directory.
+//
+// Requests whose body is a JSON list, such as the batches of client-stats pushed by
+// the beacon node and validator, are captured one element per line, which makes the
+// sink usable as a local beaconcha.in compatible client-stats collector. The
+// -fail-requests flag rejects the first requests to exercise the retries of clients.
 package main
 
 import (
@@ -13,6 +18,7 @@ import (
 	"os"
 	"path/filepath"
 	"strconv"
+	"sync"
 
 	"github.com/prysmaticlabs/prysm/config/params"
 )
@@ -20,6 +26,7 @@ import (
 func main() {
 	port := flag.Int("port", 8080, "port to listen on")
 	writeDirPath := flag.String("write-dir", "", "directory to write an append-only file")
+	failRequests := flag.Int("fail-requests", 0, "number of requests to reject with 503 Service Unavailable before accepting requests")
 	flag.Parse()
 	if *writeDirPath == "" {
 		log.Fatal("Needs a -write-dir path")
@@ -40,18 +47,50 @@ func main() {
 		}
 	}()
 
-	http.HandleFunc("/", func(writer http.ResponseWriter, r *http.Request) {
-		reqContent := map[string]interface{}{}
-		if err = parseRequest(r, &reqContent); err != nil {
-			log.Println(err)
+	http.Handle("/", &sink{f: f, failRequests: *failRequests})
+	log.Printf("Listening on port %d", *port)
+	log.Fatal(http.ListenAndServe(":"+strconv.Itoa(*port), nil))
+}
+
+// sink captures the requests it receives to a file, after rejecting the first failRequests requests.
+type sink struct {
+	sync.Mutex
+	f            *os.File
+	failRequests int
+}
+
+func (s *sink) ServeHTTP(writer http.ResponseWriter, r *http.Request) {
+	s.Lock()
+	defer s.Unlock()
+	if s.failRequests > 0 {
+		s.failRequests--
+		log.Printf("Rejecting request from %s, %d more to reject", r.RemoteAddr, s.failRequests)
+		writer.WriteHeader(http.StatusServiceUnavailable)
+		return
+	}
+	var reqContent interface{}
+	if err := parseRequest(r, &reqContent); err != nil {
+		log.Println(err)
+		writer.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	log.Printf("Capturing request from %s", r.RemoteAddr)
+	entries, ok := reqContent.([]interface{})
+	if !ok {
+		entries = []interface{}{reqContent}
+	}
+	for _, e := range entries {
+		m, ok := e.(map[string]interface{})
+		if !ok {
+			log.Printf("Skipping request entry which is not a JSON object: %v", e)
+			continue
 		}
-		log.Printf("Capturing request from %s", r.RemoteAddr)
-		if err = captureRequest(f, reqContent); err != nil {
+		if err := captureRequest(s.f, m); err != nil {
 			log.Println(err)
+			writer.WriteHeader(http.StatusInternalServerError)
+			return
 		}
-	})
-	log.Printf("Listening on port %d", *port)
-	log.Fatal(http.ListenAndServe(":"+strconv.Itoa(*port), nil))
+	}
 }
 
 func captureRequest(f *os.File, m map[string]interface{}) error {
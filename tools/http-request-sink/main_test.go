@@ -5,8 +5,10 @@ import (
 	"encoding/json"
 	"io"
 	"net/http"
+	"net/http/httptest"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/prysmaticlabs/prysm/config/params"
@@ -62,3 +64,31 @@ func Test_parseAndCaptureRequest(t *testing.T) {
 		require.DeepEqual(t, val, receivedVal)
 	}
 }
+
+func Test_sinkCapturesListsAndFailsRequests(t *testing.T) {
+	tmpFile := filepath.Join(t.TempDir(), "requests.log")
+	f, err := os.OpenFile(tmpFile, os.O_APPEND|os.O_CREATE|os.O_RDWR, params.BeaconIoConfig().ReadWritePermissions)
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, f.Close())
+	}()
+	srv := httptest.NewServer(&sink{f: f, failRequests: 1})
+	defer srv.Close()
+
+	post := func(body string) int {
+		resp, err := http.Post(srv.URL, "application/json", bytes.NewBufferString(body))
+		require.NoError(t, err)
+		require.NoError(t, resp.Body.Close())
+		return resp.StatusCode
+	}
+	require.Equal(t, http.StatusServiceUnavailable, post(`{"process":"validator"}`))
+	require.Equal(t, http.StatusOK, post(`{"process":"validator"}`))
+	require.Equal(t, http.StatusOK, post(`[{"process":"beaconnode"},{"process":"validator"}]`))
+	require.Equal(t, http.StatusBadRequest, post(`{"process":`))
+
+	fileContents, err := os.ReadFile(tmpFile)
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(string(fileContents)), "\n")
+	require.Equal(t, 3, len(lines))
+	require.Equal(t, `{"process":"beaconnode"}`, lines[1])
+}
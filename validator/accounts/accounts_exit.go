@@ -39,6 +39,29 @@ type PerformExitCfg struct {
 
 const exitPassphrase = "Exit my validator"
 
+// exitPrompts are the texts shown to the user while selecting the accounts of a voluntary exit
+// and confirming it.
+type exitPrompts struct {
+	selectAccounts string
+	// action is the operation confirmed by the user, followed by the accounts it applies to.
+	action string
+	// proceed is the operation the user proceeds with once the implications of exiting are understood.
+	proceed string
+}
+
+var (
+	performExitPrompts = exitPrompts{
+		selectAccounts: userprompt.SelectAccountsVoluntaryExitPromptText,
+		action:         "perform a voluntary exit on",
+		proceed:        "continue with the voluntary exit",
+	}
+	presignExitPrompts = exitPrompts{
+		selectAccounts: userprompt.SelectAccountsPresignExitPromptText,
+		action:         "pre-sign a voluntary exit for",
+		proceed:        "pre-sign the voluntary exit, which can be broadcast by anyone holding it",
+	}
+)
+
 // ExitAccountsCli performs a voluntary exit on one or more accounts.
 func ExitAccountsCli(cliCtx *cli.Context, r io.Reader) error {
 	validatingPublicKeys, kManager, err := prepareWallet(cliCtx)
@@ -46,7 +69,7 @@ func ExitAccountsCli(cliCtx *cli.Context, r io.Reader) error {
 		return err
 	}
 
-	rawPubKeys, trimmedPubKeys, err := interact(cliCtx, r, validatingPublicKeys)
+	rawPubKeys, trimmedPubKeys, err := interact(cliCtx, r, validatingPublicKeys, performExitPrompts)
 	if err != nil {
 		return err
 	}
@@ -160,6 +183,7 @@ func interact(
 	cliCtx *cli.Context,
 	r io.Reader,
 	validatingPublicKeys [][fieldparams.BLSPubkeyLength]byte,
+	prompts exitPrompts,
 ) (rawPubKeys [][]byte, formattedPubKeys []string, err error) {
 	if !cliCtx.IsSet(flags.ExitAllFlag.Name) {
 		// Allow the user to interactively select the accounts to exit or optionally
@@ -168,7 +192,7 @@ func interact(
 			cliCtx,
 			flags.VoluntaryExitPublicKeysFlag,
 			validatingPublicKeys,
-			userprompt.SelectAccountsVoluntaryExitPromptText,
+			prompts.selectAccounts,
 		)
 		if err != nil {
 			return nil, nil, errors.Wrap(err, "could not filter public keys for voluntary exit")
@@ -183,9 +207,9 @@ func interact(
 		allAccountStr := strings.Join(formattedPubKeys, ", ")
 		if !cliCtx.IsSet(flags.VoluntaryExitPublicKeysFlag.Name) {
 			if len(filteredPubKeys) == 1 {
-				promptText := "Are you sure you want to perform a voluntary exit on 1 account? (%s) Y/N"
+				promptText := "Are you sure you want to %s 1 account? (%s) Y/N"
 				resp, err := prompt.ValidatePrompt(
-					r, fmt.Sprintf(promptText, au.BrightGreen(formattedPubKeys[0])), prompt.ValidateYesOrNo,
+					r, fmt.Sprintf(promptText, prompts.action, au.BrightGreen(formattedPubKeys[0])), prompt.ValidateYesOrNo,
 				)
 				if err != nil {
 					return nil, nil, err
@@ -194,13 +218,13 @@ func interact(
 					return nil, nil, nil
 				}
 			} else {
-				promptText := "Are you sure you want to perform a voluntary exit on %d accounts? (%s) Y/N"
+				promptText := "Are you sure you want to %s %d accounts? (%s) Y/N"
 				if len(filteredPubKeys) == len(validatingPublicKeys) {
 					promptText = fmt.Sprintf(
-						"Are you sure you want to perform a voluntary exit on all accounts? Y/N (%s)",
-						au.BrightGreen(allAccountStr))
+						"Are you sure you want to %s all accounts? Y/N (%s)",
+						prompts.action, au.BrightGreen(allAccountStr))
 				} else {
-					promptText = fmt.Sprintf(promptText, len(filteredPubKeys), au.BrightGreen(allAccountStr))
+					promptText = fmt.Sprintf(promptText, prompts.action, len(filteredPubKeys), au.BrightGreen(allAccountStr))
 				}
 				resp, err := prompt.ValidatePrompt(r, promptText, prompt.ValidateYesOrNo)
 				if err != nil {
@@ -213,7 +237,7 @@ func interact(
 		}
 	} else {
 		rawPubKeys, formattedPubKeys = prepareAllKeys(validatingPublicKeys)
-		fmt.Printf("About to perform a voluntary exit of %d accounts\n", len(rawPubKeys))
+		fmt.Printf("About to %s %d accounts\n", prompts.action, len(rawPubKeys))
 	}
 
 	promptHeader := au.Red("===============IMPORTANT===============")
@@ -221,8 +245,8 @@ func interact(
 		"Please navigate to the following website and make sure you understand the current implications " +
 		"of a voluntary exit before making the final decision:"
 	promptURL := au.Blue("https://docs.prylabs.network/docs/wallet/exiting-a-validator/#withdrawal-delay-warning")
-	promptQuestion := "If you still want to continue with the voluntary exit, please input a phrase found at the end " +
-		"of the page from the above URL"
+	promptQuestion := fmt.Sprintf("If you still want to %s, please input a phrase found at the end "+
+		"of the page from the above URL", prompts.proceed)
 	promptText := fmt.Sprintf("%s\n%s\n%s\n%s", promptHeader, promptDescription, promptURL, promptQuestion)
 	resp, err := prompt.ValidatePrompt(r, promptText, func(input string) error {
 		return prompt.ValidatePhrase(input, exitPassphrase)
@@ -89,7 +89,7 @@ func TestExitAccountsCli_OK(t *testing.T) {
 	// Prepare user input for final confirmation step
 	var stdin bytes.Buffer
 	stdin.Write([]byte(exitPassphrase))
-	rawPubKeys, formattedPubKeys, err := interact(cliCtx, &stdin, validatingPublicKeys)
+	rawPubKeys, formattedPubKeys, err := interact(cliCtx, &stdin, validatingPublicKeys, performExitPrompts)
 	require.NoError(t, err)
 	require.NotNil(t, rawPubKeys)
 	require.NotNil(t, formattedPubKeys)
@@ -184,7 +184,7 @@ func TestExitAccountsCli_OK_AllPublicKeys(t *testing.T) {
 	// Prepare user input for final confirmation step
 	var stdin bytes.Buffer
 	stdin.Write([]byte(exitPassphrase))
-	rawPubKeys, formattedPubKeys, err := interact(cliCtx, &stdin, validatingPublicKeys)
+	rawPubKeys, formattedPubKeys, err := interact(cliCtx, &stdin, validatingPublicKeys, performExitPrompts)
 	require.NoError(t, err)
 	require.NotNil(t, rawPubKeys)
 	require.NotNil(t, formattedPubKeys)
@@ -46,6 +46,10 @@ func (_ *mockRemoteKeymanager) Sign(context.Context, *validatorpb.SignRequest) (
 	return nil, nil
 }
 
+func (_ *mockRemoteKeymanager) SignBatch(_ context.Context, reqs []*validatorpb.SignRequest) ([]bls.Signature, []error) {
+	return make([]bls.Signature, len(reqs)), make([]error, len(reqs))
+}
+
 func (_ *mockRemoteKeymanager) SubscribeAccountChanges(_ chan [][fieldparams.BLSPubkeyLength]byte) event.Subscription {
 	return nil
 }
@@ -0,0 +1,120 @@
+package accounts
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/api/client/beacon"
+	"github.com/prysmaticlabs/prysm/cmd/validator/flags"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/io/file"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/time/slots"
+	"github.com/prysmaticlabs/prysm/validator/client"
+	"github.com/prysmaticlabs/prysm/validator/keymanager"
+	"github.com/urfave/cli/v2"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// PresignExitCfg for pre-signing account voluntary exits.
+type PresignExitCfg struct {
+	ValidatorClient  ethpb.BeaconNodeValidatorClient
+	Keymanager       keymanager.IKeymanager
+	RawPubKeys       [][]byte
+	FormattedPubKeys []string
+	Epoch            types.Epoch
+	OutputDir        string
+}
+
+// PresignExitsCli signs voluntary exits for one or more accounts and writes them to disk
+// as standard Beacon API JSON files. The exits are not broadcast, so they can be held
+// until they are needed and submitted to any beacon node later on.
+func PresignExitsCli(cliCtx *cli.Context, r io.Reader) error {
+	outputDir := cliCtx.String(flags.ExitOutputDirFlag.Name)
+	if outputDir == "" {
+		return fmt.Errorf("no output directory specified, please use the --%s flag", flags.ExitOutputDirFlag.Name)
+	}
+	validatingPublicKeys, kManager, err := prepareWallet(cliCtx)
+	if err != nil {
+		return err
+	}
+
+	rawPubKeys, trimmedPubKeys, err := interact(cliCtx, r, validatingPublicKeys, presignExitPrompts)
+	if err != nil {
+		return err
+	}
+	// User decided to cancel pre-signing the voluntary exit.
+	if rawPubKeys == nil && trimmedPubKeys == nil {
+		return nil
+	}
+
+	validatorClient, nodeClient, err := prepareClients(cliCtx)
+	if err != nil {
+		return err
+	}
+	if nodeClient == nil {
+		return errors.New("could not prepare beacon node client")
+	}
+	var epoch types.Epoch
+	if cliCtx.IsSet(flags.ExitEpochFlag.Name) {
+		epoch = types.Epoch(cliCtx.Uint64(flags.ExitEpochFlag.Name))
+	} else {
+		genesis, err := (*nodeClient).GetGenesis(cliCtx.Context, &emptypb.Empty{})
+		if err != nil {
+			return errors.Wrap(err, "gRPC call to get genesis time failed")
+		}
+		epoch = slots.ToEpoch(slots.CurrentSlot(uint64(genesis.GenesisTime.Seconds)))
+	}
+
+	paths, err := PresignVoluntaryExits(cliCtx.Context, PresignExitCfg{
+		ValidatorClient:  *validatorClient,
+		Keymanager:       kManager,
+		RawPubKeys:       rawPubKeys,
+		FormattedPubKeys: trimmedPubKeys,
+		Epoch:            epoch,
+		OutputDir:        outputDir,
+	})
+	if err != nil {
+		return err
+	}
+	log.WithField("epoch", epoch).Infof(
+		"Pre-signed %d voluntary exits. Keep these files safe, anyone holding them can exit the validators "+
+			"once the exit epoch is reached", len(paths))
+	return nil
+}
+
+// PresignVoluntaryExits signs a voluntary exit valid from the configured epoch for every public key
+// and writes each one to its own file in the output directory. It returns the written file paths.
+func PresignVoluntaryExits(ctx context.Context, cfg PresignExitCfg) ([]string, error) {
+	if err := file.MkdirAll(cfg.OutputDir); err != nil {
+		return nil, errors.Wrapf(err, "could not create directory %s", cfg.OutputDir)
+	}
+	paths := make([]string, 0, len(cfg.RawPubKeys))
+	for i, key := range cfg.RawPubKeys {
+		indexResponse, err := cfg.ValidatorClient.ValidatorIndex(ctx, &ethpb.ValidatorIndexRequest{PublicKey: key})
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not get validator index for account %s", cfg.FormattedPubKeys[i])
+		}
+		signedExit, err := client.CreateSignedVoluntaryExit(
+			ctx, cfg.ValidatorClient, cfg.Keymanager.Sign, key, indexResponse.Index, cfg.Epoch,
+		)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not sign voluntary exit for account %s", cfg.FormattedPubKeys[i])
+		}
+		enc, err := json.MarshalIndent(beacon.NewSignedVoluntaryExitJson(signedExit), "", "  ")
+		if err != nil {
+			return nil, err
+		}
+		p := filepath.Join(cfg.OutputDir, fmt.Sprintf("voluntary-exit-%d.json", indexResponse.Index))
+		if err := file.WriteFile(p, enc); err != nil {
+			return nil, errors.Wrapf(err, "could not write voluntary exit to %s", p)
+		}
+		log.WithField("publicKey", cfg.FormattedPubKeys[i]).Infof("Wrote pre-signed voluntary exit to %s", p)
+		paths = append(paths, p)
+	}
+	return paths, nil
+}
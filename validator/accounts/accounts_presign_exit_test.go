@@ -0,0 +1,88 @@
+package accounts
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/prysmaticlabs/prysm/api/client/beacon"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	mock2 "github.com/prysmaticlabs/prysm/testing/mock"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	"github.com/prysmaticlabs/prysm/validator/accounts/wallet"
+	"github.com/prysmaticlabs/prysm/validator/keymanager"
+)
+
+func TestPresignVoluntaryExits_OK(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockValidatorClient := mock2.NewMockBeaconNodeValidatorClient(ctrl)
+
+	mockValidatorClient.EXPECT().
+		ValidatorIndex(gomock.Any(), gomock.Any()).
+		Return(&ethpb.ValidatorIndexResponse{Index: 7}, nil)
+
+	mockValidatorClient.EXPECT().
+		DomainData(gomock.Any(), &ethpb.DomainRequest{Epoch: 100, Domain: []byte{4, 0, 0, 0}}).
+		Return(&ethpb.DomainResponse{SignatureDomain: make([]byte, 32)}, nil)
+
+	walletDir, _, passwordFilePath := setupWalletAndPasswordsDir(t)
+	keysDir := filepath.Join(t.TempDir(), "keysDir")
+	require.NoError(t, os.MkdirAll(keysDir, os.ModePerm))
+	keystore, _ := createKeystore(t, keysDir)
+	time.Sleep(time.Second)
+
+	cliCtx := setupWalletCtx(t, &testWalletConfig{
+		walletDir:               walletDir,
+		keymanagerKind:          keymanager.Local,
+		walletPasswordFile:      passwordFilePath,
+		accountPasswordFile:     passwordFilePath,
+		keysDir:                 keysDir,
+		voluntaryExitPublicKeys: keystore.Pubkey,
+	})
+	_, err := CreateWalletWithKeymanager(cliCtx.Context, &CreateWalletConfig{
+		WalletCfg: &wallet.Config{
+			WalletDir:      walletDir,
+			KeymanagerKind: keymanager.Local,
+			WalletPassword: password,
+		},
+	})
+	require.NoError(t, err)
+	require.NoError(t, ImportAccountsCli(cliCtx))
+
+	validatingPublicKeys, km, err := prepareWallet(cliCtx)
+	require.NoError(t, err)
+	var stdin bytes.Buffer
+	stdin.Write([]byte(exitPassphrase))
+	rawPubKeys, formattedPubKeys, err := interact(cliCtx, &stdin, validatingPublicKeys, presignExitPrompts)
+	require.NoError(t, err)
+
+	outputDir := filepath.Join(t.TempDir(), "exits")
+	paths, err := PresignVoluntaryExits(cliCtx.Context, PresignExitCfg{
+		ValidatorClient:  mockValidatorClient,
+		Keymanager:       km,
+		RawPubKeys:       rawPubKeys,
+		FormattedPubKeys: formattedPubKeys,
+		Epoch:            100,
+		OutputDir:        outputDir,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(paths))
+	assert.Equal(t, filepath.Join(outputDir, "voluntary-exit-7.json"), paths[0])
+
+	enc, err := os.ReadFile(paths[0])
+	require.NoError(t, err)
+	exitJson := &beacon.SignedVoluntaryExitJson{}
+	require.NoError(t, json.Unmarshal(enc, exitJson))
+	signedExit, err := exitJson.SignedVoluntaryExit()
+	require.NoError(t, err)
+	assert.Equal(t, types.Epoch(100), signedExit.Exit.Epoch)
+	assert.Equal(t, types.ValidatorIndex(7), signedExit.Exit.ValidatorIndex)
+	assert.Equal(t, 96, len(signedExit.Signature))
+}
@@ -127,6 +127,10 @@ func (_ MockValidator) SubmitAttestation(_ context.Context, _ types.Slot, _ [48]
 	panic("implement me")
 }
 
+func (_ MockValidator) SubmitAttestations(_ context.Context, _ types.Slot, _ [][48]byte) {
+	panic("implement me")
+}
+
 func (_ MockValidator) ProposeBlock(_ context.Context, _ types.Slot, _ [48]byte) {
 	panic("implement me")
 }
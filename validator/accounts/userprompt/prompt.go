@@ -30,6 +30,8 @@ const (
 	SelectAccountsBackupPromptText = "Select the account(s) you wish to backup"
 	// SelectAccountsVoluntaryExitPromptText --
 	SelectAccountsVoluntaryExitPromptText = "Select the account(s) on which you wish to perform a voluntary exit"
+	// SelectAccountsPresignExitPromptText --
+	SelectAccountsPresignExitPromptText = "Select the account(s) for which you wish to pre-sign a voluntary exit"
 )
 
 var au = aurora.NewAurora(true)
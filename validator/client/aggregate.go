@@ -85,6 +85,7 @@ func (v *validator) SubmitAggregateAndProof(ctx context.Context, slot types.Slot
 	}
 
 	sig, err := v.aggregateAndProofSig(ctx, pubKey, res.AggregateAndProof, slot)
+	v.sendDutyEvent(Signed, AggregateDuty, slot, pubKey, err)
 	if err != nil {
 		log.Errorf("Could not sign aggregate and proof: %v", err)
 		return
@@ -95,6 +96,7 @@ func (v *validator) SubmitAggregateAndProof(ctx context.Context, slot types.Slot
 			Signature: sig,
 		},
 	})
+	v.sendDutyEvent(Submitted, AggregateDuty, slot, pubKey, err)
 	if err != nil {
 		log.Errorf("Could not submit signed aggregate and proof to beacon node: %v", err)
 		if v.emitAccountMetrics {
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/prysmaticlabs/go-bitfield"
@@ -28,6 +29,9 @@ import (
 	"go.opencensus.io/trace"
 )
 
+// The maximum number of ProposeAttestation calls in flight at the same time in SubmitAttestations.
+const maxConcurrentAttestationSubmissions = 64
+
 // SubmitAttestation completes the validator client's attester responsibility at a given slot.
 // It fetches the latest beacon block head along with the latest canonical beacon state
 // information in order to sign the block and include information about the validator's
@@ -101,6 +105,7 @@ func (v *validator) SubmitAttestation(ctx context.Context, slot types.Slot, pubK
 	}
 
 	sig, _, err := v.signAtt(ctx, pubKey, data, slot)
+	v.sendDutyEvent(Signed, AttestationDuty, slot, pubKey, err)
 	if err != nil {
 		log.WithError(err).Error("Could not sign attestation")
 		if v.emitAccountMetrics {
@@ -110,6 +115,27 @@ func (v *validator) SubmitAttestation(ctx context.Context, slot types.Slot, pubK
 		return
 	}
 
+	v.proposeSignedAttestation(ctx, slot, pubKey, duty, indexedAtt, signingRoot, sig)
+}
+
+// proposeSignedAttestation checks the signed attestation data against slashing protection and sends
+// the attestation of the validator to the beacon node.
+func (v *validator) proposeSignedAttestation(
+	ctx context.Context,
+	slot types.Slot,
+	pubKey [fieldparams.BLSPubkeyLength]byte,
+	duty *ethpb.DutiesResponse_Duty,
+	indexedAtt *ethpb.IndexedAttestation,
+	signingRoot [32]byte,
+	sig []byte,
+) {
+	ctx, span := trace.StartSpan(ctx, "validator.proposeSignedAttestation")
+	defer span.End()
+
+	fmtKey := fmt.Sprintf("%#x", pubKey[:])
+	log := log.WithField("pubKey", fmt.Sprintf("%#x", bytesutil.Trunc(pubKey[:]))).WithField("slot", slot)
+	data := indexedAtt.Data
+
 	var indexInCommittee uint64
 	var found bool
 	for i, vID := range duty.Committee {
@@ -139,6 +165,7 @@ func (v *validator) SubmitAttestation(ctx context.Context, slot types.Slot, pubK
 	indexedAtt.Signature = sig
 	if err := v.slashableAttestationCheck(ctx, indexedAtt, pubKey, signingRoot); err != nil {
 		log.WithError(err).Error("Failed attestation slashing protection check")
+		v.sendDutyEvent(SlashingProtectionRejected, AttestationDuty, slot, pubKey, err)
 		log.WithFields(
 			attestationLogFields(pubKey, indexedAtt),
 		).Debug("Attempted slashable attestation details")
@@ -146,6 +173,7 @@ func (v *validator) SubmitAttestation(ctx context.Context, slot types.Slot, pubK
 		return
 	}
 	attResp, err := v.validatorClient.ProposeAttestation(ctx, attestation)
+	v.sendDutyEvent(Submitted, AttestationDuty, slot, pubKey, err)
 	if err != nil {
 		log.WithError(err).Error("Could not submit attestation to beacon node")
 		if v.emitAccountMetrics {
@@ -180,6 +208,141 @@ func (v *validator) SubmitAttestation(ctx context.Context, slot types.Slot, pubK
 	}
 }
 
+// SubmitAttestations completes the attester responsibility of many validator keys at a given slot.
+// Attestation data is requested once per committee and all attestations are signed in a single batch
+// by the keymanager. Only signing is batched: ProposeAttestation takes a single attestation, so the
+// signed attestations are sent to the beacon node one per call, with up to
+// maxConcurrentAttestationSubmissions calls in flight.
+func (v *validator) SubmitAttestations(ctx context.Context, slot types.Slot, pubKeys [][fieldparams.BLSPubkeyLength]byte) {
+	ctx, span := trace.StartSpan(ctx, "validator.SubmitAttestations")
+	defer span.End()
+	span.AddAttributes(trace.Int64Attribute("numKeys", int64(len(pubKeys))))
+
+	if len(pubKeys) == 0 {
+		return
+	}
+	v.waitOneThirdOrValidBlock(ctx, slot)
+
+	lockKeys := make([]string, len(pubKeys))
+	for i, pubKey := range pubKeys {
+		lockKeys[i] = string(byte(iface.RoleAttester)) + string(pubKey[:])
+	}
+	lock := async.NewMultilock(lockKeys...)
+	lock.Lock()
+	defer lock.Unlock()
+
+	type batchEntry struct {
+		pubKey      [fieldparams.BLSPubkeyLength]byte
+		duty        *ethpb.DutiesResponse_Duty
+		data        *ethpb.AttestationData
+		signingRoot [32]byte
+	}
+	fail := func(pubKey [fieldparams.BLSPubkeyLength]byte) {
+		if v.emitAccountMetrics {
+			ValidatorAttestFailVec.WithLabelValues(fmt.Sprintf("%#x", pubKey[:])).Inc()
+		}
+	}
+
+	committees := make(map[types.CommitteeIndex][]*batchEntry)
+	for _, pubKey := range pubKeys {
+		log := log.WithField("pubKey", fmt.Sprintf("%#x", bytesutil.Trunc(pubKey[:]))).WithField("slot", slot)
+		duty, err := v.duty(pubKey)
+		if err != nil {
+			log.WithError(err).Error("Could not fetch validator assignment")
+			fail(pubKey)
+			continue
+		}
+		if len(duty.Committee) == 0 {
+			log.Debug("Empty committee for validator duty, not attesting")
+			continue
+		}
+		committees[duty.CommitteeIndex] = append(committees[duty.CommitteeIndex], &batchEntry{pubKey: pubKey, duty: duty})
+	}
+
+	// All validators of a committee attest to the same data, so it is only requested once per committee.
+	var wg sync.WaitGroup
+	for committeeIndex, entries := range committees {
+		wg.Add(1)
+		go func(committeeIndex types.CommitteeIndex, entries []*batchEntry) {
+			defer wg.Done()
+			data, err := v.validatorClient.GetAttestationData(ctx, &ethpb.AttestationDataRequest{
+				Slot:           slot,
+				CommitteeIndex: committeeIndex,
+			})
+			if err != nil {
+				log.WithError(err).WithFields(logrus.Fields{
+					"slot":           slot,
+					"committeeIndex": committeeIndex,
+				}).Error("Could not request attestation to sign at slot")
+				for _, e := range entries {
+					fail(e.pubKey)
+				}
+				return
+			}
+			for _, e := range entries {
+				e.data = data
+			}
+		}(committeeIndex, entries)
+	}
+	wg.Wait()
+
+	signable := make([]*batchEntry, 0, len(pubKeys))
+	reqs := make([]*validatorpb.SignRequest, 0, len(pubKeys))
+	for _, entries := range committees {
+		for _, e := range entries {
+			if e.data == nil {
+				continue
+			}
+			domain, signingRoot, err := v.getDomainAndSigningRoot(ctx, e.data)
+			if err != nil {
+				log.WithError(err).Error("Could not get domain and signing root from attestation")
+				fail(e.pubKey)
+				continue
+			}
+			e.signingRoot = signingRoot
+			signable = append(signable, e)
+			reqs = append(reqs, &validatorpb.SignRequest{
+				PublicKey:       e.pubKey[:],
+				SigningRoot:     signingRoot[:],
+				SignatureDomain: domain.SignatureDomain,
+				Object:          &validatorpb.SignRequest_AttestationData{AttestationData: e.data},
+				SigningSlot:     slot,
+			})
+		}
+	}
+	if len(reqs) == 0 {
+		return
+	}
+	sigs, errs := v.keyManager.SignBatch(ctx, reqs)
+
+	sem := make(chan struct{}, maxConcurrentAttestationSubmissions)
+	for i, e := range signable {
+		v.sendDutyEvent(Signed, AttestationDuty, slot, e.pubKey, errs[i])
+		if errs[i] != nil {
+			log.WithError(errs[i]).WithField(
+				"pubKey", fmt.Sprintf("%#x", bytesutil.Trunc(e.pubKey[:])),
+			).WithField("slot", slot).Error("Could not sign attestation")
+			fail(e.pubKey)
+			continue
+		}
+		indexedAtt := &ethpb.IndexedAttestation{
+			AttestingIndices: []uint64{uint64(e.duty.ValidatorIndex)},
+			Data:             e.data,
+			Signature:        sigs[i].Marshal(),
+		}
+		sem <- struct{}{}
+		wg.Add(1)
+		go func(e *batchEntry, indexedAtt *ethpb.IndexedAttestation) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			v.proposeSignedAttestation(ctx, slot, e.pubKey, e.duty, indexedAtt, e.signingRoot, indexedAtt.Signature)
+		}(e, indexedAtt)
+	}
+	wg.Wait()
+}
+
 // Given the validator public key, this gets the validator assignment.
 func (v *validator) duty(pubKey [fieldparams.BLSPubkeyLength]byte) (*ethpb.DutiesResponse_Duty, error) {
 	if v.duties == nil {
@@ -169,6 +169,101 @@ func TestAttestToBlockHead_AttestsCorrectly(t *testing.T) {
 	require.LogsDoNotContain(t, hook, "Could not")
 }
 
+func TestSubmitAttestations_BatchesPerCommittee(t *testing.T) {
+	validator, m, _, finish := setup(t)
+	defer finish()
+	hook := logTest.NewGlobal()
+
+	keys := make([]bls.SecretKey, 3)
+	pubKeys := make([][fieldparams.BLSPubkeyLength]byte, len(keys))
+	km := &mockKeymanager{keysMap: make(map[[fieldparams.BLSPubkeyLength]byte]bls.SecretKey)}
+	for i := range keys {
+		key, err := bls.RandKey()
+		require.NoError(t, err)
+		keys[i] = key
+		pubKeys[i] = bytesutil.ToBytes48(key.PublicKey().Marshal())
+		km.keysMap[pubKeys[i]] = key
+	}
+	validator.keyManager = km
+	committee := []types.ValidatorIndex{1, 2, 3}
+	validator.duties = &ethpb.DutiesResponse{Duties: []*ethpb.DutiesResponse_Duty{
+		{PublicKey: pubKeys[0][:], CommitteeIndex: 5, Committee: committee, ValidatorIndex: 1},
+		{PublicKey: pubKeys[1][:], CommitteeIndex: 5, Committee: committee, ValidatorIndex: 2},
+		{PublicKey: pubKeys[2][:], CommitteeIndex: 6, Committee: []types.ValidatorIndex{4}, ValidatorIndex: 4},
+	}}
+
+	dataFor := func(committeeIndex types.CommitteeIndex) *ethpb.AttestationData {
+		return &ethpb.AttestationData{
+			Slot:            30,
+			CommitteeIndex:  committeeIndex,
+			BeaconBlockRoot: bytesutil.PadTo([]byte("A"), 32),
+			Target:          &ethpb.Checkpoint{Root: bytesutil.PadTo([]byte("B"), 32), Epoch: 3},
+			Source:          &ethpb.Checkpoint{Root: bytesutil.PadTo([]byte("C"), 32), Epoch: 2},
+		}
+	}
+	// Attestation data is requested once per committee.
+	m.validatorClient.EXPECT().GetAttestationData(
+		gomock.Any(), // ctx
+		gomock.AssignableToTypeOf(&ethpb.AttestationDataRequest{}),
+	).Times(2).DoAndReturn(func(_ context.Context, req *ethpb.AttestationDataRequest, _ ...grpc.CallOption) (*ethpb.AttestationData, error) {
+		return dataFor(req.CommitteeIndex), nil
+	})
+	m.validatorClient.EXPECT().DomainData(
+		gomock.Any(), // ctx
+		gomock.Any(), // epoch
+	).Return(&ethpb.DomainResponse{SignatureDomain: make([]byte, 32)}, nil /*err*/).AnyTimes()
+
+	var lock sync.Mutex
+	submitted := make(map[types.CommitteeIndex][]*ethpb.Attestation)
+	m.validatorClient.EXPECT().ProposeAttestation(
+		gomock.Any(), // ctx
+		gomock.AssignableToTypeOf(&ethpb.Attestation{}),
+	).Times(3).Do(func(_ context.Context, att *ethpb.Attestation, opts ...grpc.CallOption) {
+		lock.Lock()
+		defer lock.Unlock()
+		submitted[att.Data.CommitteeIndex] = append(submitted[att.Data.CommitteeIndex], att)
+	}).Return(&ethpb.AttestResponse{}, nil /* error */)
+
+	validator.SubmitAttestations(context.Background(), 30, pubKeys)
+	require.LogsDoNotContain(t, hook, "Could not")
+
+	require.Equal(t, 2, len(submitted[5]))
+	require.Equal(t, 1, len(submitted[6]))
+	for committeeIndex, atts := range submitted {
+		root, err := signing.ComputeSigningRoot(dataFor(committeeIndex), make([]byte, 32))
+		require.NoError(t, err)
+		for _, att := range atts {
+			var signer int
+			switch {
+			case committeeIndex == 6:
+				signer = 2
+			case att.AggregationBits.BitAt(0):
+				signer = 0
+			default:
+				signer = 1
+			}
+			assert.DeepEqual(t, keys[signer].Sign(root[:]).Marshal(), att.Signature)
+		}
+	}
+}
+
+func TestSubmitAttestations_CommitteeRequestFailure(t *testing.T) {
+	validator, m, validatorKey, finish := setup(t)
+	defer finish()
+	hook := logTest.NewGlobal()
+	pubKey := bytesutil.ToBytes48(validatorKey.PublicKey().Marshal())
+	validator.duties = &ethpb.DutiesResponse{Duties: []*ethpb.DutiesResponse_Duty{
+		{PublicKey: pubKey[:], CommitteeIndex: 5, Committee: []types.ValidatorIndex{1}, ValidatorIndex: 1},
+	}}
+	m.validatorClient.EXPECT().GetAttestationData(
+		gomock.Any(), // ctx
+		gomock.AssignableToTypeOf(&ethpb.AttestationDataRequest{}),
+	).Return(nil, errors.New("something went wrong"))
+
+	validator.SubmitAttestations(context.Background(), 30, [][fieldparams.BLSPubkeyLength]byte{pubKey})
+	require.LogsContain(t, hook, "Could not request attestation to sign at slot")
+}
+
 func TestAttestToBlockHead_BlocksDoubleAtt(t *testing.T) {
 	hook := logTest.NewGlobal()
 	validator, m, validatorKey, finish := setup(t)
@@ -0,0 +1,76 @@
+package client
+
+import (
+	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+)
+
+// EventType represents the kind of event sent on the validator client event feed.
+type EventType int
+
+const (
+	// DutiesUpdated is sent when the validator client receives new duties from the beacon node.
+	DutiesUpdated EventType = iota + 1
+	// Signed is sent after the validator client attempted to sign an object for a duty.
+	Signed
+	// SlashingProtectionRejected is sent when slashing protection refuses a signed object.
+	SlashingProtectionRejected
+	// Submitted is sent after the validator client attempted to submit a signed object to the beacon node.
+	Submitted
+	// KeysReloaded is sent when the validating keys of the validator client change.
+	KeysReloaded
+)
+
+// Duty names used in the event data.
+const (
+	BlockDuty                = "block"
+	AttestationDuty          = "attestation"
+	AggregateDuty            = "aggregate_and_proof"
+	SyncCommitteeMessageDuty = "sync_committee_message"
+	SyncContributionDuty     = "sync_committee_contribution"
+)
+
+// Event is the object sent on the validator client event feed.
+type Event struct {
+	Type EventType
+	Data interface{}
+}
+
+// DutiesUpdatedData is the data sent with DutiesUpdated events.
+type DutiesUpdatedData struct {
+	Epoch  types.Epoch
+	Duties *ethpb.DutiesResponse
+}
+
+// DutyEventData is the data sent with Signed, SlashingProtectionRejected and Submitted events.
+// Err is nil when the operation succeeded.
+type DutyEventData struct {
+	Duty      string
+	Slot      types.Slot
+	PublicKey [fieldparams.BLSPubkeyLength]byte
+	Err       error
+}
+
+// KeysReloadedData is the data sent with KeysReloaded events.
+type KeysReloadedData struct {
+	PublicKeys [][fieldparams.BLSPubkeyLength]byte
+}
+
+// sendEvent sends an event on the event feed, if the validator has one.
+func (v *validator) sendEvent(t EventType, data interface{}) {
+	if v.eventFeed == nil {
+		return
+	}
+	v.eventFeed.Send(&Event{Type: t, Data: data})
+}
+
+// sendDutyEvent sends an event describing the outcome of an operation performed for a duty.
+func (v *validator) sendDutyEvent(t EventType, duty string, slot types.Slot, pubKey [fieldparams.BLSPubkeyLength]byte, err error) {
+	v.sendEvent(t, &DutyEventData{
+		Duty:      duty,
+		Slot:      slot,
+		PublicKey: pubKey,
+		Err:       err,
+	})
+}
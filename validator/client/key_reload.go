@@ -14,6 +14,11 @@ func (v *validator) HandleKeyReload(ctx context.Context, newKeys [][fieldparams.
 	ctx, span := trace.StartSpan(ctx, "validator.HandleKeyReload")
 	defer span.End()
 
+	v.sendEvent(KeysReloaded, &KeysReloadedData{PublicKeys: newKeys})
+	if v.syncCommitteeState != nil {
+		v.syncCommitteeState.keysChanged()
+	}
+
 	statusRequestKeys := make([][]byte, len(newKeys))
 	for i := range newKeys {
 		statusRequestKeys[i] = newKeys[i][:]
@@ -38,10 +38,12 @@ func TestValidator_HandleKeyReload(t *testing.T) {
 		}
 		client := mock.NewMockBeaconNodeValidatorClient(ctrl)
 		v := validator{
-			validatorClient: client,
-			keyManager:      km,
-			genesisTime:     1,
+			validatorClient:    client,
+			keyManager:         km,
+			genesisTime:        1,
+			syncCommitteeState: newSyncCommitteeState(),
 		}
+		v.syncCommitteeState.indices[0] = nil
 
 		resp := testutil.GenerateMultipleValidatorStatusResponse([][]byte{inactivePubKey[:], activePubKey[:]})
 		resp.Statuses[0].Status = ethpb.ValidatorStatus_UNKNOWN_STATUS
@@ -58,6 +60,8 @@ func TestValidator_HandleKeyReload(t *testing.T) {
 		assert.Equal(t, true, anyActive)
 		assert.LogsContain(t, hook, "Waiting for deposit to be observed by beacon node")
 		assert.LogsContain(t, hook, "Validator activated")
+		// Sync committee duties are looked up again for the new keys.
+		assert.Equal(t, 0, len(v.syncCommitteeState.indices))
 	})
 
 	t.Run("no active", func(t *testing.T) {
@@ -212,6 +212,45 @@ var (
 			"pubkey",
 		},
 	)
+	// ValidatorSyncContributionsVec used to count sync committee contribution results by subnet.
+	ValidatorSyncContributionsVec = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "validator",
+			Name:      "sync_committee_contributions",
+			Help:      "Count the sync committee contributions by subnet and result: submitted, empty or failed",
+		},
+		[]string{
+			"subnet",
+			"result",
+		},
+	)
+	// ValidatorSyncMessageDelaySeconds used to track how far into the slot sync committee messages are submitted.
+	ValidatorSyncMessageDelaySeconds = promauto.NewHistogram(
+		prometheus.HistogramOpts{
+			Namespace: "validator",
+			Name:      "sync_committee_message_delay_seconds",
+			Help:      "Time from the start of the slot until the sync committee message was submitted",
+			Buckets:   []float64{1, 2, 3, 4, 6, 8, 12},
+		},
+	)
+	// ValidatorSyncContributionDelaySeconds used to track how far into the slot sync committee contributions are submitted.
+	ValidatorSyncContributionDelaySeconds = promauto.NewHistogram(
+		prometheus.HistogramOpts{
+			Namespace: "validator",
+			Name:      "sync_committee_contribution_delay_seconds",
+			Help:      "Time from the start of the slot until the sync committee contribution was submitted",
+			Buckets:   []float64{4, 6, 8, 9, 10, 12},
+		},
+	)
+	// ValidatorSyncSelectionProofsPrecomputeSeconds used to track the time spent signing sync selection proofs ahead of time.
+	ValidatorSyncSelectionProofsPrecomputeSeconds = promauto.NewHistogram(
+		prometheus.HistogramOpts{
+			Namespace: "validator",
+			Name:      "sync_committee_selection_proofs_precompute_seconds",
+			Help:      "Time spent signing the sync committee selection proofs of an epoch",
+			Buckets:   []float64{0.1, 0.5, 1, 2, 4, 8, 16, 32},
+		},
+	)
 )
 
 // LogValidatorGainsAndLosses logs important metrics related to this validator client's
@@ -197,14 +197,18 @@ func run(ctx context.Context, v iface.Validator) {
 				span.End()
 				continue
 			}
+			// Attestations of all keys are signed and submitted together.
+			attesters := make([][fieldparams.BLSPubkeyLength]byte, 0)
 			for pubKey, roles := range allRoles {
-				wg.Add(len(roles))
 				for _, role := range roles {
+					if role == iface.RoleAttester {
+						attesters = append(attesters, pubKey)
+						continue
+					}
+					wg.Add(1)
 					go func(role iface.ValidatorRole, pubKey [fieldparams.BLSPubkeyLength]byte) {
 						defer wg.Done()
 						switch role {
-						case iface.RoleAttester:
-							v.SubmitAttestation(slotCtx, slot, pubKey)
 						case iface.RoleProposer:
 							v.ProposeBlock(slotCtx, slot, pubKey)
 						case iface.RoleAggregator:
@@ -221,6 +225,13 @@ func run(ctx context.Context, v iface.Validator) {
 					}(role, pubKey)
 				}
 			}
+			if len(attesters) > 0 {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					v.SubmitAttestations(slotCtx, slot, attesters)
+				}()
+			}
 
 			// Wait for all processes to complete, then report span complete.
 			go func() {
@@ -19,6 +19,7 @@ import (
 	validator_service_config "github.com/prysmaticlabs/prysm/config/validator/service"
 	"github.com/prysmaticlabs/prysm/consensus-types/interfaces"
 	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	ethpbservice "github.com/prysmaticlabs/prysm/proto/eth/service"
 	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/validator/accounts/wallet"
 	"github.com/prysmaticlabs/prysm/validator/client/iface"
@@ -59,6 +60,7 @@ type ValidatorService struct {
 	maxCallRecvMsgSize    int
 	cancel                context.CancelFunc
 	walletInitializedFeed *event.Feed
+	eventFeed             *event.Feed
 	wallet                *wallet.Wallet
 	graffitiStruct        *graffiti.Graffiti
 	dataDir               string
@@ -118,6 +120,7 @@ func NewValidatorService(ctx context.Context, cfg *Config) (*ValidatorService, e
 		db:                    cfg.ValDB,
 		wallet:                cfg.Wallet,
 		walletInitializedFeed: cfg.WalletInitializedFeed,
+		eventFeed:             new(event.Feed),
 		useWeb:                cfg.UseWeb,
 		interopKeysConfig:     cfg.InteropKeysConfig,
 		graffitiStruct:        cfg.GraffitiStruct,
@@ -185,6 +188,8 @@ func (v *ValidatorService) Start() {
 		validatorClient:                ethpb.NewBeaconNodeValidatorClient(v.conn),
 		beaconClient:                   ethpb.NewBeaconChainClient(v.conn),
 		slashingProtectionClient:       ethpb.NewSlasherClient(v.conn),
+		syncCommitteeClient:            ethpbservice.NewBeaconValidatorClient(v.conn),
+		syncCommitteeState:             newSyncCommitteeState(),
 		node:                           ethpb.NewNodeClient(v.conn),
 		graffiti:                       v.graffiti,
 		logValidatorBalances:           v.logValidatorBalances,
@@ -201,6 +206,7 @@ func (v *ValidatorService) Start() {
 		wallet:                         v.wallet,
 		walletInitializedFeed:          v.walletInitializedFeed,
 		blockFeed:                      new(event.Feed),
+		eventFeed:                      v.eventFeed,
 		graffitiStruct:                 v.graffitiStruct,
 		graffitiOrderedIndex:           graffitiOrderedIndex,
 		eipImportBlacklistedPublicKeys: slashablePublicKeys,
@@ -246,6 +252,12 @@ func (v *ValidatorService) InteropKeysConfig() *local.InteropKeymanagerConfig {
 	return v.interopKeysConfig
 }
 
+// EventFeed returns the feed on which the validator client sends events about its duties,
+// signatures, slashing protection and key changes.
+func (v *ValidatorService) EventFeed() *event.Feed {
+	return v.eventFeed
+}
+
 func (v *ValidatorService) Keymanager() (keymanager.IKeymanager, error) {
 	return v.validator.Keymanager()
 }
@@ -14,6 +14,7 @@ import (
 	"github.com/prysmaticlabs/prysm/monitoring/tracing"
 	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
 	validatorpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/validator-client"
+	prysmTime "github.com/prysmaticlabs/prysm/time"
 	"github.com/prysmaticlabs/prysm/time/slots"
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
@@ -61,6 +62,7 @@ func (v *validator) SubmitSyncCommitteeMessage(ctx context.Context, slot types.S
 		},
 		SigningSlot: slot,
 	})
+	v.sendDutyEvent(Signed, SyncCommitteeMessageDuty, slot, pubKey, err)
 	if err != nil {
 		log.WithError(err).Error("Could not sign sync committee message")
 		return
@@ -72,10 +74,13 @@ func (v *validator) SubmitSyncCommitteeMessage(ctx context.Context, slot types.S
 		ValidatorIndex: duty.ValidatorIndex,
 		Signature:      sig.Marshal(),
 	}
-	if _, err := v.validatorClient.SubmitSyncMessage(ctx, msg); err != nil {
+	_, err = v.validatorClient.SubmitSyncMessage(ctx, msg)
+	v.sendDutyEvent(Submitted, SyncCommitteeMessageDuty, slot, pubKey, err)
+	if err != nil {
 		log.WithError(err).Error("Could not submit sync committee message")
 		return
 	}
+	ValidatorSyncMessageDelaySeconds.Observe(prysmTime.Since(slots.StartTime(v.genesisTime, slot)).Seconds())
 
 	log.WithFields(logrus.Fields{
 		"slot":           msg.Slot,
@@ -128,6 +133,7 @@ func (v *validator) SubmitSignedContributionAndProof(ctx context.Context, slot t
 		}
 		subCommitteeSize := params.BeaconConfig().SyncCommitteeSize / params.BeaconConfig().SyncCommitteeSubnetCount
 		subnet := uint64(comIdx) / subCommitteeSize
+		subnetLabel := fmt.Sprintf("%d", subnet)
 		contribution, err := v.validatorClient.GetSyncCommitteeContribution(ctx, &ethpb.SyncCommitteeContributionRequest{
 			Slot:      slot,
 			PublicKey: pubKey[:],
@@ -135,9 +141,11 @@ func (v *validator) SubmitSignedContributionAndProof(ctx context.Context, slot t
 		})
 		if err != nil {
 			log.Errorf("Could not get sync committee contribution: %v", err)
+			ValidatorSyncContributionsVec.WithLabelValues(subnetLabel, "failed").Inc()
 			return
 		}
 		if contribution.AggregationBits.Count() == 0 {
+			ValidatorSyncContributionsVec.WithLabelValues(subnetLabel, "empty").Inc()
 			log.WithFields(logrus.Fields{
 				"slot":   slot,
 				"pubkey": pubKey,
@@ -152,18 +160,25 @@ func (v *validator) SubmitSignedContributionAndProof(ctx context.Context, slot t
 			SelectionProof:  selectionProofs[i],
 		}
 		sig, err := v.signContributionAndProof(ctx, pubKey, contributionAndProof, slot)
+		v.sendDutyEvent(Signed, SyncContributionDuty, slot, pubKey, err)
 		if err != nil {
 			log.Errorf("Could not sign contribution and proof: %v", err)
+			ValidatorSyncContributionsVec.WithLabelValues(subnetLabel, "failed").Inc()
 			return
 		}
 
-		if _, err := v.validatorClient.SubmitSignedContributionAndProof(ctx, &ethpb.SignedContributionAndProof{
+		_, err = v.validatorClient.SubmitSignedContributionAndProof(ctx, &ethpb.SignedContributionAndProof{
 			Message:   contributionAndProof,
 			Signature: sig,
-		}); err != nil {
+		})
+		v.sendDutyEvent(Submitted, SyncContributionDuty, slot, pubKey, err)
+		if err != nil {
 			log.Errorf("Could not submit signed contribution and proof: %v", err)
+			ValidatorSyncContributionsVec.WithLabelValues(subnetLabel, "failed").Inc()
 			return
 		}
+		ValidatorSyncContributionsVec.WithLabelValues(subnetLabel, "submitted").Inc()
+		ValidatorSyncContributionDelaySeconds.Observe(prysmTime.Since(slots.StartTime(v.genesisTime, slot)).Seconds())
 
 		log.WithFields(logrus.Fields{
 			"slot":              contributionAndProof.Contribution.Slot,
@@ -176,6 +191,7 @@ func (v *validator) SubmitSignedContributionAndProof(ctx context.Context, slot t
 }
 
 // Signs and returns selection proofs per validator for slot and pub key.
+// Proofs which were precomputed ahead of time are reused.
 func (v *validator) selectionProofs(ctx context.Context, slot types.Slot, pubKey [fieldparams.BLSPubkeyLength]byte, indexRes *ethpb.SyncSubcommitteeIndexResponse) ([][]byte, error) {
 	selectionProofs := make([][]byte, len(indexRes.Indices))
 	cfg := params.BeaconConfig()
@@ -184,6 +200,12 @@ func (v *validator) selectionProofs(ctx context.Context, slot types.Slot, pubKey
 	for i, index := range indexRes.Indices {
 		subSize := size / subCount
 		subnet := uint64(index) / subSize
+		if v.syncCommitteeState != nil {
+			if proof, ok := v.syncCommitteeState.selectionProof(slot, subnet, pubKey); ok {
+				selectionProofs[i] = proof
+				continue
+			}
+		}
 		selectionProof, err := v.signSyncSelectionData(ctx, pubKey, subnet, slot)
 		if err != nil {
 			return nil, err
@@ -0,0 +1,282 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/config/params"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	ethpbv2 "github.com/prysmaticlabs/prysm/proto/eth/v2"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/time/slots"
+	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// The number of epochs before the start of the next sync committee period at which the validator
+// client fetches its sync committee duties for that period and subscribes to the matching subnets,
+// giving the beacon node time to find peers on them.
+const syncCommitteeSubscriptionLeadEpochs = 4
+
+// syncCommitteeClient is the subset of the standard beacon validator API used to look up
+// sync committee duties ahead of time and to subscribe to sync committee subnets.
+type syncCommitteeClient interface {
+	GetSyncCommitteeDuties(ctx context.Context, in *ethpbv2.SyncCommitteeDutiesRequest, opts ...grpc.CallOption) (*ethpbv2.SyncCommitteeDutiesResponse, error)
+	SubmitSyncCommitteeSubscription(ctx context.Context, in *ethpbv2.SubmitSyncCommitteeSubscriptionsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type syncSelectionProofKey struct {
+	slot   types.Slot
+	subnet uint64
+	pubKey [fieldparams.BLSPubkeyLength]byte
+}
+
+// syncCommitteeState tracks the sync committee membership of the validating keys per period,
+// together with the selection proofs which were signed ahead of time.
+type syncCommitteeState struct {
+	sync.RWMutex
+	// Sync committee indices of the validating keys, per sync committee period.
+	indices map[uint64]map[[fieldparams.BLSPubkeyLength]byte][]uint64
+	// Epochs for which selection proofs have been precomputed.
+	precomputed map[types.Epoch]bool
+	proofs      map[syncSelectionProofKey][]byte
+	// Epochs for which sync committee duties are being prepared.
+	preparing map[types.Epoch]bool
+}
+
+func newSyncCommitteeState() *syncCommitteeState {
+	return &syncCommitteeState{
+		indices:     make(map[uint64]map[[fieldparams.BLSPubkeyLength]byte][]uint64),
+		precomputed: make(map[types.Epoch]bool),
+		proofs:      make(map[syncSelectionProofKey][]byte),
+		preparing:   make(map[types.Epoch]bool),
+	}
+}
+
+// startPreparing marks the sync committee duties of the epoch as being prepared. It returns false if
+// they already are.
+func (s *syncCommitteeState) startPreparing(epoch types.Epoch) bool {
+	s.Lock()
+	defer s.Unlock()
+	if s.preparing[epoch] {
+		return false
+	}
+	s.preparing[epoch] = true
+	return true
+}
+
+// donePreparing marks the sync committee duties of the epoch as no longer being prepared.
+func (s *syncCommitteeState) donePreparing(epoch types.Epoch) {
+	s.Lock()
+	defer s.Unlock()
+	delete(s.preparing, epoch)
+}
+
+// selectionProof returns a precomputed sync committee selection proof, if there is one.
+func (s *syncCommitteeState) selectionProof(slot types.Slot, subnet uint64, pubKey [fieldparams.BLSPubkeyLength]byte) ([]byte, bool) {
+	s.RLock()
+	defer s.RUnlock()
+	proof, ok := s.proofs[syncSelectionProofKey{slot: slot, subnet: subnet, pubKey: pubKey}]
+	return proof, ok
+}
+
+// keysChanged forgets the sync committee membership looked up for the previous set of validating
+// keys, so that the duties of the new set are fetched and its subnets subscribed to on the next
+// duties update.
+func (s *syncCommitteeState) keysChanged() {
+	s.Lock()
+	defer s.Unlock()
+	s.indices = make(map[uint64]map[[fieldparams.BLSPubkeyLength]byte][]uint64)
+	s.precomputed = make(map[types.Epoch]bool)
+}
+
+// prune removes all data which is no longer needed at the given epoch.
+func (s *syncCommitteeState) prune(epoch types.Epoch) {
+	s.Lock()
+	defer s.Unlock()
+	period := slots.SyncCommitteePeriod(epoch)
+	for p := range s.indices {
+		if p < period {
+			delete(s.indices, p)
+		}
+	}
+	for e := range s.precomputed {
+		if e < epoch {
+			delete(s.precomputed, e)
+		}
+	}
+	for k := range s.proofs {
+		if slots.ToEpoch(k.slot) < epoch {
+			delete(s.proofs, k)
+		}
+	}
+}
+
+// prepareSyncCommitteeDuties prepares the sync committee duties of the upcoming epochs. Shortly before
+// a sync committee period starts, the sync committee duties for that period are fetched and the beacon
+// node is asked to subscribe to the subnets of the validating keys. Selection proofs for the next epoch
+// are signed ahead of time, so that the aggregation duty does not have to wait for them.
+func (v *validator) prepareSyncCommitteeDuties(ctx context.Context, epoch types.Epoch, duties *ethpb.DutiesResponse) {
+	ctx, span := trace.StartSpan(ctx, "validator.prepareSyncCommitteeDuties")
+	defer span.End()
+
+	if v.syncCommitteeClient == nil || v.syncCommitteeState == nil || duties == nil {
+		return
+	}
+	if epoch+1 < params.BeaconConfig().AltairForkEpoch {
+		return
+	}
+	v.syncCommitteeState.prune(epoch)
+
+	indices := make([]types.ValidatorIndex, 0, len(duties.CurrentEpochDuties))
+	for _, d := range duties.CurrentEpochDuties {
+		if d.Status == ethpb.ValidatorStatus_ACTIVE || d.Status == ethpb.ValidatorStatus_EXITING {
+			indices = append(indices, d.ValidatorIndex)
+		}
+	}
+	if len(indices) == 0 {
+		return
+	}
+
+	periods := []uint64{slots.SyncCommitteePeriod(epoch)}
+	nextPeriodStart := epoch - epoch%params.BeaconConfig().EpochsPerSyncCommitteePeriod + params.BeaconConfig().EpochsPerSyncCommitteePeriod
+	if epoch+syncCommitteeSubscriptionLeadEpochs >= nextPeriodStart {
+		periods = append(periods, slots.SyncCommitteePeriod(nextPeriodStart))
+	}
+	for _, period := range periods {
+		if err := v.subscribeToSyncCommitteePeriod(ctx, period, indices); err != nil {
+			log.WithError(err).WithField("period", period).Error("Could not subscribe to sync committee subnets")
+		}
+	}
+
+	for _, e := range []types.Epoch{epoch, epoch + 1} {
+		if err := v.precomputeSyncSelectionProofs(ctx, e); err != nil {
+			log.WithError(err).WithField("epoch", e).Error("Could not precompute sync committee selection proofs")
+		}
+	}
+}
+
+// subscribeToSyncCommitteePeriod fetches the sync committee duties of the validators for the given
+// period and subscribes to the subnets of their sync committee positions until the end of the period.
+// This is done once per period, or again after the validating keys changed.
+func (v *validator) subscribeToSyncCommitteePeriod(ctx context.Context, period uint64, indices []types.ValidatorIndex) error {
+	v.syncCommitteeState.RLock()
+	_, done := v.syncCommitteeState.indices[period]
+	v.syncCommitteeState.RUnlock()
+	if done {
+		return nil
+	}
+
+	periodStart := types.Epoch(period) * params.BeaconConfig().EpochsPerSyncCommitteePeriod
+	resp, err := v.syncCommitteeClient.GetSyncCommitteeDuties(ctx, &ethpbv2.SyncCommitteeDutiesRequest{
+		Epoch: periodStart,
+		Index: indices,
+	})
+	if err != nil {
+		return err
+	}
+	members := make(map[[fieldparams.BLSPubkeyLength]byte][]uint64, len(resp.Data))
+	subs := make([]*ethpbv2.SyncCommitteeSubscription, 0, len(resp.Data))
+	for _, d := range resp.Data {
+		if len(d.ValidatorSyncCommitteeIndices) == 0 {
+			continue
+		}
+		members[bytesutil.ToBytes48(d.Pubkey)] = d.ValidatorSyncCommitteeIndices
+		subs = append(subs, &ethpbv2.SyncCommitteeSubscription{
+			ValidatorIndex:       d.ValidatorIndex,
+			SyncCommitteeIndices: d.ValidatorSyncCommitteeIndices,
+			UntilEpoch:           periodStart + params.BeaconConfig().EpochsPerSyncCommitteePeriod,
+		})
+	}
+	if len(subs) > 0 {
+		if _, err := v.syncCommitteeClient.SubmitSyncCommitteeSubscription(ctx, &ethpbv2.SubmitSyncCommitteeSubscriptionsRequest{
+			Data: subs,
+		}); err != nil {
+			return err
+		}
+		log.WithFields(logrus.Fields{
+			"period":     period,
+			"validators": len(subs),
+		}).Info("Subscribed to sync committee subnets")
+	}
+
+	v.syncCommitteeState.Lock()
+	v.syncCommitteeState.indices[period] = members
+	v.syncCommitteeState.Unlock()
+	return nil
+}
+
+// precomputeSyncSelectionProofs signs the sync committee selection proofs for every slot of the epoch
+// and every subnet of the validating keys which are part of the sync committee. Keys are signed
+// for concurrently.
+func (v *validator) precomputeSyncSelectionProofs(ctx context.Context, epoch types.Epoch) error {
+	ctx, span := trace.StartSpan(ctx, "validator.precomputeSyncSelectionProofs")
+	defer span.End()
+
+	if epoch < params.BeaconConfig().AltairForkEpoch {
+		return nil
+	}
+	v.syncCommitteeState.RLock()
+	members, ok := v.syncCommitteeState.indices[slots.SyncCommitteePeriod(epoch)]
+	done := v.syncCommitteeState.precomputed[epoch]
+	v.syncCommitteeState.RUnlock()
+	if !ok || done || len(members) == 0 {
+		return nil
+	}
+	startSlot, err := slots.EpochStart(epoch)
+	if err != nil {
+		return err
+	}
+
+	start := time.Now()
+	subnetSize := params.BeaconConfig().SyncCommitteeSize / params.BeaconConfig().SyncCommitteeSubnetCount
+	var wg sync.WaitGroup
+	errs := make(chan error, len(members))
+	for pubKey, committeeIndices := range members {
+		subnets := make(map[uint64]bool)
+		for _, idx := range committeeIndices {
+			subnets[idx/subnetSize] = true
+		}
+		wg.Add(1)
+		go func(pubKey [fieldparams.BLSPubkeyLength]byte, subnets map[uint64]bool) {
+			defer wg.Done()
+			proofs := make(map[syncSelectionProofKey][]byte)
+			for slot := startSlot; slot < startSlot+params.BeaconConfig().SlotsPerEpoch; slot++ {
+				for subnet := range subnets {
+					proof, err := v.signSyncSelectionData(ctx, pubKey, subnet, slot)
+					if err != nil {
+						errs <- fmt.Errorf("could not sign selection proof for %#x: %w", bytesutil.Trunc(pubKey[:]), err)
+						return
+					}
+					proofs[syncSelectionProofKey{slot: slot, subnet: subnet, pubKey: pubKey}] = proof
+				}
+			}
+			v.syncCommitteeState.Lock()
+			for k, proof := range proofs {
+				v.syncCommitteeState.proofs[k] = proof
+			}
+			v.syncCommitteeState.Unlock()
+		}(pubKey, subnets)
+	}
+	wg.Wait()
+	close(errs)
+	if err, ok := <-errs; ok {
+		return err
+	}
+
+	v.syncCommitteeState.Lock()
+	v.syncCommitteeState.precomputed[epoch] = true
+	v.syncCommitteeState.Unlock()
+	ValidatorSyncSelectionProofsPrecomputeSeconds.Observe(time.Since(start).Seconds())
+	log.WithFields(logrus.Fields{
+		"epoch":      epoch,
+		"validators": len(members),
+	}).Debug("Precomputed sync committee selection proofs")
+	return nil
+}
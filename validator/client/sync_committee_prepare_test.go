@@ -0,0 +1,220 @@
+package client
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/config/params"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	ethpbv2 "github.com/prysmaticlabs/prysm/proto/eth/v2"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	mock2 "github.com/prysmaticlabs/prysm/testing/mock"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type fakeSyncCommitteeClient struct {
+	duties        map[types.Epoch][]*ethpbv2.SyncCommitteeDuty
+	dutyRequests  []*ethpbv2.SyncCommitteeDutiesRequest
+	subscriptions []*ethpbv2.SubmitSyncCommitteeSubscriptionsRequest
+}
+
+func (f *fakeSyncCommitteeClient) GetSyncCommitteeDuties(
+	_ context.Context, in *ethpbv2.SyncCommitteeDutiesRequest, _ ...grpc.CallOption,
+) (*ethpbv2.SyncCommitteeDutiesResponse, error) {
+	f.dutyRequests = append(f.dutyRequests, in)
+	return &ethpbv2.SyncCommitteeDutiesResponse{Data: f.duties[in.Epoch]}, nil
+}
+
+func (f *fakeSyncCommitteeClient) SubmitSyncCommitteeSubscription(
+	_ context.Context, in *ethpbv2.SubmitSyncCommitteeSubscriptionsRequest, _ ...grpc.CallOption,
+) (*emptypb.Empty, error) {
+	f.subscriptions = append(f.subscriptions, in)
+	return &emptypb.Empty{}, nil
+}
+
+// blockingSyncCommitteeClient blocks duty requests until it is released or their context is done.
+type blockingSyncCommitteeClient struct {
+	sync.Mutex
+	requests int
+	ctxErr   error
+	started  chan struct{}
+	release  chan struct{}
+}
+
+func (b *blockingSyncCommitteeClient) GetSyncCommitteeDuties(
+	ctx context.Context, _ *ethpbv2.SyncCommitteeDutiesRequest, _ ...grpc.CallOption,
+) (*ethpbv2.SyncCommitteeDutiesResponse, error) {
+	b.Lock()
+	b.requests++
+	b.Unlock()
+	b.started <- struct{}{}
+	select {
+	case <-b.release:
+	case <-ctx.Done():
+	}
+	b.Lock()
+	b.ctxErr = ctx.Err()
+	b.Unlock()
+	return &ethpbv2.SyncCommitteeDutiesResponse{}, nil
+}
+
+func (*blockingSyncCommitteeClient) SubmitSyncCommitteeSubscription(
+	context.Context, *ethpbv2.SubmitSyncCommitteeSubscriptionsRequest, ...grpc.CallOption,
+) (*emptypb.Empty, error) {
+	return &emptypb.Empty{}, nil
+}
+
+func TestUpdateDuties_PreparesSyncCommitteeDutiesOnce(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig().Copy()
+	cfg.AltairForkEpoch = 0
+	params.OverrideBeaconConfig(cfg)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mock2.NewMockBeaconNodeValidatorClient(ctrl)
+	resp := &ethpb.DutiesResponse{CurrentEpochDuties: []*ethpb.DutiesResponse_Duty{
+		{ValidatorIndex: 7, Status: ethpb.ValidatorStatus_ACTIVE},
+	}}
+	client.EXPECT().GetDuties(gomock.Any(), gomock.Any()).Return(resp, nil).Times(2)
+	client.EXPECT().SubscribeCommitteeSubnets(gomock.Any(), gomock.Any()).Return(&emptypb.Empty{}, nil).AnyTimes()
+	client.EXPECT().DomainData(gomock.Any(), gomock.Any()).
+		Return(&ethpb.DomainResponse{SignatureDomain: make([]byte, 32)}, nil).AnyTimes()
+	syncClient := &blockingSyncCommitteeClient{started: make(chan struct{}, 2), release: make(chan struct{})}
+	v := validator{
+		genesisTime:         uint64(time.Now().Unix()),
+		keyManager:          &mockKeymanager{},
+		validatorClient:     client,
+		syncCommitteeClient: syncClient,
+		syncCommitteeState:  newSyncCommitteeState(),
+	}
+
+	slot := params.BeaconConfig().SlotsPerEpoch
+	require.NoError(t, v.UpdateDuties(context.Background(), slot))
+	<-syncClient.started
+	// The preparation outlives the call which started it, and is not started again while it runs.
+	require.NoError(t, v.UpdateDuties(context.Background(), slot))
+	close(syncClient.release)
+	require.Equal(t, true, waitFor(func() bool { return v.syncCommitteeState.startPreparing(1) }))
+
+	syncClient.Lock()
+	defer syncClient.Unlock()
+	assert.Equal(t, 1, syncClient.requests)
+	assert.NoError(t, syncClient.ctxErr)
+}
+
+// waitFor polls the condition for up to a second.
+func waitFor(cond func() bool) bool {
+	for i := 0; i < 100; i++ {
+		if cond() {
+			return true
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	return false
+}
+
+func TestPrepareSyncCommitteeDuties(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig().Copy()
+	cfg.AltairForkEpoch = 0
+	cfg.EpochsPerSyncCommitteePeriod = 8
+	params.OverrideBeaconConfig(cfg)
+
+	validator, m, validatorKey, finish := setup(t)
+	defer finish()
+	pubKey := [fieldparams.BLSPubkeyLength]byte{}
+	copy(pubKey[:], validatorKey.PublicKey().Marshal())
+
+	subnetSize := cfg.SyncCommitteeSize / cfg.SyncCommitteeSubnetCount
+	fake := &fakeSyncCommitteeClient{duties: map[types.Epoch][]*ethpbv2.SyncCommitteeDuty{
+		// Not part of the current sync committee, but part of the next one on two subnets.
+		0: {{Pubkey: pubKey[:], ValidatorIndex: 7}},
+		8: {{Pubkey: pubKey[:], ValidatorIndex: 7, ValidatorSyncCommitteeIndices: []uint64{1, subnetSize + 1}}},
+	}}
+	validator.syncCommitteeClient = fake
+	validator.syncCommitteeState = newSyncCommitteeState()
+	duties := &ethpb.DutiesResponse{CurrentEpochDuties: []*ethpb.DutiesResponse_Duty{
+		{PublicKey: pubKey[:], ValidatorIndex: 7, Status: ethpb.ValidatorStatus_ACTIVE},
+	}}
+
+	// Far enough from the next period, only the current period is looked up.
+	validator.prepareSyncCommitteeDuties(context.Background(), 1, duties)
+	require.Equal(t, 1, len(fake.dutyRequests))
+	assert.Equal(t, types.Epoch(0), fake.dutyRequests[0].Epoch)
+	assert.DeepEqual(t, []types.ValidatorIndex{7}, fake.dutyRequests[0].Index)
+	assert.Equal(t, 0, len(fake.subscriptions))
+
+	// Close to the next period, its duties are fetched, subnets are subscribed and the proofs
+	// for the first epoch of the period are signed ahead of time.
+	m.validatorClient.EXPECT().DomainData(gomock.Any(), gomock.Any()).
+		Return(&ethpb.DomainResponse{SignatureDomain: make([]byte, 32)}, nil).AnyTimes()
+	validator.prepareSyncCommitteeDuties(context.Background(), 7, duties)
+	require.Equal(t, 2, len(fake.dutyRequests))
+	assert.Equal(t, types.Epoch(8), fake.dutyRequests[1].Epoch)
+	require.Equal(t, 1, len(fake.subscriptions))
+	assert.DeepEqual(t, []*ethpbv2.SyncCommitteeSubscription{{
+		ValidatorIndex:       7,
+		SyncCommitteeIndices: []uint64{1, subnetSize + 1},
+		UntilEpoch:           16,
+	}}, fake.subscriptions[0].Data)
+
+	startSlot := 8 * cfg.SlotsPerEpoch
+	for slot := startSlot; slot < startSlot+cfg.SlotsPerEpoch; slot++ {
+		for _, subnet := range []uint64{0, 1} {
+			_, ok := validator.syncCommitteeState.selectionProof(slot, subnet, pubKey)
+			assert.Equal(t, true, ok)
+		}
+		_, ok := validator.syncCommitteeState.selectionProof(slot, 2, pubKey)
+		assert.Equal(t, false, ok)
+	}
+
+	// The precomputed proofs are used instead of signing again.
+	proofs, err := validator.selectionProofs(context.Background(), startSlot, pubKey, &ethpb.SyncSubcommitteeIndexResponse{
+		Indices: []types.CommitteeIndex{1, types.CommitteeIndex(subnetSize + 1)},
+	})
+	require.NoError(t, err)
+	want, err := validator.signSyncSelectionData(context.Background(), pubKey, 1, startSlot)
+	require.NoError(t, err)
+	assert.DeepEqual(t, want, proofs[1])
+
+	// Duties for a period are only fetched once.
+	validator.prepareSyncCommitteeDuties(context.Background(), 7, duties)
+	assert.Equal(t, 2, len(fake.dutyRequests))
+	assert.Equal(t, 1, len(fake.subscriptions))
+
+	// A key joining the current sync committee is subscribed once the keys changed.
+	fake.duties[0] = []*ethpbv2.SyncCommitteeDuty{{Pubkey: pubKey[:], ValidatorIndex: 7, ValidatorSyncCommitteeIndices: []uint64{2}}}
+	validator.syncCommitteeState.keysChanged()
+	validator.prepareSyncCommitteeDuties(context.Background(), 7, duties)
+	assert.Equal(t, 4, len(fake.dutyRequests))
+	require.Equal(t, 3, len(fake.subscriptions))
+	assert.DeepEqual(t, []uint64{2}, fake.subscriptions[1].Data[0].SyncCommitteeIndices)
+}
+
+func TestSyncCommitteeState_Prune(t *testing.T) {
+	s := newSyncCommitteeState()
+	pubKey := [fieldparams.BLSPubkeyLength]byte{1}
+	s.indices[0] = nil
+	s.indices[1] = nil
+	s.precomputed[1] = true
+	s.precomputed[params.BeaconConfig().EpochsPerSyncCommitteePeriod] = true
+	s.proofs[syncSelectionProofKey{slot: 1, pubKey: pubKey}] = []byte{1}
+	nextPeriodSlot := types.Slot(params.BeaconConfig().EpochsPerSyncCommitteePeriod) * params.BeaconConfig().SlotsPerEpoch
+	s.proofs[syncSelectionProofKey{slot: nextPeriodSlot, pubKey: pubKey}] = []byte{2}
+
+	s.prune(params.BeaconConfig().EpochsPerSyncCommitteePeriod)
+	assert.Equal(t, 1, len(s.indices))
+	assert.Equal(t, 1, len(s.precomputed))
+	_, ok := s.selectionProof(1, 0, pubKey)
+	assert.Equal(t, false, ok)
+	_, ok = s.selectionProof(nextPeriodSlot, 0, pubKey)
+	assert.Equal(t, true, ok)
+}
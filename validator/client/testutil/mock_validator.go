@@ -155,6 +155,12 @@ func (fv *FakeValidator) RolesAt(_ context.Context, slot types.Slot) (map[[field
 	return vr, nil
 }
 
+// SubmitAttestations for mocking.
+func (fv *FakeValidator) SubmitAttestations(_ context.Context, slot types.Slot, _ [][fieldparams.BLSPubkeyLength]byte) {
+	fv.AttestToBlockHeadCalled = true
+	fv.AttestToBlockHeadArg1 = uint64(slot)
+}
+
 // SubmitAttestation for mocking.
 func (fv *FakeValidator) SubmitAttestation(_ context.Context, slot types.Slot, _ [fieldparams.BLSPubkeyLength]byte) {
 	fv.AttestToBlockHeadCalled = true
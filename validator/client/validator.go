@@ -82,6 +82,9 @@ type validator struct {
 	highestValidSlot                   types.Slot
 	genesisTime                        uint64
 	blockFeed                          *event.Feed
+	eventFeed                          *event.Feed
+	syncCommitteeClient                syncCommitteeClient
+	syncCommitteeState                 *syncCommitteeState
 	interopKeysConfig                  *local.InteropKeymanagerConfig
 	wallet                             *wallet.Wallet
 	graffitiStruct                     *graffiti.Graffiti
@@ -556,7 +559,9 @@ func (v *validator) UpdateDuties(ctx context.Context, slot types.Slot) error {
 	if err != nil {
 		return err
 	}
-	ctx, cancel := context.WithDeadline(ctx, v.SlotDeadline(ss))
+	validatorCtx := ctx
+	deadline := v.SlotDeadline(ss)
+	ctx, cancel := context.WithDeadline(ctx, deadline)
 	defer cancel()
 	ctx, span := trace.StartSpan(ctx, "validator.UpdateAssignments")
 	defer span.End()
@@ -566,18 +571,29 @@ func (v *validator) UpdateDuties(ctx context.Context, slot types.Slot) error {
 		return err
 	}
 
-	// Filter out the slashable public keys from the duties request.
+	disabledKeys, err := v.disabledPublicKeys(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Filter out the slashable and disabled public keys from the duties request.
 	filteredKeys := make([][fieldparams.BLSPubkeyLength]byte, 0, len(validatingKeys))
 	v.slashableKeysLock.RLock()
 	for _, pubKey := range validatingKeys {
-		if ok := v.eipImportBlacklistedPublicKeys[pubKey]; !ok {
-			filteredKeys = append(filteredKeys, pubKey)
-		} else {
+		if ok := v.eipImportBlacklistedPublicKeys[pubKey]; ok {
 			log.WithField(
 				"publicKey", fmt.Sprintf("%#x", bytesutil.Trunc(pubKey[:])),
 			).Warn("Not including slashable public key from slashing protection import " +
 				"in request to update validator duties")
+			continue
 		}
+		if disabledKeys[pubKey] {
+			log.WithField(
+				"publicKey", fmt.Sprintf("%#x", bytesutil.Trunc(pubKey[:])),
+			).Warn("Not including disabled public key in request to update validator duties")
+			continue
+		}
+		filteredKeys = append(filteredKeys, pubKey)
 	}
 	v.slashableKeysLock.RUnlock()
 
@@ -596,6 +612,7 @@ func (v *validator) UpdateDuties(ctx context.Context, slot types.Slot) error {
 
 	v.duties = resp
 	v.logDuties(slot, v.duties.CurrentEpochDuties)
+	v.sendEvent(DutiesUpdated, &DutiesUpdatedData{Epoch: req.Epoch, Duties: resp})
 
 	// Non-blocking call for beacon node to start subscriptions for aggregators.
 	go func() {
@@ -603,10 +620,37 @@ func (v *validator) UpdateDuties(ctx context.Context, slot types.Slot) error {
 			log.WithError(err).Error("Failed to subscribe to subnets")
 		}
 	}()
+	// Sync committee duties are prepared in the background until the end of the epoch, unless
+	// they are still being prepared for the epoch from a previous call.
+	if v.syncCommitteeState != nil && v.syncCommitteeState.startPreparing(req.Epoch) {
+		prepareCtx, prepareCancel := context.WithDeadline(validatorCtx, deadline)
+		go func() {
+			defer prepareCancel()
+			defer v.syncCommitteeState.donePreparing(req.Epoch)
+			v.prepareSyncCommitteeDuties(prepareCtx, req.Epoch, resp)
+		}()
+	}
 
 	return nil
 }
 
+// disabledPublicKeys returns the set of public keys which were disabled by the user
+// and must not perform any duties. Their slashing protection history is kept intact.
+func (v *validator) disabledPublicKeys(ctx context.Context) (map[[fieldparams.BLSPubkeyLength]byte]bool, error) {
+	disabled := make(map[[fieldparams.BLSPubkeyLength]byte]bool)
+	if v.db == nil {
+		return disabled, nil
+	}
+	keys, err := v.db.DisabledPublicKeys(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read disabled public keys")
+	}
+	for _, k := range keys {
+		disabled[k] = true
+	}
+	return disabled, nil
+}
+
 // subscribeToSubnets iterates through each validator duty, signs each slot, and asks beacon node
 // to eagerly subscribe to subnets so that the aggregator has attestations to aggregate.
 func (v *validator) subscribeToSubnets(ctx context.Context, res *ethpb.DutiesResponse) error {
@@ -677,6 +721,12 @@ func (v *validator) subscribeToSubnets(ctx context.Context, res *ethpb.DutiesRes
 // validator is known to not have a roles at the slot. Returns UNKNOWN if the
 // validator assignments are unknown. Otherwise returns a valid ValidatorRole map.
 func (v *validator) RolesAt(ctx context.Context, slot types.Slot) (map[[fieldparams.BLSPubkeyLength]byte][]iface.ValidatorRole, error) {
+	// Keys may be disabled in the middle of an epoch, after duties were fetched,
+	// so we check again before assigning any roles.
+	disabledKeys, err := v.disabledPublicKeys(ctx)
+	if err != nil {
+		return nil, err
+	}
 	rolesAt := make(map[[fieldparams.BLSPubkeyLength]byte][]iface.ValidatorRole)
 	for validator, duty := range v.duties.Duties {
 		var roles []iface.ValidatorRole
@@ -684,6 +734,9 @@ func (v *validator) RolesAt(ctx context.Context, slot types.Slot) (map[[fieldpar
 		if duty == nil {
 			continue
 		}
+		if disabledKeys[bytesutil.ToBytes48(duty.PublicKey)] {
+			continue
+		}
 		if len(duty.ProposerSlots) > 0 {
 			for _, proposerSlot := range duty.ProposerSlots {
 				if proposerSlot != 0 && proposerSlot == slot {
@@ -95,6 +95,15 @@ func (m *mockKeymanager) Sign(_ context.Context, req *validatorpb.SignRequest) (
 	return sig, nil
 }
 
+func (m *mockKeymanager) SignBatch(ctx context.Context, reqs []*validatorpb.SignRequest) ([]bls.Signature, []error) {
+	sigs := make([]bls.Signature, len(reqs))
+	errs := make([]error, len(reqs))
+	for i, req := range reqs {
+		sigs[i], errs[i] = m.Sign(ctx, req)
+	}
+	return sigs, errs
+}
+
 func (m *mockKeymanager) SubscribeAccountChanges(pubKeysChan chan [][fieldparams.BLSPubkeyLength]byte) event.Subscription {
 	if m.accountsChangedFeed == nil {
 		m.accountsChangedFeed = &event.Feed{}
@@ -619,6 +628,34 @@ func TestUpdateDuties_OK(t *testing.T) {
 	assert.Equal(t, resp.Duties[0].ValidatorIndex, v.duties.Duties[0].ValidatorIndex, "Unexpected validator assignments")
 }
 
+func TestUpdateDuties_SendsEvent(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mock2.NewMockBeaconNodeValidatorClient(ctrl)
+
+	slot := params.BeaconConfig().SlotsPerEpoch
+	resp := &ethpb.DutiesResponse{}
+	v := validator{
+		keyManager:      &mockKeymanager{},
+		validatorClient: client,
+		eventFeed:       new(event.Feed),
+	}
+	client.EXPECT().GetDuties(gomock.Any(), gomock.Any()).Return(resp, nil)
+	client.EXPECT().SubscribeCommitteeSubnets(gomock.Any(), gomock.Any()).Return(&emptypb.Empty{}, nil).AnyTimes()
+
+	events := make(chan *Event, 1)
+	sub := v.eventFeed.Subscribe(events)
+	defer sub.Unsubscribe()
+
+	require.NoError(t, v.UpdateDuties(context.Background(), slot))
+	ev := <-events
+	assert.Equal(t, DutiesUpdated, ev.Type)
+	data, ok := ev.Data.(*DutiesUpdatedData)
+	require.Equal(t, true, ok)
+	assert.Equal(t, types.Epoch(1), data.Epoch)
+	assert.Equal(t, resp, data.Duties)
+}
+
 func TestUpdateDuties_OK_FilterBlacklistedPublicKeys(t *testing.T) {
 	hook := logTest.NewGlobal()
 	ctrl := gomock.NewController(t)
@@ -749,6 +786,28 @@ func TestRolesAt_OK(t *testing.T) {
 	assert.Equal(t, iface.RoleSyncCommittee, roleMap[bytesutil.ToBytes48(validatorKey.PublicKey().Marshal())][0])
 }
 
+func TestRolesAt_SkipsDisabledPublicKeys(t *testing.T) {
+	v, _, validatorKey, finish := setup(t)
+	defer finish()
+
+	pubKey := bytesutil.ToBytes48(validatorKey.PublicKey().Marshal())
+	v.duties = &ethpb.DutiesResponse{
+		Duties: []*ethpb.DutiesResponse_Duty{
+			{
+				CommitteeIndex: 1,
+				AttesterSlot:   1,
+				ProposerSlots:  []types.Slot{1},
+				PublicKey:      pubKey[:],
+			},
+		},
+	}
+	require.NoError(t, v.db.DisablePublicKeys(context.Background(), [][fieldparams.BLSPubkeyLength]byte{pubKey}))
+
+	roleMap, err := v.RolesAt(context.Background(), 1)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(roleMap))
+}
+
 func TestRolesAt_DoesNotAssignProposer_Slot0(t *testing.T) {
 	v, m, validatorKey, finish := setup(t)
 	defer finish()
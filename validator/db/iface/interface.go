@@ -19,6 +19,7 @@ var _ = ValidatorDB(&kv.Store{})
 type ValidatorDB interface {
 	io.Closer
 	backup.BackupExporter
+	backup.Snapshotter
 	DatabasePath() string
 	ClearDB() error
 	RunUpMigrations(ctx context.Context) error
@@ -59,6 +60,11 @@ type ValidatorDB interface {
 		ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte,
 	) ([]*kv.AttestationRecord, error)
 
+	// Methods to store and read public keys disabled by the user.
+	DisabledPublicKeys(ctx context.Context) ([][fieldparams.BLSPubkeyLength]byte, error)
+	DisablePublicKeys(ctx context.Context, publicKeys [][fieldparams.BLSPubkeyLength]byte) error
+	EnablePublicKeys(ctx context.Context, publicKeys [][fieldparams.BLSPubkeyLength]byte) error
+
 	// Graffiti ordered index related methods
 	SaveGraffitiOrderedIndex(ctx context.Context, index uint64) error
 	GraffitiOrderedIndex(ctx context.Context, fileHash [32]byte) (uint64, error)
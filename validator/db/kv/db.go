@@ -148,6 +148,7 @@ func NewKVStore(ctx context.Context, dirPath string, config *Config) (*Store, er
 			lowestSignedProposalsBucket,
 			highestSignedProposalsBucket,
 			slashablePublicKeysBucket,
+			disabledPublicKeysBucket,
 			pubKeysBucket,
 			migrationsBucket,
 			graffitiBucket,
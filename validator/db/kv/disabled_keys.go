@@ -0,0 +1,62 @@
+package kv
+
+import (
+	"context"
+
+	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// DisabledPublicKeys returns the public keys which were disabled by the user. Disabled keys
+// remain in the keymanager and keep their slashing protection history, but must not be
+// assigned any duties by the validator client.
+func (s *Store) DisabledPublicKeys(ctx context.Context) ([][fieldparams.BLSPubkeyLength]byte, error) {
+	_, span := trace.StartSpan(ctx, "Validator.DisabledPublicKeys")
+	defer span.End()
+	publicKeys := make([][fieldparams.BLSPubkeyLength]byte, 0)
+	err := s.db.View(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(disabledPublicKeysBucket)
+		return bkt.ForEach(func(key []byte, _ []byte) error {
+			if key != nil {
+				pubKeyBytes := [fieldparams.BLSPubkeyLength]byte{}
+				copy(pubKeyBytes[:], key)
+				publicKeys = append(publicKeys, pubKeyBytes)
+			}
+			return nil
+		})
+	})
+	return publicKeys, err
+}
+
+// DisablePublicKeys marks the given public keys as disabled.
+func (s *Store) DisablePublicKeys(ctx context.Context, publicKeys [][fieldparams.BLSPubkeyLength]byte) error {
+	_, span := trace.StartSpan(ctx, "Validator.DisablePublicKeys")
+	defer span.End()
+	return s.db.Update(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(disabledPublicKeysBucket)
+		for _, pubKey := range publicKeys {
+			// Only the presence of the key in the bucket matters, the value is ignored.
+			if err := bkt.Put(pubKey[:], []byte{1}); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// EnablePublicKeys removes the disabled mark from the given public keys. Keys which
+// were not disabled are ignored.
+func (s *Store) EnablePublicKeys(ctx context.Context, publicKeys [][fieldparams.BLSPubkeyLength]byte) error {
+	_, span := trace.StartSpan(ctx, "Validator.EnablePublicKeys")
+	defer span.End()
+	return s.db.Update(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(disabledPublicKeysBucket)
+		for _, pubKey := range publicKeys {
+			if err := bkt.Delete(pubKey[:]); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
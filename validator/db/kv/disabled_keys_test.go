@@ -0,0 +1,53 @@
+package kv
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+)
+
+func TestStore_DisabledPublicKeys(t *testing.T) {
+	ctx := context.Background()
+	numValidators := 10
+	publicKeys := make([][fieldparams.BLSPubkeyLength]byte, numValidators)
+	for i := 0; i < numValidators; i++ {
+		key := [fieldparams.BLSPubkeyLength]byte{}
+		copy(key[:], fmt.Sprintf("%d", i))
+		publicKeys[i] = key
+	}
+
+	// No disabled keys returns empty.
+	validatorDB := setupDB(t, publicKeys)
+	received, err := validatorDB.DisabledPublicKeys(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(received))
+
+	require.NoError(t, validatorDB.DisablePublicKeys(ctx, publicKeys[:5]))
+	received, err = validatorDB.DisabledPublicKeys(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 5, len(received))
+
+	// Keys are not guaranteed to be ordered, so we create a map for comparisons.
+	want := make(map[[fieldparams.BLSPubkeyLength]byte]bool)
+	for _, pubKey := range publicKeys[:5] {
+		want[pubKey] = true
+	}
+	for _, pubKey := range received {
+		require.Equal(t, true, want[pubKey])
+	}
+
+	// Enabling a key that was never disabled is a no-op.
+	require.NoError(t, validatorDB.EnablePublicKeys(ctx, publicKeys[3:6]))
+	received, err = validatorDB.DisabledPublicKeys(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(received))
+	for _, pubKey := range received {
+		require.Equal(t, true, want[pubKey])
+		assert.NotEqual(t, publicKeys[3], pubKey)
+		assert.NotEqual(t, publicKeys[4], pubKey)
+	}
+}
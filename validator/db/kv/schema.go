@@ -19,6 +19,9 @@ var (
 	// Slashable public keys bucket.
 	slashablePublicKeysBucket = []byte("slashable-public-keys")
 
+	// Public keys which have been disabled by the user and should not perform duties.
+	disabledPublicKeysBucket = []byte("disabled-public-keys")
+
 	// Genesis validators root bucket key.
 	genesisValidatorsRootKey = []byte("genesis-val-root")
 
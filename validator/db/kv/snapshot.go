@@ -0,0 +1,139 @@
+package kv
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/config/params"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/monitoring/backup"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// SigningWatermark is the highest proposal slot and attestation target epoch signed by a
+// validator public key.
+type SigningWatermark struct {
+	ProposalSlot types.Slot
+	TargetEpoch  types.Epoch
+}
+
+// SnapshotMetadata returns the genesis validators root of the database.
+func (s *Store) SnapshotMetadata(ctx context.Context) (*backup.Metadata, error) {
+	_, span := trace.StartSpan(ctx, "ValidatorDB.SnapshotMetadata")
+	defer span.End()
+
+	var meta *backup.Metadata
+	err := s.view(func(tx *bolt.Tx) error {
+		meta = snapshotMetadata(tx)
+		return nil
+	})
+	return meta, err
+}
+
+// Snapshot writes a copy of the database file to w. The copy is taken within a single read
+// transaction, so that it is consistent while the validator client keeps signing.
+func (s *Store) Snapshot(ctx context.Context, w io.Writer) (*backup.Metadata, error) {
+	_, span := trace.StartSpan(ctx, "ValidatorDB.Snapshot")
+	defer span.End()
+
+	var meta *backup.Metadata
+	err := s.view(func(tx *bolt.Tx) error {
+		meta = snapshotMetadata(tx)
+		_, err := tx.WriteTo(w)
+		return err
+	})
+	return meta, err
+}
+
+// ReadSnapshotMetadata returns the genesis validators root of the database file at the given
+// path, which must not be in use by a validator client.
+func ReadSnapshotMetadata(ctx context.Context, dbFile string) (*backup.Metadata, error) {
+	_, span := trace.StartSpan(ctx, "ValidatorDB.ReadSnapshotMetadata")
+	defer span.End()
+
+	var meta *backup.Metadata
+	err := viewFile(dbFile, func(tx *bolt.Tx) error {
+		meta = snapshotMetadata(tx)
+		return nil
+	})
+	return meta, err
+}
+
+// ReadSigningWatermarks returns the signing watermark of every public key found in the database
+// file at the given path, which must not be in use by a validator client.
+func ReadSigningWatermarks(ctx context.Context, dbFile string) (map[[fieldparams.BLSPubkeyLength]byte]*SigningWatermark, error) {
+	_, span := trace.StartSpan(ctx, "ValidatorDB.ReadSigningWatermarks")
+	defer span.End()
+
+	watermarks := make(map[[fieldparams.BLSPubkeyLength]byte]*SigningWatermark)
+	watermark := func(pubKey []byte) *SigningWatermark {
+		key := bytesutil.ToBytes48(pubKey)
+		if _, ok := watermarks[key]; !ok {
+			watermarks[key] = &SigningWatermark{}
+		}
+		return watermarks[key]
+	}
+	err := viewFile(dbFile, func(tx *bolt.Tx) error {
+		if bkt := tx.Bucket(highestSignedProposalsBucket); bkt != nil {
+			if err := bkt.ForEach(func(pubKey, slot []byte) error {
+				if len(slot) >= 8 {
+					watermark(pubKey).ProposalSlot = bytesutil.BytesToSlotBigEndian(slot)
+				}
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		bkt := tx.Bucket(pubKeysBucket)
+		if bkt == nil {
+			return nil
+		}
+		return bkt.ForEach(func(pubKey, _ []byte) error {
+			pkBucket := bkt.Bucket(pubKey)
+			if pkBucket == nil {
+				return nil
+			}
+			targetEpochsBucket := pkBucket.Bucket(attestationTargetEpochsBucket)
+			if targetEpochsBucket == nil {
+				return nil
+			}
+			// Target epochs are big endian encoded, so the last key is the highest target epoch.
+			if target, _ := targetEpochsBucket.Cursor().Last(); target != nil {
+				watermark(pubKey).TargetEpoch = bytesutil.BytesToEpochBigEndian(target)
+			}
+			return nil
+		})
+	})
+	return watermarks, err
+}
+
+func snapshotMetadata(tx *bolt.Tx) *backup.Metadata {
+	meta := &backup.Metadata{}
+	if bkt := tx.Bucket(genesisInfoBucket); bkt != nil {
+		if root := bkt.Get(genesisValidatorsRootKey); len(root) != 0 {
+			meta.GenesisRoot = fmt.Sprintf("%#x", root)
+		}
+	}
+	return meta
+}
+
+// viewFile runs fn in a read transaction of the database file at the given path.
+func viewFile(dbFile string, fn func(tx *bolt.Tx) error) error {
+	db, err := bolt.Open(dbFile, params.BeaconIoConfig().ReadWritePermissions, &bolt.Options{
+		ReadOnly: true,
+		Timeout:  params.BeaconIoConfig().BoltTimeout,
+	})
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := db.Close(); err != nil {
+			log.WithError(err).Error("Could not close database")
+		}
+	}()
+	return db.View(fn)
+}
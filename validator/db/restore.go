@@ -1,27 +1,37 @@
 package db
 
 import (
+	"fmt"
 	"os"
 	"path"
 	"strings"
 
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/cmd"
+	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/io/file"
 	"github.com/prysmaticlabs/prysm/io/prompt"
+	"github.com/prysmaticlabs/prysm/monitoring/backup"
 	"github.com/prysmaticlabs/prysm/validator/db/kv"
+	"github.com/sirupsen/logrus"
 	"github.com/urfave/cli/v2"
 )
 
 const dbExistsYesNoPrompt = "A database file already exists in the target directory. " +
 	"Are you sure that you want to overwrite it? [y/n]"
 
-// Restore a Prysm validator database.
+// Restore a Prysm validator database. The backup is extracted next to the target database file
+// and its checksum and genesis validators root are verified before it replaces the target
+// database file. A backup which has signed less than the current database for any public key is
+// only restored if explicitly allowed, as it would lose slashing protection history, and so is a
+// backup without a manifest.
 func Restore(cliCtx *cli.Context) error {
 	sourceFile := cliCtx.String(cmd.RestoreSourceFileFlag.Name)
 	targetDir := cliCtx.String(cmd.RestoreTargetDirFlag.Name)
 
-	if file.FileExists(path.Join(targetDir, kv.ProtectionDbFileName)) {
+	targetFile := path.Join(targetDir, kv.ProtectionDbFileName)
+	targetExists := file.FileExists(targetFile)
+	if targetExists {
 		resp, err := prompt.ValidatePrompt(
 			os.Stdin, dbExistsYesNoPrompt, prompt.ValidateYesOrNo,
 		)
@@ -36,10 +46,95 @@ func Restore(cliCtx *cli.Context) error {
 	if err := file.MkdirAll(targetDir); err != nil {
 		return err
 	}
-	if err := file.CopyFile(sourceFile, path.Join(targetDir, kv.ProtectionDbFileName)); err != nil {
+
+	tmpFile := targetFile + ".restore"
+	if err := os.Remove(tmpFile); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	defer func() {
+		if err := os.Remove(tmpFile); err != nil && !os.IsNotExist(err) {
+			log.WithError(err).Error("Could not remove extracted backup")
+		}
+	}()
+	manifest, err := backup.Extract(sourceFile, tmpFile)
+	if err != nil {
+		return errors.Wrap(err, "could not extract backup")
+	}
+	restored, err := kv.ReadSnapshotMetadata(cliCtx.Context, tmpFile)
+	if err != nil {
+		return errors.Wrap(err, "could not read restored database")
+	}
+	if manifest != nil {
+		if err := manifest.Metadata.Verify(restored); err != nil {
+			return err
+		}
+	} else if !cliCtx.Bool(cmd.RestoreAllowUnverifiedFlag.Name) {
+		return errors.Errorf("backup %s has no manifest, its checksum cannot be verified. Use --%s to restore it anyway",
+			sourceFile, cmd.RestoreAllowUnverifiedFlag.Name)
+	}
+	if targetExists {
+		if err := verifyNotStale(cliCtx, tmpFile, targetFile, restored); err != nil {
+			return err
+		}
+	}
+	if err := os.Rename(tmpFile, targetFile); err != nil {
 		return err
 	}
 
 	log.Info("Restore completed successfully")
 	return nil
 }
+
+// verifyNotStale checks that the restored database belongs to the same chain as the current
+// database, and that it contains the highest proposal and attestation signed by every public
+// key of the current database.
+func verifyNotStale(cliCtx *cli.Context, restoredFile, currentFile string, restored *backup.Metadata) error {
+	current, err := kv.ReadSnapshotMetadata(cliCtx.Context, currentFile)
+	if err != nil {
+		return errors.Wrap(err, "could not read current database")
+	}
+	if current.GenesisRoot != "" && restored.GenesisRoot != current.GenesisRoot {
+		return errors.Errorf("backup genesis validators root %s does not match genesis validators root %s of the current database",
+			restored.GenesisRoot, current.GenesisRoot)
+	}
+
+	currentWatermarks, err := kv.ReadSigningWatermarks(cliCtx.Context, currentFile)
+	if err != nil {
+		return errors.Wrap(err, "could not read current slashing protection history")
+	}
+	restoredWatermarks, err := kv.ReadSigningWatermarks(cliCtx.Context, restoredFile)
+	if err != nil {
+		return errors.Wrap(err, "could not read restored slashing protection history")
+	}
+	var stale [][fieldparams.BLSPubkeyLength]byte
+	for pubKey, cur := range currentWatermarks {
+		res, ok := restoredWatermarks[pubKey]
+		if !ok || res.ProposalSlot < cur.ProposalSlot || res.TargetEpoch < cur.TargetEpoch {
+			stale = append(stale, pubKey)
+			log.WithFields(logrus.Fields{
+				"pubKey":               fmt.Sprintf("%#x", pubKey),
+				"currentProposalSlot":  cur.ProposalSlot,
+				"currentTargetEpoch":   cur.TargetEpoch,
+				"restoredProposalSlot": valueOrZero(res).ProposalSlot,
+				"restoredTargetEpoch":  valueOrZero(res).TargetEpoch,
+			}).Warn("Backup is missing slashing protection history of public key")
+		}
+	}
+	if len(stale) == 0 {
+		return nil
+	}
+	if !cliCtx.Bool(cmd.RestoreAllowStaleFlag.Name) {
+		return errors.Errorf("backup is missing slashing protection history for %d public keys, "+
+			"restoring it could lead to slashable signatures. Use --%s to restore it anyway",
+			len(stale), cmd.RestoreAllowStaleFlag.Name)
+	}
+	log.WithField("publicKeys", len(stale)).Warn("Restoring a backup missing slashing protection history")
+	return nil
+}
+
+func valueOrZero(w *kv.SigningWatermark) *kv.SigningWatermark {
+	if w == nil {
+		return &kv.SigningWatermark{}
+	}
+	return w
+}
@@ -8,7 +8,11 @@ import (
 	"testing"
 
 	"github.com/prysmaticlabs/prysm/cmd"
+	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/config/params"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/io/file"
+	"github.com/prysmaticlabs/prysm/monitoring/backup"
 	"github.com/prysmaticlabs/prysm/testing/assert"
 	"github.com/prysmaticlabs/prysm/testing/require"
 	"github.com/prysmaticlabs/prysm/validator/db/kv"
@@ -42,8 +46,12 @@ func TestRestore(t *testing.T) {
 	set.String(cmd.RestoreTargetDirFlag.Name, "", "")
 	require.NoError(t, set.Set(cmd.RestoreSourceFileFlag.Name, path.Join(backupDb.DatabasePath(), "backup.db")))
 	require.NoError(t, set.Set(cmd.RestoreTargetDirFlag.Name, restoreDir))
+	set.Bool(cmd.RestoreAllowUnverifiedFlag.Name, false, "")
 	cliCtx := cli.NewContext(&app, set, nil)
 
+	// The backup has no manifest, it is only restored when explicitly allowed.
+	assert.ErrorContains(t, "has no manifest", Restore(cliCtx))
+	require.NoError(t, set.Set(cmd.RestoreAllowUnverifiedFlag.Name, "true"))
 	assert.NoError(t, Restore(cliCtx))
 
 	files, err := os.ReadDir(restoreDir)
@@ -60,3 +68,57 @@ func TestRestore(t *testing.T) {
 	require.DeepEqual(t, root[:], genesisRoot, "Restored database has incorrect data")
 	assert.LogsContain(t, logHook, "Restore completed successfully")
 }
+
+func TestRestore_StaleSnapshot(t *testing.T) {
+	ctx := context.Background()
+	pubKey := [fieldparams.BLSPubkeyLength]byte{1}
+
+	db, err := kv.NewKVStore(ctx, t.TempDir(), &kv.Config{PubKeys: [][fieldparams.BLSPubkeyLength]byte{pubKey}})
+	require.NoError(t, err)
+	root := [32]byte{1}
+	require.NoError(t, db.SaveGenesisValidatorsRoot(ctx, root[:]))
+	require.NoError(t, db.SaveProposalHistoryForSlot(ctx, pubKey, 5, []byte{1}))
+	snapshotDir := t.TempDir()
+	manifest, err := backup.WriteSnapshot(ctx, db, snapshotDir, "prysm_validatordb", true)
+	require.NoError(t, err)
+	// The validator keeps signing after the snapshot is taken.
+	require.NoError(t, db.SaveProposalHistoryForSlot(ctx, pubKey, 10, []byte{2}))
+	require.NoError(t, db.Close())
+
+	restoreDir := t.TempDir()
+	require.NoError(t, os.Chmod(restoreDir, params.BeaconIoConfig().ReadWriteExecutePermissions))
+	currentFile := path.Join(restoreDir, kv.ProtectionDbFileName)
+	require.NoError(t, file.CopyFile(path.Join(db.DatabasePath(), kv.ProtectionDbFileName), currentFile))
+
+	newCliCtx := func(allowStale bool) *cli.Context {
+		set := flag.NewFlagSet("test", 0)
+		set.String(cmd.RestoreSourceFileFlag.Name, path.Join(snapshotDir, manifest.File), "")
+		set.String(cmd.RestoreTargetDirFlag.Name, restoreDir, "")
+		set.Bool(cmd.RestoreAllowStaleFlag.Name, allowStale, "")
+		return cli.NewContext(&cli.App{}, set, nil)
+	}
+	// Confirm overwriting the current database.
+	confirm := func() {
+		tmpfile, err := os.CreateTemp(t.TempDir(), "stdin")
+		require.NoError(t, err)
+		_, err = tmpfile.Write([]byte("y\n"))
+		require.NoError(t, err)
+		_, err = tmpfile.Seek(0, 0)
+		require.NoError(t, err)
+		os.Stdin = tmpfile
+	}
+	origStdin := os.Stdin
+	defer func() { os.Stdin = origStdin }()
+
+	confirm()
+	assert.ErrorContains(t, "missing slashing protection history for 1 public keys", Restore(newCliCtx(false)))
+	watermarks, err := kv.ReadSigningWatermarks(ctx, currentFile)
+	require.NoError(t, err)
+	assert.Equal(t, types.Slot(10), watermarks[pubKey].ProposalSlot, "Current database was replaced")
+
+	confirm()
+	require.NoError(t, Restore(newCliCtx(true)))
+	watermarks, err = kv.ReadSigningWatermarks(ctx, currentFile)
+	require.NoError(t, err)
+	assert.Equal(t, types.Slot(5), watermarks[pubKey].ProposalSlot)
+}
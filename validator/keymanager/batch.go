@@ -0,0 +1,51 @@
+package keymanager
+
+import (
+	"context"
+	"runtime"
+	"sync"
+
+	"github.com/prysmaticlabs/prysm/crypto/bls"
+	validatorpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/validator-client"
+)
+
+// SignFunc signs a single request.
+type SignFunc func(context.Context, *validatorpb.SignRequest) (bls.Signature, error)
+
+// SignBatchConcurrently signs all the requests with the given signing function, running at most
+// maxConcurrency signing calls at the same time. A limit of zero or less runs one call per CPU.
+// Signatures and errors are returned in the order of the requests. Requests which could not
+// be started before the context was done get the context error.
+func SignBatchConcurrently(
+	ctx context.Context, sign SignFunc, reqs []*validatorpb.SignRequest, maxConcurrency int,
+) ([]bls.Signature, []error) {
+	if maxConcurrency <= 0 {
+		maxConcurrency = runtime.GOMAXPROCS(0)
+	}
+	sigs := make([]bls.Signature, len(reqs))
+	errs := make([]error, len(reqs))
+	sem := make(chan struct{}, maxConcurrency)
+	var wg sync.WaitGroup
+	for i, req := range reqs {
+		if err := ctx.Err(); err != nil {
+			errs[i] = err
+			continue
+		}
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			errs[i] = ctx.Err()
+			continue
+		}
+		wg.Add(1)
+		go func(i int, req *validatorpb.SignRequest) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			sigs[i], errs[i] = sign(ctx, req)
+		}(i, req)
+	}
+	wg.Wait()
+	return sigs, errs
+}
@@ -0,0 +1,67 @@
+package keymanager_test
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/crypto/bls"
+	validatorpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/validator-client"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	"github.com/prysmaticlabs/prysm/validator/keymanager"
+)
+
+func TestSignBatchConcurrently(t *testing.T) {
+	key, err := bls.RandKey()
+	require.NoError(t, err)
+	var running, maxRunning int32
+	sign := func(_ context.Context, req *validatorpb.SignRequest) (bls.Signature, error) {
+		n := atomic.AddInt32(&running, 1)
+		defer atomic.AddInt32(&running, -1)
+		for {
+			m := atomic.LoadInt32(&maxRunning)
+			if n <= m || atomic.CompareAndSwapInt32(&maxRunning, m, n) {
+				break
+			}
+		}
+		if req.SigningSlot == 3 {
+			return nil, errors.New("bad request")
+		}
+		return key.Sign(req.SigningRoot), nil
+	}
+
+	reqs := make([]*validatorpb.SignRequest, 10)
+	for i := range reqs {
+		reqs[i] = &validatorpb.SignRequest{SigningRoot: []byte{byte(i)}, SigningSlot: 3}
+		if i != 3 {
+			reqs[i].SigningSlot = 1
+		}
+	}
+	sigs, errs := keymanager.SignBatchConcurrently(context.Background(), sign, reqs, 2)
+	require.Equal(t, len(reqs), len(sigs))
+	require.Equal(t, len(reqs), len(errs))
+	for i := range reqs {
+		if i == 3 {
+			assert.ErrorContains(t, "bad request", errs[i])
+			continue
+		}
+		require.NoError(t, errs[i])
+		assert.DeepEqual(t, key.Sign([]byte{byte(i)}).Marshal(), sigs[i].Marshal())
+	}
+	assert.Equal(t, true, atomic.LoadInt32(&maxRunning) <= 2)
+}
+
+func TestSignBatchConcurrently_ContextCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	sign := func(_ context.Context, _ *validatorpb.SignRequest) (bls.Signature, error) {
+		return nil, nil
+	}
+	reqs := make([]*validatorpb.SignRequest, 100)
+	_, errs := keymanager.SignBatchConcurrently(ctx, sign, reqs, 1)
+	for _, err := range errs {
+		assert.Equal(t, context.Canceled, err)
+	}
+}
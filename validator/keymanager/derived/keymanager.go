@@ -99,6 +99,11 @@ func (km *Keymanager) Sign(ctx context.Context, req *validatorpb.SignRequest) (b
 	return km.localKM.Sign(ctx, req)
 }
 
+// SignBatch signs many messages concurrently using the validator keys.
+func (km *Keymanager) SignBatch(ctx context.Context, reqs []*validatorpb.SignRequest) ([]bls.Signature, []error) {
+	return km.localKM.SignBatch(ctx, reqs)
+}
+
 // FetchValidatingPublicKeys fetches the list of validating public keys from the keymanager.
 func (km *Keymanager) FetchValidatingPublicKeys(ctx context.Context) ([][fieldparams.BLSPubkeyLength]byte, error) {
 	return km.localKM.FetchValidatingPublicKeys(ctx)
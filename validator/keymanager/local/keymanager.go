@@ -198,6 +198,11 @@ func (km *Keymanager) FetchValidatingPrivateKeys(ctx context.Context) ([][32]byt
 	return privKeys, nil
 }
 
+// SignBatch signs many messages concurrently, using at most one goroutine per CPU.
+func (km *Keymanager) SignBatch(ctx context.Context, reqs []*validatorpb.SignRequest) ([]bls.Signature, []error) {
+	return keymanager.SignBatchConcurrently(ctx, km.Sign, reqs, 0)
+}
+
 // Sign signs a message using a validator key.
 func (_ *Keymanager) Sign(ctx context.Context, req *validatorpb.SignRequest) (bls.Signature, error) {
 	_, span := trace.StartSpan(ctx, "keymanager.Sign")
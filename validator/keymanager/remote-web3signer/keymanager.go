@@ -40,8 +40,16 @@ type SetupConfig struct {
 	// a static list of public keys to be passed by the user to determine what accounts should sign.
 	// This will provide a layer of safety against slashing if the web3signer is shared across validators.
 	ProvidedPublicKeys [][48]byte
+
+	// The maximum number of signing requests sent concurrently to the web3signer when signing
+	// in batches. DefaultMaxConcurrentSignRequests is used when not set.
+	MaxConcurrentSignRequests int
 }
 
+// DefaultMaxConcurrentSignRequests is the default maximum number of concurrent signing requests
+// sent to the web3signer when signing in batches.
+const DefaultMaxConcurrentSignRequests = 32
+
 // Keymanager defines the web3signer keymanager.
 type Keymanager struct {
 	client                internal.HttpSignerClient
@@ -51,6 +59,7 @@ type Keymanager struct {
 	accountsChangedFeed   *event.Feed
 	validator             *validator.Validate
 	publicKeysUrlCalled   bool
+	maxConcurrentRequests int
 }
 
 // NewKeymanager instantiates a new web3signer key manager.
@@ -62,6 +71,10 @@ func NewKeymanager(_ context.Context, cfg *SetupConfig) (*Keymanager, error) {
 	if err != nil {
 		return nil, errors.Wrap(err, "could not create apiClient")
 	}
+	maxConcurrentRequests := cfg.MaxConcurrentSignRequests
+	if maxConcurrentRequests <= 0 {
+		maxConcurrentRequests = DefaultMaxConcurrentSignRequests
+	}
 	return &Keymanager{
 		client:                internal.HttpSignerClient(client),
 		genesisValidatorsRoot: cfg.GenesisValidatorsRoot,
@@ -70,6 +83,7 @@ func NewKeymanager(_ context.Context, cfg *SetupConfig) (*Keymanager, error) {
 		providedPublicKeys:    cfg.ProvidedPublicKeys,
 		validator:             validator.New(),
 		publicKeysUrlCalled:   false,
+		maxConcurrentRequests: maxConcurrentRequests,
 	}, nil
 }
 
@@ -103,6 +117,12 @@ func (km *Keymanager) Sign(ctx context.Context, request *validatorpb.SignRequest
 	return km.client.Sign(ctx, hexutil.Encode(request.PublicKey), signRequest)
 }
 
+// SignBatch signs many messages by sending concurrent requests to the remote web3signer server,
+// keeping at most the configured number of requests in flight.
+func (km *Keymanager) SignBatch(ctx context.Context, reqs []*validatorpb.SignRequest) ([]bls.Signature, []error) {
+	return keymanager.SignBatchConcurrently(ctx, km.Sign, reqs, km.maxConcurrentRequests)
+}
+
 // getSignRequestJson returns a json request based on the SignRequest type.
 func getSignRequestJson(ctx context.Context, validator *validator.Validate, request *validatorpb.SignRequest, genesisValidatorsRoot []byte) (internal.SignRequestJson, error) {
 	if request == nil {
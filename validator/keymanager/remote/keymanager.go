@@ -37,6 +37,9 @@ var (
 	ErrSigningDenied = errors.New("signing request was denied by remote server")
 )
 
+// The maximum number of signing requests sent concurrently to the remote server in a batch.
+const maxConcurrentSignRequests = 64
+
 // RemoteKeymanager defines the interface for remote Prysm wallets.
 type RemoteKeymanager interface {
 	keymanager.IKeymanager
@@ -264,6 +267,11 @@ func (km *Keymanager) Sign(ctx context.Context, req *validatorpb.SignRequest) (b
 	return bls.SignatureFromBytes(resp.Signature)
 }
 
+// SignBatch signs many messages via concurrent gRPC requests, limiting the number of requests in flight.
+func (km *Keymanager) SignBatch(ctx context.Context, reqs []*validatorpb.SignRequest) ([]bls.Signature, []error) {
+	return keymanager.SignBatchConcurrently(ctx, km.Sign, reqs, maxConcurrentSignRequests)
+}
+
 // SubscribeAccountChanges creates an event subscription for a channel
 // to listen for public key changes at runtime, such as when new validator accounts
 // are imported into the keymanager while the validator process is running.
@@ -37,6 +37,11 @@ func (*MockKeymanager) Sign(context.Context, *validatorpb.SignRequest) (bls.Sign
 	panic("implement me")
 }
 
+// SignBatch --
+func (*MockKeymanager) SignBatch(context.Context, []*validatorpb.SignRequest) ([]bls.Signature, []error) {
+	panic("implement me")
+}
+
 // SubscribeAccountChanges --
 func (m *MockKeymanager) SubscribeAccountChanges(chan [][fieldparams.BLSPubkeyLength]byte) event.Subscription {
 	return m.accountsChangedFeed.Subscribe(m.ReloadPublicKeysChan)
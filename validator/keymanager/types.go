@@ -16,6 +16,7 @@ import (
 type IKeymanager interface {
 	PublicKeysFetcher
 	Signer
+	BatchSigner
 	KeyChangeSubscriber
 	KeyStoreExtractor
 	AccountLister
@@ -38,6 +39,13 @@ type Signer interface {
 	Sign(context.Context, *validatorpb.SignRequest) (bls.Signature, error)
 }
 
+// BatchSigner allows signing many messages at once, possibly for different validator keys.
+// Signatures and errors are returned in the order of the requests, a nil error at an index
+// meaning the signature at the same index is valid.
+type BatchSigner interface {
+	SignBatch(context.Context, []*validatorpb.SignRequest) ([]bls.Signature, []error)
+}
+
 // Importer can import new keystores into the keymanager.
 type Importer interface {
 	ImportKeystores(
@@ -0,0 +1,88 @@
+package node
+
+import (
+	"context"
+	"path/filepath"
+	"sync"
+
+	"github.com/prysmaticlabs/prysm/async/event"
+	"github.com/prysmaticlabs/prysm/cmd"
+	"github.com/prysmaticlabs/prysm/monitoring/clientstats"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/validator/client"
+	"github.com/urfave/cli/v2"
+)
+
+// validatorCountTracker keeps the number of validating keys and of active validators
+// reported by the latest duties received by the validator client.
+type validatorCountTracker struct {
+	sync.RWMutex
+	total  int64
+	active int64
+}
+
+// run updates the counts from the duties sent on the event feed until the context is done.
+func (t *validatorCountTracker) run(ctx context.Context, feed *event.Feed) {
+	ch := make(chan *client.Event)
+	sub := feed.Subscribe(ch)
+	defer sub.Unsubscribe()
+	for {
+		select {
+		case ev := <-ch:
+			if ev.Type != client.DutiesUpdated {
+				continue
+			}
+			data, ok := ev.Data.(*client.DutiesUpdatedData)
+			if !ok || data.Duties == nil {
+				continue
+			}
+			t.update(data.Duties)
+		case <-sub.Err():
+			return
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (t *validatorCountTracker) update(duties *ethpb.DutiesResponse) {
+	var active int64
+	for _, d := range duties.CurrentEpochDuties {
+		if d.Status == ethpb.ValidatorStatus_ACTIVE {
+			active++
+		}
+	}
+	t.Lock()
+	defer t.Unlock()
+	t.total = int64(len(duties.CurrentEpochDuties))
+	t.active = active
+}
+
+func (t *validatorCountTracker) stats() clientstats.ValidatorStats {
+	t.RLock()
+	defer t.RUnlock()
+	return clientstats.ValidatorStats{ValidatorTotal: t.total, ValidatorActive: t.active}
+}
+
+func (c *ValidatorClient) registerClientStatsService(cliCtx *cli.Context) error {
+	var vs *client.ValidatorService
+	if err := c.services.FetchService(&vs); err != nil {
+		return err
+	}
+	tracker := &validatorCountTracker{}
+	go tracker.run(c.ctx, vs.EventFeed())
+
+	spool := cliCtx.String(cmd.ClientStatsSpoolFileFlag.Name)
+	if spool == "" {
+		spool = filepath.Join(cliCtx.String(cmd.DataDirFlag.Name), "clientstats-spool.jsonl")
+	}
+	svc := clientstats.NewPusher(c.ctx, &clientstats.PusherConfig{
+		Scrapers:   []clientstats.Scraper{clientstats.NewValidatorStatsScraper(tracker.stats)},
+		Updater:    clientstats.NewClientStatsHTTPPostUpdater(cliCtx.String(cmd.ClientStatsAPIURLFlag.Name)),
+		Interval:   cliCtx.Duration(cmd.ClientStatsPushIntervalFlag.Name),
+		Retries:    clientstats.DefaultPushRetries,
+		RetryDelay: clientstats.DefaultPushRetryDelay,
+		SpoolPath:  spool,
+	})
+	return c.services.RegisterService(svc)
+}
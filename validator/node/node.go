@@ -19,6 +19,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/gorilla/mux"
 	gwruntime "github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/api/gateway"
@@ -246,6 +247,11 @@ func (c *ValidatorClient) initializeFromCLI(cliCtx *cli.Context) error {
 	if err := valDB.RunUpMigrations(cliCtx.Context); err != nil {
 		return errors.Wrap(err, "could not run database migration")
 	}
+	if cliCtx.Duration(cmd.DBBackupIntervalFlag.Name) > 0 {
+		if err := c.registerSnapshotScheduler(cliCtx); err != nil {
+			return err
+		}
+	}
 
 	if !cliCtx.Bool(cmd.DisableMonitoringFlag.Name) {
 		if err := c.registerPrometheusService(cliCtx); err != nil {
@@ -255,6 +261,11 @@ func (c *ValidatorClient) initializeFromCLI(cliCtx *cli.Context) error {
 	if err := c.registerValidatorService(cliCtx); err != nil {
 		return err
 	}
+	if cliCtx.String(cmd.ClientStatsAPIURLFlag.Name) != "" {
+		if err := c.registerClientStatsService(cliCtx); err != nil {
+			return err
+		}
+	}
 	if cliCtx.Bool(flags.EnableRPCFlag.Name) {
 		if err := c.registerRPCService(cliCtx); err != nil {
 			return err
@@ -323,6 +334,11 @@ func (c *ValidatorClient) initializeForWeb(cliCtx *cli.Context) error {
 	if err := valDB.RunUpMigrations(cliCtx.Context); err != nil {
 		return errors.Wrap(err, "could not run database migration")
 	}
+	if cliCtx.Duration(cmd.DBBackupIntervalFlag.Name) > 0 {
+		if err := c.registerSnapshotScheduler(cliCtx); err != nil {
+			return err
+		}
+	}
 
 	if !cliCtx.Bool(cmd.DisableMonitoringFlag.Name) {
 		if err := c.registerPrometheusService(cliCtx); err != nil {
@@ -332,6 +348,11 @@ func (c *ValidatorClient) initializeForWeb(cliCtx *cli.Context) error {
 	if err := c.registerValidatorService(cliCtx); err != nil {
 		return err
 	}
+	if cliCtx.String(cmd.ClientStatsAPIURLFlag.Name) != "" {
+		if err := c.registerClientStatsService(cliCtx); err != nil {
+			return err
+		}
+	}
 	if err := c.registerRPCService(cliCtx); err != nil {
 		return err
 	}
@@ -347,6 +368,25 @@ func (c *ValidatorClient) initializeForWeb(cliCtx *cli.Context) error {
 	return nil
 }
 
+func (c *ValidatorClient) registerSnapshotScheduler(cliCtx *cli.Context) error {
+	dir, err := backup.SnapshotDir(cliCtx.String(cmd.BackupWebhookOutputDir.Name), c.db.DatabasePath())
+	if err != nil {
+		return err
+	}
+	svc, err := backup.NewScheduler(cliCtx.Context, &backup.SchedulerConfig{
+		Snapshotter: c.db,
+		Dir:         dir,
+		Prefix:      "prysm_validatordb",
+		Interval:    cliCtx.Duration(cmd.DBBackupIntervalFlag.Name),
+		Retention:   cliCtx.Int(cmd.DBBackupRetentionFlag.Name),
+		Compress:    cliCtx.Bool(cmd.DBBackupCompressFlag.Name),
+	})
+	if err != nil {
+		return err
+	}
+	return c.services.RegisterService(svc)
+}
+
 func (c *ValidatorClient) registerPrometheusService(cliCtx *cli.Context) error {
 	var additionalHandlers []prometheus.Handler
 	if cliCtx.IsSet(cmd.EnableBackupWebhookFlag.Name) {
@@ -446,8 +486,9 @@ func web3SignerConfig(cliCtx *cli.Context) (*remote_web3signer.SetupConfig, erro
 			return nil, fmt.Errorf("web3signer url must be in the format of http(s)://host:port url used: %v", urlStr)
 		}
 		web3signerConfig = &remote_web3signer.SetupConfig{
-			BaseEndpoint:          u.String(),
-			GenesisValidatorsRoot: nil,
+			BaseEndpoint:              u.String(),
+			GenesisValidatorsRoot:     nil,
+			MaxConcurrentSignRequests: cliCtx.Int(flags.Web3SignerMaxConcurrentRequestsFlag.Name),
 		}
 		if cliCtx.IsSet(flags.Web3SignerPublicValidatorKeysFlag.Name) {
 			publicKeysStr := cliCtx.String(flags.Web3SignerPublicValidatorKeysFlag.Name)
@@ -623,6 +664,13 @@ func (c *ValidatorClient) registerRPCGatewayService(cliCtx *cli.Context) error {
 	}
 	maxCallSize := cliCtx.Uint64(cmd.GrpcMaxCallRecvMsgSizeFlag.Name)
 
+	var rpcServer *rpc.Server
+	if err := c.services.FetchService(&rpcServer); err != nil {
+		return err
+	}
+	router := mux.NewRouter()
+	rpcServer.RegisterHTTPHandlers(router)
+
 	registrations := []gateway.PbHandlerRegistration{
 		validatorpb.RegisterAuthHandler,
 		validatorpb.RegisterWalletHandler,
@@ -675,6 +723,7 @@ func (c *ValidatorClient) registerRPCGatewayService(cliCtx *cli.Context) error {
 	opts := []gateway.Option{
 		gateway.WithRemoteAddr(rpcAddr),
 		gateway.WithGatewayAddr(gatewayAddress),
+		gateway.WithRouter(router),
 		gateway.WithMaxCallRecvMsgSize(maxCallSize),
 		gateway.WithPbHandlers([]*gateway.PbMux{pbHandler}),
 		gateway.WithAllowedOrigins(allowedOrigins),
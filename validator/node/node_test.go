@@ -9,17 +9,21 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/prysmaticlabs/prysm/async/event"
 	"github.com/prysmaticlabs/prysm/cmd/validator/flags"
 	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
 	validator_service_config "github.com/prysmaticlabs/prysm/config/validator/service"
 	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/testing/assert"
 	"github.com/prysmaticlabs/prysm/testing/require"
 	"github.com/prysmaticlabs/prysm/validator/accounts"
 	"github.com/prysmaticlabs/prysm/validator/accounts/wallet"
+	"github.com/prysmaticlabs/prysm/validator/client"
 	"github.com/prysmaticlabs/prysm/validator/keymanager"
 	remote_web3signer "github.com/prysmaticlabs/prysm/validator/keymanager/remote-web3signer"
 	logTest "github.com/sirupsen/logrus/hooks/test"
@@ -105,6 +109,7 @@ func TestWeb3SignerConfig(t *testing.T) {
 					bytepubkey1,
 					bytepubkey2,
 				},
+				MaxConcurrentSignRequests: 16,
 			},
 		},
 		{
@@ -114,10 +119,11 @@ func TestWeb3SignerConfig(t *testing.T) {
 				publicKeysOrURL: "http://localhost:8545/api/v1/eth2/publicKeys",
 			},
 			want: &remote_web3signer.SetupConfig{
-				BaseEndpoint:          "http://localhost:8545",
-				GenesisValidatorsRoot: nil,
-				PublicKeysURL:         "http://localhost:8545/api/v1/eth2/publicKeys",
-				ProvidedPublicKeys:    nil,
+				BaseEndpoint:              "http://localhost:8545",
+				GenesisValidatorsRoot:     nil,
+				PublicKeysURL:             "http://localhost:8545/api/v1/eth2/publicKeys",
+				ProvidedPublicKeys:        nil,
+				MaxConcurrentSignRequests: 16,
 			},
 		},
 		{
@@ -185,6 +191,7 @@ func newWeb3SignerCli(t *testing.T, baseUrl string, publicKeysOrURL string) *cli
 	set := flag.NewFlagSet("test", 0)
 	set.String("validators-external-signer-url", baseUrl, "baseUrl")
 	set.String("validators-external-signer-public-keys", publicKeysOrURL, "publicKeys or URL")
+	set.Int(flags.Web3SignerMaxConcurrentRequestsFlag.Name, 16, "max concurrent requests")
 	require.NoError(t, set.Set(flags.Web3SignerURLFlag.Name, baseUrl))
 	require.NoError(t, set.Set(flags.Web3SignerPublicValidatorKeysFlag.Name, publicKeysOrURL))
 	return cli.NewContext(&app, set, nil)
@@ -521,3 +528,35 @@ func TestFeeRecipientConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestValidatorCountTracker(t *testing.T) {
+	feed := new(event.Feed)
+	tracker := &validatorCountTracker{}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	done := make(chan struct{})
+	go func() {
+		tracker.run(ctx, feed)
+		close(done)
+	}()
+	// Wait for the tracker to subscribe, as events sent without subscribers are dropped.
+	for feed.Send(&client.Event{Type: client.KeysReloaded}) == 0 {
+		time.Sleep(time.Millisecond)
+	}
+
+	feed.Send(&client.Event{Type: client.DutiesUpdated, Data: &client.DutiesUpdatedData{
+		Duties: &ethpb.DutiesResponse{CurrentEpochDuties: []*ethpb.DutiesResponse_Duty{
+			{Status: ethpb.ValidatorStatus_ACTIVE},
+			{Status: ethpb.ValidatorStatus_PENDING},
+			{Status: ethpb.ValidatorStatus_ACTIVE},
+		}},
+	}})
+	// The tracker handles events in order, so the counts are updated once the next event is received.
+	feed.Send(&client.Event{Type: client.KeysReloaded})
+	stats := tracker.stats()
+	assert.Equal(t, int64(3), stats.ValidatorTotal)
+	assert.Equal(t, int64(2), stats.ValidatorActive)
+
+	cancel()
+	<-done
+}
@@ -9,7 +9,9 @@ import (
 
 	"github.com/prysmaticlabs/prysm/api/pagination"
 	"github.com/prysmaticlabs/prysm/cmd"
+	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/crypto/bls"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
 	pb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/validator-client"
 	"github.com/prysmaticlabs/prysm/validator/accounts"
 	"github.com/prysmaticlabs/prysm/validator/accounts/petnames"
@@ -220,3 +222,44 @@ func (s *Server) VoluntaryExit(
 		ExitedKeys: rawExitedKeys,
 	}, nil
 }
+
+// DisabledAccounts returns the public keys which were disabled in the validator DB.
+// Disabled keys stay in the wallet but are not assigned any duties.
+func (s *Server) DisabledAccounts(ctx context.Context) ([][]byte, error) {
+	if s.valDB == nil {
+		return nil, status.Error(codes.FailedPrecondition, "Validator DB not yet initialized")
+	}
+	keys, err := s.valDB.DisabledPublicKeys(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not read disabled public keys: %v", err)
+	}
+	return bytesutil.FromBytes48Array(keys), nil
+}
+
+// SetAccountsDisabled disables or re-enables the specified public keys. Disabled keys do not
+// perform any duties, while their slashing protection history is kept.
+func (s *Server) SetAccountsDisabled(ctx context.Context, publicKeys [][]byte, disabled bool) error {
+	if s.valDB == nil {
+		return status.Error(codes.FailedPrecondition, "Validator DB not yet initialized")
+	}
+	if len(publicKeys) == 0 {
+		return status.Error(codes.InvalidArgument, "No public keys specified")
+	}
+	keys := make([][fieldparams.BLSPubkeyLength]byte, len(publicKeys))
+	for i, pk := range publicKeys {
+		if len(pk) != fieldparams.BLSPubkeyLength {
+			return status.Errorf(codes.InvalidArgument, "Invalid public key length %d for key %#x", len(pk), pk)
+		}
+		keys[i] = bytesutil.ToBytes48(pk)
+	}
+	var err error
+	if disabled {
+		err = s.valDB.DisablePublicKeys(ctx, keys)
+	} else {
+		err = s.valDB.EnablePublicKeys(ctx, keys)
+	}
+	if err != nil {
+		return status.Errorf(codes.Internal, "Could not update disabled public keys: %v", err)
+	}
+	return nil
+}
@@ -55,8 +55,8 @@ func TestServer_AuthenticateUsingExistingToken(t *testing.T) {
 func TestServer_RefreshJWTSecretOnFileChange(t *testing.T) {
 	// Initializing for the first time, there is no auth token file in
 	// the wallet directory, so we generate a jwt token and secret from scratch.
-	srv := &Server{}
 	walletDir := setupWalletDir(t)
+	srv := &Server{walletDir: walletDir}
 	_, err := srv.initializeAuthToken(walletDir)
 	require.NoError(t, err)
 	currentSecret := srv.jwtSecret
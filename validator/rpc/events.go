@@ -0,0 +1,206 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/prysmaticlabs/prysm/validator/client"
+)
+
+const (
+	// DutiesTopic represents a new set of duties received from the beacon node.
+	DutiesTopic = "duties"
+	// SigningTopic represents an attempt to sign an object for a duty.
+	SigningTopic = "signing"
+	// SlashingProtectionTopic represents a signed object refused by slashing protection.
+	SlashingProtectionTopic = "slashing_protection"
+	// SubmissionTopic represents an attempt to submit a signed object to the beacon node.
+	SubmissionTopic = "submission"
+	// KeyReloadTopic represents a change of the validating keys.
+	KeyReloadTopic = "key_reload"
+
+	successOutcome = "success"
+	failureOutcome = "failure"
+
+	eventsChanSize = 100
+)
+
+var eventTopics = map[string]client.EventType{
+	DutiesTopic:             client.DutiesUpdated,
+	SigningTopic:            client.Signed,
+	SlashingProtectionTopic: client.SlashingProtectionRejected,
+	SubmissionTopic:         client.Submitted,
+	KeyReloadTopic:          client.KeysReloaded,
+}
+
+// DutiesEventJson is the JSON representation of a duties event.
+type DutiesEventJson struct {
+	Epoch  string           `json:"epoch"`
+	Duties []*DutyEntryJson `json:"duties"`
+}
+
+// DutyEntryJson is the JSON representation of the duties of a single validator.
+type DutyEntryJson struct {
+	PublicKey       string   `json:"public_key"`
+	ValidatorIndex  string   `json:"validator_index"`
+	Status          string   `json:"status"`
+	AttesterSlot    string   `json:"attester_slot"`
+	CommitteeIndex  string   `json:"committee_index"`
+	ProposerSlots   []string `json:"proposer_slots"`
+	IsSyncCommittee bool     `json:"is_sync_committee"`
+}
+
+// DutyEventJson is the JSON representation of signing, slashing protection and submission events.
+type DutyEventJson struct {
+	Duty      string `json:"duty"`
+	Slot      string `json:"slot"`
+	PublicKey string `json:"public_key"`
+	Outcome   string `json:"outcome"`
+	Error     string `json:"error,omitempty"`
+}
+
+// KeyReloadEventJson is the JSON representation of a key reload event.
+type KeyReloadEventJson struct {
+	PublicKeys []string `json:"public_keys"`
+}
+
+// handleStreamEvents streams validator client events for the requested topics as server-sent events.
+// Topics are given with the topics query parameter, either repeated or as a comma separated list.
+func (s *Server) handleStreamEvents(w http.ResponseWriter, r *http.Request) {
+	if s.validatorService == nil {
+		writeHTTPError(w, http.StatusServiceUnavailable, "Validator client is not running")
+		return
+	}
+	requested := make(map[client.EventType]string)
+	for _, param := range r.URL.Query()["topics"] {
+		for _, topic := range strings.Split(param, ",") {
+			topic = strings.TrimSpace(topic)
+			t, ok := eventTopics[topic]
+			if !ok {
+				writeHTTPError(w, http.StatusBadRequest, fmt.Sprintf("Topic %s not allowed for event subscriptions", topic))
+				return
+			}
+			requested[t] = topic
+		}
+	}
+	if len(requested) == 0 {
+		writeHTTPError(w, http.StatusBadRequest, "No topics specified to subscribe to")
+		return
+	}
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		writeHTTPError(w, http.StatusInternalServerError, "Streaming is not supported")
+		return
+	}
+
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+	feedChan := make(chan *client.Event, eventsChanSize)
+	sub := s.validatorService.EventFeed().Subscribe(feedChan)
+	defer sub.Unsubscribe()
+	eventsChan := make(chan *client.Event, eventsChanSize)
+	go forwardEvents(ctx, feedChan, eventsChan)
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	for {
+		select {
+		case ev := <-eventsChan:
+			topic, ok := requested[ev.Type]
+			if !ok {
+				continue
+			}
+			data, err := json.Marshal(eventJson(ev))
+			if err != nil {
+				log.WithError(err).Error("Could not marshal event")
+				continue
+			}
+			if _, err := fmt.Fprintf(w, "event: %s\ndata: %s\n\n", topic, data); err != nil {
+				log.WithError(err).Debug("Could not write event, closing stream")
+				return
+			}
+			flusher.Flush()
+		case <-sub.Err():
+			return
+		case <-ctx.Done():
+			return
+		case <-s.ctx.Done():
+			return
+		}
+	}
+}
+
+// forwardEvents receives events from the feed as soon as they are sent and forwards them to the stream.
+// Sending on the feed blocks until every subscriber received the event, so a slow client would otherwise
+// stall the duties of the validator client. Events are dropped when the stream falls too far behind.
+func forwardEvents(ctx context.Context, feedChan <-chan *client.Event, eventsChan chan<- *client.Event) {
+	for {
+		select {
+		case ev := <-feedChan:
+			select {
+			case eventsChan <- ev:
+			default:
+				log.Debug("Event stream is falling behind, dropping event")
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func eventJson(ev *client.Event) interface{} {
+	switch data := ev.Data.(type) {
+	case *client.DutiesUpdatedData:
+		resp := &DutiesEventJson{
+			Epoch:  fmt.Sprintf("%d", data.Epoch),
+			Duties: make([]*DutyEntryJson, 0),
+		}
+		if data.Duties == nil {
+			return resp
+		}
+		for _, d := range data.Duties.CurrentEpochDuties {
+			proposerSlots := make([]string, len(d.ProposerSlots))
+			for i, slot := range d.ProposerSlots {
+				proposerSlots[i] = fmt.Sprintf("%d", slot)
+			}
+			resp.Duties = append(resp.Duties, &DutyEntryJson{
+				PublicKey:       hexutil.Encode(d.PublicKey),
+				ValidatorIndex:  fmt.Sprintf("%d", d.ValidatorIndex),
+				Status:          strings.ToLower(d.Status.String()),
+				AttesterSlot:    fmt.Sprintf("%d", d.AttesterSlot),
+				CommitteeIndex:  fmt.Sprintf("%d", d.CommitteeIndex),
+				ProposerSlots:   proposerSlots,
+				IsSyncCommittee: d.IsSyncCommittee,
+			})
+		}
+		return resp
+	case *client.DutyEventData:
+		resp := &DutyEventJson{
+			Duty:      data.Duty,
+			Slot:      fmt.Sprintf("%d", data.Slot),
+			PublicKey: hexutil.Encode(data.PublicKey[:]),
+			Outcome:   successOutcome,
+		}
+		if data.Err != nil {
+			resp.Outcome = failureOutcome
+			resp.Error = data.Err.Error()
+		}
+		return resp
+	case *client.KeysReloadedData:
+		resp := &KeyReloadEventJson{PublicKeys: make([]string, len(data.PublicKeys))}
+		for i, k := range data.PublicKeys {
+			resp.PublicKeys[i] = hexutil.Encode(k[:])
+		}
+		return resp
+	default:
+		return ev.Data
+	}
+}
@@ -0,0 +1,153 @@
+package rpc
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	"github.com/prysmaticlabs/prysm/validator/client"
+)
+
+func TestServer_StreamEvents_InvalidTopic(t *testing.T) {
+	vs, err := client.NewValidatorService(context.Background(), &client.Config{})
+	require.NoError(t, err)
+	s := &Server{ctx: context.Background(), validatorService: vs}
+
+	req := httptest.NewRequest(http.MethodGet, "/v2/validator/events?topics=foo", nil)
+	rec := httptest.NewRecorder()
+	s.handleStreamEvents(rec, req)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Equal(t, true, strings.Contains(rec.Body.String(), "Topic foo not allowed"))
+
+	req = httptest.NewRequest(http.MethodGet, "/v2/validator/events", nil)
+	rec = httptest.NewRecorder()
+	s.handleStreamEvents(rec, req)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func TestServer_StreamEvents(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	vs, err := client.NewValidatorService(ctx, &client.Config{})
+	require.NoError(t, err)
+	s := &Server{ctx: ctx, validatorService: vs, jwtSecret: []byte("testKey")}
+	router := mux.NewRouter()
+	s.RegisterHTTPHandlers(router)
+	srv := httptest.NewServer(router)
+	defer srv.Close()
+	token, err := createTokenString(s.jwtSecret)
+	require.NoError(t, err)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, srv.URL+"/v2/validator/events?topics=signing,key_reload", nil)
+	require.NoError(t, err)
+	req.Header.Set("Authorization", "Bearer "+token)
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, resp.Body.Close())
+	}()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+
+	pubKey := [fieldparams.BLSPubkeyLength]byte{1}
+	// Wait for the handler to subscribe to the feed.
+	for vs.EventFeed().Send(&client.Event{Type: client.DutiesUpdated, Data: &client.DutiesUpdatedData{}}) == 0 {
+		time.Sleep(10 * time.Millisecond)
+	}
+	vs.EventFeed().Send(&client.Event{
+		Type: client.Signed,
+		Data: &client.DutyEventData{Duty: client.AttestationDuty, Slot: 5, PublicKey: pubKey, Err: errors.New("bad")},
+	})
+	vs.EventFeed().Send(&client.Event{
+		Type: client.KeysReloaded,
+		Data: &client.KeysReloadedData{PublicKeys: [][fieldparams.BLSPubkeyLength]byte{pubKey}},
+	})
+
+	reader := bufio.NewReader(resp.Body)
+	readEvent := func() (string, string) {
+		topic, err := reader.ReadString('\n')
+		require.NoError(t, err)
+		data, err := reader.ReadString('\n')
+		require.NoError(t, err)
+		_, err = reader.ReadString('\n')
+		require.NoError(t, err)
+		return strings.TrimSpace(strings.TrimPrefix(topic, "event:")), strings.TrimSpace(strings.TrimPrefix(data, "data:"))
+	}
+
+	// The duties event is filtered out as the topic was not requested.
+	topic, data := readEvent()
+	assert.Equal(t, SigningTopic, topic)
+	signing := &DutyEventJson{}
+	require.NoError(t, json.Unmarshal([]byte(data), signing))
+	assert.DeepEqual(t, &DutyEventJson{
+		Duty:      client.AttestationDuty,
+		Slot:      "5",
+		PublicKey: fmt.Sprintf("%#x", pubKey),
+		Outcome:   failureOutcome,
+		Error:     "bad",
+	}, signing)
+
+	topic, data = readEvent()
+	assert.Equal(t, KeyReloadTopic, topic)
+	reload := &KeyReloadEventJson{}
+	require.NoError(t, json.Unmarshal([]byte(data), reload))
+	assert.DeepEqual(t, []string{fmt.Sprintf("%#x", pubKey)}, reload.PublicKeys)
+}
+
+// stalledWriter is a response writer for a client which never reads the stream.
+type stalledWriter struct {
+	header http.Header
+	done   chan struct{}
+}
+
+func (w *stalledWriter) Header() http.Header { return w.header }
+
+func (w *stalledWriter) Write(b []byte) (int, error) {
+	<-w.done
+	return len(b), nil
+}
+
+func (*stalledWriter) WriteHeader(int) {}
+
+func (*stalledWriter) Flush() {}
+
+func TestServer_StreamEvents_StalledClientDoesNotBlockFeed(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	vs, err := client.NewValidatorService(ctx, &client.Config{})
+	require.NoError(t, err)
+	s := &Server{ctx: ctx, validatorService: vs}
+	w := &stalledWriter{header: make(http.Header), done: make(chan struct{})}
+	defer close(w.done)
+	req := httptest.NewRequest(http.MethodGet, "/v2/validator/events?topics=signing", nil).WithContext(ctx)
+	go s.handleStreamEvents(w, req)
+
+	ev := &client.Event{Type: client.Signed, Data: &client.DutyEventData{Duty: client.AttestationDuty}}
+	// Wait for the handler to subscribe to the feed.
+	for vs.EventFeed().Send(ev) == 0 {
+		time.Sleep(10 * time.Millisecond)
+	}
+	sent := make(chan struct{})
+	go func() {
+		for i := 0; i < 3*eventsChanSize; i++ {
+			vs.EventFeed().Send(ev)
+		}
+		close(sent)
+	}()
+	select {
+	case <-sent:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Sending events was blocked by a client which does not read the stream")
+	}
+}
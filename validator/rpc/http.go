@@ -0,0 +1,108 @@
+package rpc
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/gorilla/mux"
+	gwruntime "github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/prysmaticlabs/prysm/api/gateway/apimiddleware"
+	"google.golang.org/grpc/status"
+)
+
+// DisabledAccountsJson is the JSON representation of a list of disabled public keys.
+type DisabledAccountsJson struct {
+	PublicKeys []string `json:"public_keys"`
+}
+
+// RegisterHTTPHandlers registers the validator web API endpoints which are served
+// directly over HTTP instead of being proxied to the gRPC server by the gateway.
+// Paths are registered without the /api prefix, which is stripped by the gateway.
+func (s *Server) RegisterHTTPHandlers(r *mux.Router) {
+	r.HandleFunc("/v2/validator/accounts/disabled", s.authorizeHTTP(s.handleListDisabledAccounts)).Methods(http.MethodGet)
+	r.HandleFunc("/v2/validator/accounts/disable", s.authorizeHTTP(s.handleDisableAccounts)).Methods(http.MethodPost)
+	r.HandleFunc("/v2/validator/accounts/enable", s.authorizeHTTP(s.handleEnableAccounts)).Methods(http.MethodPost)
+	r.HandleFunc("/v2/validator/events", s.authorizeHTTP(s.handleStreamEvents)).Methods(http.MethodGet)
+}
+
+// authorizeHTTP wraps an HTTP handler with the same bearer token check that
+// JWTInterceptor performs for gRPC requests.
+func (s *Server) authorizeHTTP(h http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		authHeader := r.Header.Get("Authorization")
+		if !strings.Contains(authHeader, "Bearer ") {
+			writeHTTPError(w, http.StatusUnauthorized, "Invalid auth header, needs Bearer {token}")
+			return
+		}
+		token := strings.Split(authHeader, "Bearer ")[1]
+		if _, err := jwt.Parse(token, s.validateJWT); err != nil {
+			writeHTTPError(w, http.StatusUnauthorized, fmt.Sprintf("Could not parse JWT token: %v", err))
+			return
+		}
+		h(w, r)
+	}
+}
+
+func (s *Server) handleListDisabledAccounts(w http.ResponseWriter, r *http.Request) {
+	keys, err := s.DisabledAccounts(r.Context())
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+	resp := &DisabledAccountsJson{PublicKeys: make([]string, len(keys))}
+	for i, k := range keys {
+		resp.PublicKeys[i] = hexutil.Encode(k)
+	}
+	writeHTTPJson(w, resp)
+}
+
+func (s *Server) handleDisableAccounts(w http.ResponseWriter, r *http.Request) {
+	s.handleSetAccountsDisabled(w, r, true)
+}
+
+func (s *Server) handleEnableAccounts(w http.ResponseWriter, r *http.Request) {
+	s.handleSetAccountsDisabled(w, r, false)
+}
+
+func (s *Server) handleSetAccountsDisabled(w http.ResponseWriter, r *http.Request, disabled bool) {
+	req := &DisabledAccountsJson{}
+	if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+		writeHTTPError(w, http.StatusBadRequest, fmt.Sprintf("Could not decode request body: %v", err))
+		return
+	}
+	keys := make([][]byte, len(req.PublicKeys))
+	for i, k := range req.PublicKeys {
+		key, err := hexutil.Decode(k)
+		if err != nil {
+			writeHTTPError(w, http.StatusBadRequest, fmt.Sprintf("Could not decode public key %s: %v", k, err))
+			return
+		}
+		keys[i] = key
+	}
+	if err := s.SetAccountsDisabled(r.Context(), keys, disabled); err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+	writeHTTPJson(w, req)
+}
+
+func writeHTTPJson(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		log.WithError(err).Error("Could not write response")
+	}
+}
+
+func writeHTTPError(w http.ResponseWriter, code int, msg string) {
+	apimiddleware.WriteError(w, &apimiddleware.DefaultErrorJson{Message: msg, Code: code}, nil)
+}
+
+// writeGRPCError converts an error carrying a gRPC status into the matching HTTP error.
+func writeGRPCError(w http.ResponseWriter, err error) {
+	st := status.Convert(err)
+	writeHTTPError(w, gwruntime.HTTPStatusFromCode(st.Code()), st.Message())
+}
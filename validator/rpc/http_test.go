@@ -0,0 +1,65 @@
+package rpc
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	dbtest "github.com/prysmaticlabs/prysm/validator/db/testing"
+)
+
+func TestServer_DisabledAccounts_HTTP(t *testing.T) {
+	pubKey := [fieldparams.BLSPubkeyLength]byte{1}
+	s := &Server{
+		valDB:     dbtest.SetupDB(t, [][fieldparams.BLSPubkeyLength]byte{pubKey}),
+		jwtSecret: []byte("testKey"),
+	}
+	router := mux.NewRouter()
+	s.RegisterHTTPHandlers(router)
+	token, err := createTokenString(s.jwtSecret)
+	require.NoError(t, err)
+
+	do := func(method, path string, body []byte, auth bool) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, path, bytes.NewReader(body))
+		if auth {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, req)
+		return rec
+	}
+
+	rec := do(http.MethodGet, "/v2/validator/accounts/disabled", nil, false)
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+
+	body, err := json.Marshal(&DisabledAccountsJson{PublicKeys: []string{fmt.Sprintf("%#x", pubKey)}})
+	require.NoError(t, err)
+	rec = do(http.MethodPost, "/v2/validator/accounts/disable", body, true)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	rec = do(http.MethodGet, "/v2/validator/accounts/disabled", nil, true)
+	require.Equal(t, http.StatusOK, rec.Code)
+	resp := &DisabledAccountsJson{}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), resp))
+	require.Equal(t, 1, len(resp.PublicKeys))
+	assert.Equal(t, fmt.Sprintf("%#x", pubKey), resp.PublicKeys[0])
+
+	rec = do(http.MethodPost, "/v2/validator/accounts/enable", body, true)
+	require.Equal(t, http.StatusOK, rec.Code)
+	keys, err := s.DisabledAccounts(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(keys))
+
+	body, err = json.Marshal(&DisabledAccountsJson{PublicKeys: []string{"0x1234"}})
+	require.NoError(t, err)
+	rec = do(http.MethodPost, "/v2/validator/accounts/disable", body, true)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}